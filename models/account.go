@@ -29,18 +29,25 @@ package models
 import "encoding/json"
 
 type Account struct {
-	Id             string           `json:"id" bson:"_id"`
-	Identifier     []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Name           string           `bson:"name,omitempty" json:"name,omitempty"`
-	Type           *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Status         string           `bson:"status,omitempty" json:"status,omitempty"`
-	ActivePeriod   *Period          `bson:"activePeriod,omitempty" json:"activePeriod,omitempty"`
-	Currency       *Coding          `bson:"currency,omitempty" json:"currency,omitempty"`
-	Balance        *Quantity        `bson:"balance,omitempty" json:"balance,omitempty"`
-	CoveragePeriod *Period          `bson:"coveragePeriod,omitempty" json:"coveragePeriod,omitempty"`
-	Subject        *Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
-	Owner          *Reference       `bson:"owner,omitempty" json:"owner,omitempty"`
-	Description    string           `bson:"description,omitempty" json:"description,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Name              string             `bson:"name,omitempty" json:"name,omitempty"`
+	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Status            string             `bson:"status,omitempty" json:"status,omitempty"`
+	ActivePeriod      *Period            `bson:"activePeriod,omitempty" json:"activePeriod,omitempty"`
+	Currency          *Coding            `bson:"currency,omitempty" json:"currency,omitempty"`
+	Balance           *Quantity          `bson:"balance,omitempty" json:"balance,omitempty"`
+	CoveragePeriod    *Period            `bson:"coveragePeriod,omitempty" json:"coveragePeriod,omitempty"`
+	Subject           *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	Owner             *Reference         `bson:"owner,omitempty" json:"owner,omitempty"`
+	Description       string             `bson:"description,omitempty" json:"description,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,21 +29,28 @@ package models
 import "encoding/json"
 
 type AllergyIntolerance struct {
-	Id            string                                `json:"id" bson:"_id"`
-	Identifier    []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Onset         *FHIRDateTime                         `bson:"onset,omitempty" json:"onset,omitempty"`
-	RecordedDate  *FHIRDateTime                         `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
-	Recorder      *Reference                            `bson:"recorder,omitempty" json:"recorder,omitempty"`
-	Patient       *Reference                            `bson:"patient,omitempty" json:"patient,omitempty"`
-	Reporter      *Reference                            `bson:"reporter,omitempty" json:"reporter,omitempty"`
-	Substance     *CodeableConcept                      `bson:"substance,omitempty" json:"substance,omitempty"`
-	Status        string                                `bson:"status,omitempty" json:"status,omitempty"`
-	Criticality   string                                `bson:"criticality,omitempty" json:"criticality,omitempty"`
-	Type          string                                `bson:"type,omitempty" json:"type,omitempty"`
-	Category      string                                `bson:"category,omitempty" json:"category,omitempty"`
-	LastOccurence *FHIRDateTime                         `bson:"lastOccurence,omitempty" json:"lastOccurence,omitempty"`
-	Note          *Annotation                           `bson:"note,omitempty" json:"note,omitempty"`
-	Reaction      []AllergyIntoleranceReactionComponent `bson:"reaction,omitempty" json:"reaction,omitempty"`
+	Id                string                                `json:"id" bson:"_id"`
+	Meta              *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Onset             *FHIRDateTime                         `bson:"onset,omitempty" json:"onset,omitempty"`
+	RecordedDate      *FHIRDateTime                         `bson:"recordedDate,omitempty" json:"recordedDate,omitempty"`
+	Recorder          *Reference                            `bson:"recorder,omitempty" json:"recorder,omitempty"`
+	Patient           *Reference                            `bson:"patient,omitempty" json:"patient,omitempty"`
+	Reporter          *Reference                            `bson:"reporter,omitempty" json:"reporter,omitempty"`
+	Substance         *CodeableConcept                      `bson:"substance,omitempty" json:"substance,omitempty"`
+	Status            string                                `bson:"status,omitempty" json:"status,omitempty"`
+	Criticality       string                                `bson:"criticality,omitempty" json:"criticality,omitempty"`
+	Type              string                                `bson:"type,omitempty" json:"type,omitempty"`
+	Category          string                                `bson:"category,omitempty" json:"category,omitempty"`
+	LastOccurence     *FHIRDateTime                         `bson:"lastOccurence,omitempty" json:"lastOccurence,omitempty"`
+	Note              *Annotation                           `bson:"note,omitempty" json:"note,omitempty"`
+	Reaction          []AllergyIntoleranceReactionComponent `bson:"reaction,omitempty" json:"reaction,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,19 +29,26 @@ package models
 import "encoding/json"
 
 type Appointment struct {
-	Id              string                            `json:"id" bson:"_id"`
-	Identifier      []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status          string                            `bson:"status,omitempty" json:"status,omitempty"`
-	Type            *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
-	Reason          *CodeableConcept                  `bson:"reason,omitempty" json:"reason,omitempty"`
-	Priority        *uint32                           `bson:"priority,omitempty" json:"priority,omitempty"`
-	Description     string                            `bson:"description,omitempty" json:"description,omitempty"`
-	Start           *FHIRDateTime                     `bson:"start,omitempty" json:"start,omitempty"`
-	End             *FHIRDateTime                     `bson:"end,omitempty" json:"end,omitempty"`
-	MinutesDuration *uint32                           `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
-	Slot            []Reference                       `bson:"slot,omitempty" json:"slot,omitempty"`
-	Comment         string                            `bson:"comment,omitempty" json:"comment,omitempty"`
-	Participant     []AppointmentParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
+	Id                string                            `json:"id" bson:"_id"`
+	Meta              *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                            `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                      `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status            string                            `bson:"status,omitempty" json:"status,omitempty"`
+	Type              *CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
+	Reason            *CodeableConcept                  `bson:"reason,omitempty" json:"reason,omitempty"`
+	Priority          *uint32                           `bson:"priority,omitempty" json:"priority,omitempty"`
+	Description       string                            `bson:"description,omitempty" json:"description,omitempty"`
+	Start             *FHIRDateTime                     `bson:"start,omitempty" json:"start,omitempty"`
+	End               *FHIRDateTime                     `bson:"end,omitempty" json:"end,omitempty"`
+	MinutesDuration   *uint32                           `bson:"minutesDuration,omitempty" json:"minutesDuration,omitempty"`
+	Slot              []Reference                       `bson:"slot,omitempty" json:"slot,omitempty"`
+	Comment           string                            `bson:"comment,omitempty" json:"comment,omitempty"`
+	Participant       []AppointmentParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,11 +29,18 @@ package models
 import "encoding/json"
 
 type AuditEvent struct {
-	Id          string                           `json:"id" bson:"_id"`
-	Event       *AuditEventEventComponent        `bson:"event,omitempty" json:"event,omitempty"`
-	Participant []AuditEventParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
-	Source      *AuditEventSourceComponent       `bson:"source,omitempty" json:"source,omitempty"`
-	Object      []AuditEventObjectComponent      `bson:"object,omitempty" json:"object,omitempty"`
+	Id                string                           `json:"id" bson:"_id"`
+	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                           `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Event             *AuditEventEventComponent        `bson:"event,omitempty" json:"event,omitempty"`
+	Participant       []AuditEventParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
+	Source            *AuditEventSourceComponent       `bson:"source,omitempty" json:"source,omitempty"`
+	Object            []AuditEventObjectComponent      `bson:"object,omitempty" json:"object,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,12 +29,19 @@ package models
 import "encoding/json"
 
 type Basic struct {
-	Id         string           `json:"id" bson:"_id"`
-	Identifier []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code       *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
-	Subject    *Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
-	Author     *Reference       `bson:"author,omitempty" json:"author,omitempty"`
-	Created    *FHIRDateTime    `bson:"created,omitempty" json:"created,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code              *CodeableConcept   `bson:"code,omitempty" json:"code,omitempty"`
+	Subject           *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	Author            *Reference         `bson:"author,omitempty" json:"author,omitempty"`
+	Created           *FHIRDateTime      `bson:"created,omitempty" json:"created,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
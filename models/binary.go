@@ -29,9 +29,12 @@ package models
 import "encoding/json"
 
 type Binary struct {
-	Id          string `json:"id" bson:"_id"`
-	ContentType string `bson:"contentType,omitempty" json:"contentType,omitempty"`
-	Content     string `bson:"content,omitempty" json:"content,omitempty"`
+	Id            string `json:"id" bson:"_id"`
+	Meta          *Meta  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules string `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language      string `bson:"language,omitempty" json:"language,omitempty"`
+	ContentType   string `bson:"contentType,omitempty" json:"contentType,omitempty"`
+	Content       string `bson:"content,omitempty" json:"content,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,13 +29,20 @@ package models
 import "encoding/json"
 
 type BodySite struct {
-	Id          string            `json:"id" bson:"_id"`
-	Patient     *Reference        `bson:"patient,omitempty" json:"patient,omitempty"`
-	Identifier  []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Code        *CodeableConcept  `bson:"code,omitempty" json:"code,omitempty"`
-	Modifier    []CodeableConcept `bson:"modifier,omitempty" json:"modifier,omitempty"`
-	Description string            `bson:"description,omitempty" json:"description,omitempty"`
-	Image       []Attachment      `bson:"image,omitempty" json:"image,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Patient           *Reference         `bson:"patient,omitempty" json:"patient,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Code              *CodeableConcept   `bson:"code,omitempty" json:"code,omitempty"`
+	Modifier          []CodeableConcept  `bson:"modifier,omitempty" json:"modifier,omitempty"`
+	Description       string             `bson:"description,omitempty" json:"description,omitempty"`
+	Image             []Attachment       `bson:"image,omitempty" json:"image,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,12 +29,15 @@ package models
 import "encoding/json"
 
 type Bundle struct {
-	Id        string                 `json:"id" bson:"_id"`
-	Type      string                 `bson:"type,omitempty" json:"type,omitempty"`
-	Total     *uint32                `bson:"total,omitempty" json:"total,omitempty"`
-	Link      []BundleLinkComponent  `bson:"link,omitempty" json:"link,omitempty"`
-	Entry     []BundleEntryComponent `bson:"entry,omitempty" json:"entry,omitempty"`
-	Signature *Signature             `bson:"signature,omitempty" json:"signature,omitempty"`
+	Id            string                 `json:"id" bson:"_id"`
+	Meta          *Meta                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules string                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language      string                 `bson:"language,omitempty" json:"language,omitempty"`
+	Type          string                 `bson:"type,omitempty" json:"type,omitempty"`
+	Total         *uint32                `bson:"total,omitempty" json:"total,omitempty"`
+	Link          []BundleLinkComponent  `bson:"link,omitempty" json:"link,omitempty"`
+	Entry         []BundleEntryComponent `bson:"entry,omitempty" json:"entry,omitempty"`
+	Signature     *Signature             `bson:"signature,omitempty" json:"signature,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
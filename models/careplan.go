@@ -29,23 +29,30 @@ package models
 import "encoding/json"
 
 type CarePlan struct {
-	Id          string                         `json:"id" bson:"_id"`
-	Identifier  []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject     *Reference                     `bson:"subject,omitempty" json:"subject,omitempty"`
-	Status      string                         `bson:"status,omitempty" json:"status,omitempty"`
-	Context     *Reference                     `bson:"context,omitempty" json:"context,omitempty"`
-	Period      *Period                        `bson:"period,omitempty" json:"period,omitempty"`
-	Author      []Reference                    `bson:"author,omitempty" json:"author,omitempty"`
-	Modified    *FHIRDateTime                  `bson:"modified,omitempty" json:"modified,omitempty"`
-	Category    []CodeableConcept              `bson:"category,omitempty" json:"category,omitempty"`
-	Description string                         `bson:"description,omitempty" json:"description,omitempty"`
-	Addresses   []Reference                    `bson:"addresses,omitempty" json:"addresses,omitempty"`
-	Support     []Reference                    `bson:"support,omitempty" json:"support,omitempty"`
-	RelatedPlan []CarePlanRelatedPlanComponent `bson:"relatedPlan,omitempty" json:"relatedPlan,omitempty"`
-	Participant []CarePlanParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
-	Goal        []Reference                    `bson:"goal,omitempty" json:"goal,omitempty"`
-	Activity    []CarePlanActivityComponent    `bson:"activity,omitempty" json:"activity,omitempty"`
-	Note        *Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
+	Id                string                         `json:"id" bson:"_id"`
+	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                         `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject           *Reference                     `bson:"subject,omitempty" json:"subject,omitempty"`
+	Status            string                         `bson:"status,omitempty" json:"status,omitempty"`
+	Context           *Reference                     `bson:"context,omitempty" json:"context,omitempty"`
+	Period            *Period                        `bson:"period,omitempty" json:"period,omitempty"`
+	Author            []Reference                    `bson:"author,omitempty" json:"author,omitempty"`
+	Modified          *FHIRDateTime                  `bson:"modified,omitempty" json:"modified,omitempty"`
+	Category          []CodeableConcept              `bson:"category,omitempty" json:"category,omitempty"`
+	Description       string                         `bson:"description,omitempty" json:"description,omitempty"`
+	Addresses         []Reference                    `bson:"addresses,omitempty" json:"addresses,omitempty"`
+	Support           []Reference                    `bson:"support,omitempty" json:"support,omitempty"`
+	RelatedPlan       []CarePlanRelatedPlanComponent `bson:"relatedPlan,omitempty" json:"relatedPlan,omitempty"`
+	Participant       []CarePlanParticipantComponent `bson:"participant,omitempty" json:"participant,omitempty"`
+	Goal              []Reference                    `bson:"goal,omitempty" json:"goal,omitempty"`
+	Activity          []CarePlanActivityComponent    `bson:"activity,omitempty" json:"activity,omitempty"`
+	Note              *Annotation                    `bson:"note,omitempty" json:"note,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,20 +29,27 @@ package models
 import "encoding/json"
 
 type Communication struct {
-	Id            string                          `json:"id" bson:"_id"`
-	Identifier    []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Category      *CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
-	Sender        *Reference                      `bson:"sender,omitempty" json:"sender,omitempty"`
-	Recipient     []Reference                     `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Payload       []CommunicationPayloadComponent `bson:"payload,omitempty" json:"payload,omitempty"`
-	Medium        []CodeableConcept               `bson:"medium,omitempty" json:"medium,omitempty"`
-	Status        string                          `bson:"status,omitempty" json:"status,omitempty"`
-	Encounter     *Reference                      `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Sent          *FHIRDateTime                   `bson:"sent,omitempty" json:"sent,omitempty"`
-	Received      *FHIRDateTime                   `bson:"received,omitempty" json:"received,omitempty"`
-	Reason        []CodeableConcept               `bson:"reason,omitempty" json:"reason,omitempty"`
-	Subject       *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
-	RequestDetail *Reference                      `bson:"requestDetail,omitempty" json:"requestDetail,omitempty"`
+	Id                string                          `json:"id" bson:"_id"`
+	Meta              *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                          `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Category          *CodeableConcept                `bson:"category,omitempty" json:"category,omitempty"`
+	Sender            *Reference                      `bson:"sender,omitempty" json:"sender,omitempty"`
+	Recipient         []Reference                     `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Payload           []CommunicationPayloadComponent `bson:"payload,omitempty" json:"payload,omitempty"`
+	Medium            []CodeableConcept               `bson:"medium,omitempty" json:"medium,omitempty"`
+	Status            string                          `bson:"status,omitempty" json:"status,omitempty"`
+	Encounter         *Reference                      `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Sent              *FHIRDateTime                   `bson:"sent,omitempty" json:"sent,omitempty"`
+	Received          *FHIRDateTime                   `bson:"received,omitempty" json:"received,omitempty"`
+	Reason            []CodeableConcept               `bson:"reason,omitempty" json:"reason,omitempty"`
+	Subject           *Reference                      `bson:"subject,omitempty" json:"subject,omitempty"`
+	RequestDetail     *Reference                      `bson:"requestDetail,omitempty" json:"requestDetail,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
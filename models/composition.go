@@ -29,21 +29,28 @@ package models
 import "encoding/json"
 
 type Composition struct {
-	Id              string                         `json:"id" bson:"_id"`
-	Identifier      *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Date            *FHIRDateTime                  `bson:"date,omitempty" json:"date,omitempty"`
-	Type            *CodeableConcept               `bson:"type,omitempty" json:"type,omitempty"`
-	Class           *CodeableConcept               `bson:"class,omitempty" json:"class,omitempty"`
-	Title           string                         `bson:"title,omitempty" json:"title,omitempty"`
-	Status          string                         `bson:"status,omitempty" json:"status,omitempty"`
-	Confidentiality string                         `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
-	Subject         *Reference                     `bson:"subject,omitempty" json:"subject,omitempty"`
-	Author          []Reference                    `bson:"author,omitempty" json:"author,omitempty"`
-	Attester        []CompositionAttesterComponent `bson:"attester,omitempty" json:"attester,omitempty"`
-	Custodian       *Reference                     `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	Event           []CompositionEventComponent    `bson:"event,omitempty" json:"event,omitempty"`
-	Encounter       *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Section         []CompositionSectionComponent  `bson:"section,omitempty" json:"section,omitempty"`
+	Id                string                         `json:"id" bson:"_id"`
+	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                         `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier                    `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Date              *FHIRDateTime                  `bson:"date,omitempty" json:"date,omitempty"`
+	Type              *CodeableConcept               `bson:"type,omitempty" json:"type,omitempty"`
+	Class             *CodeableConcept               `bson:"class,omitempty" json:"class,omitempty"`
+	Title             string                         `bson:"title,omitempty" json:"title,omitempty"`
+	Status            string                         `bson:"status,omitempty" json:"status,omitempty"`
+	Confidentiality   string                         `bson:"confidentiality,omitempty" json:"confidentiality,omitempty"`
+	Subject           *Reference                     `bson:"subject,omitempty" json:"subject,omitempty"`
+	Author            []Reference                    `bson:"author,omitempty" json:"author,omitempty"`
+	Attester          []CompositionAttesterComponent `bson:"attester,omitempty" json:"attester,omitempty"`
+	Custodian         *Reference                     `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	Event             []CompositionEventComponent    `bson:"event,omitempty" json:"event,omitempty"`
+	Encounter         *Reference                     `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Section           []CompositionSectionComponent  `bson:"section,omitempty" json:"section,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
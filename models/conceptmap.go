@@ -29,25 +29,32 @@ package models
 import "encoding/json"
 
 type ConceptMap struct {
-	Id              string                             `json:"id" bson:"_id"`
-	Url             string                             `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier      *Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version         string                             `bson:"version,omitempty" json:"version,omitempty"`
-	Name            string                             `bson:"name,omitempty" json:"name,omitempty"`
-	Status          string                             `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental    *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher       string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact         []ConceptMapContactComponent       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date            *FHIRDateTime                      `bson:"date,omitempty" json:"date,omitempty"`
-	Description     string                             `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext      []CodeableConcept                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Requirements    string                             `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Copyright       string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	SourceUri       string                             `bson:"sourceUri,omitempty" json:"sourceUri,omitempty"`
-	SourceReference *Reference                         `bson:"sourceReference,omitempty" json:"sourceReference,omitempty"`
-	TargetUri       string                             `bson:"targetUri,omitempty" json:"targetUri,omitempty"`
-	TargetReference *Reference                         `bson:"targetReference,omitempty" json:"targetReference,omitempty"`
-	Element         []ConceptMapSourceElementComponent `bson:"element,omitempty" json:"element,omitempty"`
+	Id                string                             `json:"id" bson:"_id"`
+	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                             `bson:"url,omitempty" json:"url,omitempty"`
+	Identifier        *Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version           string                             `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                             `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                             `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                              `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                             `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []ConceptMapContactComponent       `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                      `bson:"date,omitempty" json:"date,omitempty"`
+	Description       string                             `bson:"description,omitempty" json:"description,omitempty"`
+	UseContext        []CodeableConcept                  `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Requirements      string                             `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Copyright         string                             `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	SourceUri         string                             `bson:"sourceUri,omitempty" json:"sourceUri,omitempty"`
+	SourceReference   *Reference                         `bson:"sourceReference,omitempty" json:"sourceReference,omitempty"`
+	TargetUri         string                             `bson:"targetUri,omitempty" json:"targetUri,omitempty"`
+	TargetReference   *Reference                         `bson:"targetReference,omitempty" json:"targetReference,omitempty"`
+	Element           []ConceptMapSourceElementComponent `bson:"element,omitempty" json:"element,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,28 +29,35 @@ package models
 import "encoding/json"
 
 type Conformance struct {
-	Id             string                              `json:"id" bson:"_id"`
-	Url            string                              `bson:"url,omitempty" json:"url,omitempty"`
-	Version        string                              `bson:"version,omitempty" json:"version,omitempty"`
-	Name           string                              `bson:"name,omitempty" json:"name,omitempty"`
-	Status         string                              `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental   *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher      string                              `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact        []ConformanceContactComponent       `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date           *FHIRDateTime                       `bson:"date,omitempty" json:"date,omitempty"`
-	Description    string                              `bson:"description,omitempty" json:"description,omitempty"`
-	Requirements   string                              `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Copyright      string                              `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Kind           string                              `bson:"kind,omitempty" json:"kind,omitempty"`
-	Software       *ConformanceSoftwareComponent       `bson:"software,omitempty" json:"software,omitempty"`
-	Implementation *ConformanceImplementationComponent `bson:"implementation,omitempty" json:"implementation,omitempty"`
-	FhirVersion    string                              `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	AcceptUnknown  string                              `bson:"acceptUnknown,omitempty" json:"acceptUnknown,omitempty"`
-	Format         []string                            `bson:"format,omitempty" json:"format,omitempty"`
-	Profile        []Reference                         `bson:"profile,omitempty" json:"profile,omitempty"`
-	Rest           []ConformanceRestComponent          `bson:"rest,omitempty" json:"rest,omitempty"`
-	Messaging      []ConformanceMessagingComponent     `bson:"messaging,omitempty" json:"messaging,omitempty"`
-	Document       []ConformanceDocumentComponent      `bson:"document,omitempty" json:"document,omitempty"`
+	Id                string                              `json:"id" bson:"_id"`
+	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                              `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                              `bson:"url,omitempty" json:"url,omitempty"`
+	Version           string                              `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                              `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                              `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                               `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                              `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []ConformanceContactComponent       `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                       `bson:"date,omitempty" json:"date,omitempty"`
+	Description       string                              `bson:"description,omitempty" json:"description,omitempty"`
+	Requirements      string                              `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Copyright         string                              `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	Kind              string                              `bson:"kind,omitempty" json:"kind,omitempty"`
+	Software          *ConformanceSoftwareComponent       `bson:"software,omitempty" json:"software,omitempty"`
+	Implementation    *ConformanceImplementationComponent `bson:"implementation,omitempty" json:"implementation,omitempty"`
+	FhirVersion       string                              `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	AcceptUnknown     string                              `bson:"acceptUnknown,omitempty" json:"acceptUnknown,omitempty"`
+	Format            []string                            `bson:"format,omitempty" json:"format,omitempty"`
+	Profile           []Reference                         `bson:"profile,omitempty" json:"profile,omitempty"`
+	Rest              []ConformanceRestComponent          `bson:"rest,omitempty" json:"rest,omitempty"`
+	Messaging         []ConformanceMessagingComponent     `bson:"messaging,omitempty" json:"messaging,omitempty"`
+	Document          []ConformanceDocumentComponent      `bson:"document,omitempty" json:"document,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
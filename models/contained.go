@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ContainedResources holds the resources contained inline in a DomainResource.
+// Since the contained resources can be of any type, they are represented as
+// pointers to the concrete resource structs (e.g., *Medication).
+type ContainedResources []interface{}
+
+// UnmarshalJSON unmarshals each contained resource into a pointer to the
+// resource struct for its resourceType.  Contained resources that aren't
+// objects, or whose resourceType is missing or unknown, are errors.
+func (c *ContainedResources) UnmarshalJSON(data []byte) error {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return err
+	}
+	resources := make(ContainedResources, len(raws))
+	for i := range raws {
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(raws[i], &header); err != nil {
+			return fmt.Errorf("contained resource %d is not a resource", i)
+		}
+		if header.ResourceType == "" {
+			return fmt.Errorf("contained resource %d has no resourceType", i)
+		}
+		if StructForResourceName(header.ResourceType) == nil {
+			return fmt.Errorf("unknown contained resource type \"%s\"", header.ResourceType)
+		}
+		resource := NewStructForResourceName(header.ResourceType)
+		if err := json.Unmarshal(raws[i], resource); err != nil {
+			return err
+		}
+		resources[i] = resource
+	}
+	*c = resources
+	return nil
+}
+
+// GetBSON adds the resourceType to each contained resource so that SetBSON
+// can restore them to the correct resource structs.
+func (c ContainedResources) GetBSON() (interface{}, error) {
+	docs := make([]bson.D, len(c))
+	for i := range c {
+		data, err := bson.Marshal(c[i])
+		if err != nil {
+			return nil, err
+		}
+		var doc bson.D
+		if err = bson.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		resourceType := reflect.Indirect(reflect.ValueOf(c[i])).Type().Name()
+		docs[i] = append(bson.D{{Name: "resourceType", Value: resourceType}}, doc...)
+	}
+	return docs, nil
+}
+
+// SetBSON restores each contained resource to a pointer to its resource
+// struct, based on the resourceType stored by GetBSON.
+func (c *ContainedResources) SetBSON(raw bson.Raw) error {
+	var docs []bson.Raw
+	if err := raw.Unmarshal(&docs); err != nil {
+		return err
+	}
+	resources := make(ContainedResources, len(docs))
+	for i := range docs {
+		var header struct {
+			ResourceType string `bson:"resourceType"`
+		}
+		if err := docs[i].Unmarshal(&header); err != nil {
+			return err
+		}
+		if StructForResourceName(header.ResourceType) == nil {
+			return fmt.Errorf("unknown contained resource type \"%s\"", header.ResourceType)
+		}
+		resource := NewStructForResourceName(header.ResourceType)
+		if err := docs[i].Unmarshal(resource); err != nil {
+			return err
+		}
+		resources[i] = resource
+	}
+	*c = resources
+	return nil
+}
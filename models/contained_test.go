@@ -0,0 +1,103 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/pebbe/util"
+	check "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type ContainedSuite struct {
+}
+
+var _ = check.Suite(&ContainedSuite{})
+
+var domainResourceJSON = []byte(`{
+	"resourceType": "Patient",
+	"id": "123",
+	"meta": {"versionId": "2", "profile": ["http://fhir.org/guides/argonaut/StructureDefinition/argo-patient"]},
+	"implicitRules": "http://example.com/rules",
+	"language": "en-US",
+	"text": {"status": "generated", "div": "<div>Donald Duck</div>"},
+	"contained": [
+		{"resourceType": "Organization", "id": "org1", "name": "Acme Healthcare"}
+	],
+	"extension": [
+		{"url": "http://hl7.org/fhir/StructureDefinition/us-core-race", "valueCodeableConcept": {"text": "White"}}
+	],
+	"modifierExtension": [
+		{"url": "http://example.com/fhir/StructureDefinition/restricted", "valueBoolean": true}
+	],
+	"managingOrganization": {"reference": "#org1"}
+}`)
+
+func (s *ContainedSuite) TestDomainResourceJSONRoundTrip(c *check.C) {
+	patient := &Patient{}
+	err := json.Unmarshal(domainResourceJSON, patient)
+	util.CheckErr(err)
+	assertDomainResourcePatient(c, patient)
+
+	data, err := json.Marshal(patient)
+	util.CheckErr(err)
+	patient2 := &Patient{}
+	err = json.Unmarshal(data, patient2)
+	util.CheckErr(err)
+	assertDomainResourcePatient(c, patient2)
+}
+
+func (s *ContainedSuite) TestDomainResourceBSONRoundTrip(c *check.C) {
+	patient := &Patient{}
+	err := json.Unmarshal(domainResourceJSON, patient)
+	util.CheckErr(err)
+
+	data, err := bson.Marshal(patient)
+	util.CheckErr(err)
+
+	// The contained resource should be stored with its resourceType
+	var doc bson.M
+	err = bson.Unmarshal(data, &doc)
+	util.CheckErr(err)
+	contained := doc["contained"].([]interface{})
+	c.Assert(contained[0].(bson.M)["resourceType"], check.Equals, "Organization")
+
+	patient2 := &Patient{}
+	err = bson.Unmarshal(data, patient2)
+	util.CheckErr(err)
+	assertDomainResourcePatient(c, patient2)
+}
+
+func (s *ContainedSuite) TestInvalidContainedResources(c *check.C) {
+	for contained, message := range map[string]string{
+		`[1]`: "contained resource 0 is not a resource",
+		`[{"resourceType": "Organization"}, {"id": "org2"}]`: "contained resource 1 has no resourceType",
+		`[null]`:                    "contained resource 0 has no resourceType",
+		`[{"resourceType": "Foo"}]`: "unknown contained resource type \"Foo\"",
+		`[{"resourceType": "Patient", "gender": 1}]`: ".*cannot unmarshal number.*",
+	} {
+		patient := &Patient{}
+		err := json.Unmarshal([]byte(`{"resourceType": "Patient", "contained": `+contained+`}`), patient)
+		c.Assert(err, check.ErrorMatches, message, check.Commentf("contained: %s", contained))
+	}
+}
+
+func assertDomainResourcePatient(c *check.C, patient *Patient) {
+	c.Assert(patient.Id, check.Equals, "123")
+	c.Assert(patient.Meta.VersionId, check.Equals, "2")
+	c.Assert(patient.Meta.Profile, check.DeepEquals, []string{"http://fhir.org/guides/argonaut/StructureDefinition/argo-patient"})
+	c.Assert(patient.ImplicitRules, check.Equals, "http://example.com/rules")
+	c.Assert(patient.Language, check.Equals, "en-US")
+	c.Assert(patient.Text.Status, check.Equals, "generated")
+	c.Assert(patient.Text.Div, check.Equals, "<div>Donald Duck</div>")
+	c.Assert(patient.Contained, check.HasLen, 1)
+	org, ok := patient.Contained[0].(*Organization)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(org.Id, check.Equals, "org1")
+	c.Assert(org.Name, check.Equals, "Acme Healthcare")
+	c.Assert(patient.Extension, check.HasLen, 1)
+	c.Assert(patient.Extension[0].Url, check.Equals, "http://hl7.org/fhir/StructureDefinition/us-core-race")
+	c.Assert(patient.Extension[0].ValueCodeableConcept.Text, check.Equals, "White")
+	c.Assert(patient.ModifierExtension, check.HasLen, 1)
+	c.Assert(*patient.ModifierExtension[0].ValueBoolean, check.Equals, true)
+	c.Assert(patient.ManagingOrganization.Reference, check.Equals, "#org1")
+}
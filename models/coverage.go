@@ -29,21 +29,28 @@ package models
 import "encoding/json"
 
 type Coverage struct {
-	Id           string       `json:"id" bson:"_id"`
-	Issuer       *Reference   `bson:"issuer,omitempty" json:"issuer,omitempty"`
-	Bin          *Identifier  `bson:"bin,omitempty" json:"bin,omitempty"`
-	Period       *Period      `bson:"period,omitempty" json:"period,omitempty"`
-	Type         *Coding      `bson:"type,omitempty" json:"type,omitempty"`
-	SubscriberId *Identifier  `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
-	Identifier   []Identifier `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Group        string       `bson:"group,omitempty" json:"group,omitempty"`
-	Plan         string       `bson:"plan,omitempty" json:"plan,omitempty"`
-	SubPlan      string       `bson:"subPlan,omitempty" json:"subPlan,omitempty"`
-	Dependent    *uint32      `bson:"dependent,omitempty" json:"dependent,omitempty"`
-	Sequence     *uint32      `bson:"sequence,omitempty" json:"sequence,omitempty"`
-	Subscriber   *Reference   `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
-	Network      *Identifier  `bson:"network,omitempty" json:"network,omitempty"`
-	Contract     []Reference  `bson:"contract,omitempty" json:"contract,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Issuer            *Reference         `bson:"issuer,omitempty" json:"issuer,omitempty"`
+	Bin               *Identifier        `bson:"bin,omitempty" json:"bin,omitempty"`
+	Period            *Period            `bson:"period,omitempty" json:"period,omitempty"`
+	Type              *Coding            `bson:"type,omitempty" json:"type,omitempty"`
+	SubscriberId      *Identifier        `bson:"subscriberId,omitempty" json:"subscriberId,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Group             string             `bson:"group,omitempty" json:"group,omitempty"`
+	Plan              string             `bson:"plan,omitempty" json:"plan,omitempty"`
+	SubPlan           string             `bson:"subPlan,omitempty" json:"subPlan,omitempty"`
+	Dependent         *uint32            `bson:"dependent,omitempty" json:"dependent,omitempty"`
+	Sequence          *uint32            `bson:"sequence,omitempty" json:"sequence,omitempty"`
+	Subscriber        *Reference         `bson:"subscriber,omitempty" json:"subscriber,omitempty"`
+	Network           *Identifier        `bson:"network,omitempty" json:"network,omitempty"`
+	Contract          []Reference        `bson:"contract,omitempty" json:"contract,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
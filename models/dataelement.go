@@ -29,21 +29,28 @@ package models
 import "encoding/json"
 
 type DataElement struct {
-	Id           string                        `json:"id" bson:"_id"`
-	Url          string                        `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier   []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version      string                        `bson:"version,omitempty" json:"version,omitempty"`
-	Name         string                        `bson:"name,omitempty" json:"name,omitempty"`
-	Status       string                        `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental *bool                         `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher    string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact      []DataElementContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date         *FHIRDateTime                 `bson:"date,omitempty" json:"date,omitempty"`
-	UseContext   []CodeableConcept             `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Copyright    string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Stringency   string                        `bson:"stringency,omitempty" json:"stringency,omitempty"`
-	Mapping      []DataElementMappingComponent `bson:"mapping,omitempty" json:"mapping,omitempty"`
-	Element      []ElementDefinition           `bson:"element,omitempty" json:"element,omitempty"`
+	Id                string                        `json:"id" bson:"_id"`
+	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                        `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                        `bson:"url,omitempty" json:"url,omitempty"`
+	Identifier        []Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version           string                        `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                        `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                        `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                         `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                        `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []DataElementContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                 `bson:"date,omitempty" json:"date,omitempty"`
+	UseContext        []CodeableConcept             `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Copyright         string                        `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	Stringency        string                        `bson:"stringency,omitempty" json:"stringency,omitempty"`
+	Mapping           []DataElementMappingComponent `bson:"mapping,omitempty" json:"mapping,omitempty"`
+	Element           []ElementDefinition           `bson:"element,omitempty" json:"element,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
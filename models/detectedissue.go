@@ -29,17 +29,24 @@ package models
 import "encoding/json"
 
 type DetectedIssue struct {
-	Id         string                             `json:"id" bson:"_id"`
-	Patient    *Reference                         `bson:"patient,omitempty" json:"patient,omitempty"`
-	Category   *CodeableConcept                   `bson:"category,omitempty" json:"category,omitempty"`
-	Severity   string                             `bson:"severity,omitempty" json:"severity,omitempty"`
-	Implicated []Reference                        `bson:"implicated,omitempty" json:"implicated,omitempty"`
-	Detail     string                             `bson:"detail,omitempty" json:"detail,omitempty"`
-	Date       *FHIRDateTime                      `bson:"date,omitempty" json:"date,omitempty"`
-	Author     *Reference                         `bson:"author,omitempty" json:"author,omitempty"`
-	Identifier *Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Reference  string                             `bson:"reference,omitempty" json:"reference,omitempty"`
-	Mitigation []DetectedIssueMitigationComponent `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	Id                string                             `json:"id" bson:"_id"`
+	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Patient           *Reference                         `bson:"patient,omitempty" json:"patient,omitempty"`
+	Category          *CodeableConcept                   `bson:"category,omitempty" json:"category,omitempty"`
+	Severity          string                             `bson:"severity,omitempty" json:"severity,omitempty"`
+	Implicated        []Reference                        `bson:"implicated,omitempty" json:"implicated,omitempty"`
+	Detail            string                             `bson:"detail,omitempty" json:"detail,omitempty"`
+	Date              *FHIRDateTime                      `bson:"date,omitempty" json:"date,omitempty"`
+	Author            *Reference                         `bson:"author,omitempty" json:"author,omitempty"`
+	Identifier        *Identifier                        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Reference         string                             `bson:"reference,omitempty" json:"reference,omitempty"`
+	Mitigation        []DetectedIssueMitigationComponent `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,23 +29,30 @@ package models
 import "encoding/json"
 
 type Device struct {
-	Id              string           `json:"id" bson:"_id"`
-	Identifier      []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type            *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Note            []Annotation     `bson:"note,omitempty" json:"note,omitempty"`
-	Status          string           `bson:"status,omitempty" json:"status,omitempty"`
-	Manufacturer    string           `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Model           string           `bson:"model,omitempty" json:"model,omitempty"`
-	Version         string           `bson:"version,omitempty" json:"version,omitempty"`
-	ManufactureDate *FHIRDateTime    `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
-	Expiry          *FHIRDateTime    `bson:"expiry,omitempty" json:"expiry,omitempty"`
-	Udi             string           `bson:"udi,omitempty" json:"udi,omitempty"`
-	LotNumber       string           `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
-	Owner           *Reference       `bson:"owner,omitempty" json:"owner,omitempty"`
-	Location        *Reference       `bson:"location,omitempty" json:"location,omitempty"`
-	Patient         *Reference       `bson:"patient,omitempty" json:"patient,omitempty"`
-	Contact         []ContactPoint   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Url             string           `bson:"url,omitempty" json:"url,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Note              []Annotation       `bson:"note,omitempty" json:"note,omitempty"`
+	Status            string             `bson:"status,omitempty" json:"status,omitempty"`
+	Manufacturer      string             `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Model             string             `bson:"model,omitempty" json:"model,omitempty"`
+	Version           string             `bson:"version,omitempty" json:"version,omitempty"`
+	ManufactureDate   *FHIRDateTime      `bson:"manufactureDate,omitempty" json:"manufactureDate,omitempty"`
+	Expiry            *FHIRDateTime      `bson:"expiry,omitempty" json:"expiry,omitempty"`
+	Udi               string             `bson:"udi,omitempty" json:"udi,omitempty"`
+	LotNumber         string             `bson:"lotNumber,omitempty" json:"lotNumber,omitempty"`
+	Owner             *Reference         `bson:"owner,omitempty" json:"owner,omitempty"`
+	Location          *Reference         `bson:"location,omitempty" json:"location,omitempty"`
+	Patient           *Reference         `bson:"patient,omitempty" json:"patient,omitempty"`
+	Contact           []ContactPoint     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Url               string             `bson:"url,omitempty" json:"url,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
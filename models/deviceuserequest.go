@@ -29,23 +29,30 @@ package models
 import "encoding/json"
 
 type DeviceUseRequest struct {
-	Id                      string            `json:"id" bson:"_id"`
-	BodySiteCodeableConcept *CodeableConcept  `bson:"bodySiteCodeableConcept,omitempty" json:"bodySiteCodeableConcept,omitempty"`
-	BodySiteReference       *Reference        `bson:"bodySiteReference,omitempty" json:"bodySiteReference,omitempty"`
-	Status                  string            `bson:"status,omitempty" json:"status,omitempty"`
-	Device                  *Reference        `bson:"device,omitempty" json:"device,omitempty"`
-	Encounter               *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Identifier              []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Indication              []CodeableConcept `bson:"indication,omitempty" json:"indication,omitempty"`
-	Notes                   []string          `bson:"notes,omitempty" json:"notes,omitempty"`
-	PrnReason               []CodeableConcept `bson:"prnReason,omitempty" json:"prnReason,omitempty"`
-	OrderedOn               *FHIRDateTime     `bson:"orderedOn,omitempty" json:"orderedOn,omitempty"`
-	RecordedOn              *FHIRDateTime     `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
-	Subject                 *Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	TimingTiming            *Timing           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	TimingPeriod            *Period           `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	TimingDateTime          *FHIRDateTime     `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
-	Priority                string            `bson:"priority,omitempty" json:"priority,omitempty"`
+	Id                      string             `json:"id" bson:"_id"`
+	Meta                    *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language                string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text                    *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	BodySiteCodeableConcept *CodeableConcept   `bson:"bodySiteCodeableConcept,omitempty" json:"bodySiteCodeableConcept,omitempty"`
+	BodySiteReference       *Reference         `bson:"bodySiteReference,omitempty" json:"bodySiteReference,omitempty"`
+	Status                  string             `bson:"status,omitempty" json:"status,omitempty"`
+	Device                  *Reference         `bson:"device,omitempty" json:"device,omitempty"`
+	Encounter               *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Identifier              []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Indication              []CodeableConcept  `bson:"indication,omitempty" json:"indication,omitempty"`
+	Notes                   []string           `bson:"notes,omitempty" json:"notes,omitempty"`
+	PrnReason               []CodeableConcept  `bson:"prnReason,omitempty" json:"prnReason,omitempty"`
+	OrderedOn               *FHIRDateTime      `bson:"orderedOn,omitempty" json:"orderedOn,omitempty"`
+	RecordedOn              *FHIRDateTime      `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
+	Subject                 *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	TimingTiming            *Timing            `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingPeriod            *Period            `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDateTime          *FHIRDateTime      `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	Priority                string             `bson:"priority,omitempty" json:"priority,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,19 +29,26 @@ package models
 import "encoding/json"
 
 type DeviceUseStatement struct {
-	Id                      string            `json:"id" bson:"_id"`
-	BodySiteCodeableConcept *CodeableConcept  `bson:"bodySiteCodeableConcept,omitempty" json:"bodySiteCodeableConcept,omitempty"`
-	BodySiteReference       *Reference        `bson:"bodySiteReference,omitempty" json:"bodySiteReference,omitempty"`
-	WhenUsed                *Period           `bson:"whenUsed,omitempty" json:"whenUsed,omitempty"`
-	Device                  *Reference        `bson:"device,omitempty" json:"device,omitempty"`
-	Identifier              []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Indication              []CodeableConcept `bson:"indication,omitempty" json:"indication,omitempty"`
-	Notes                   []string          `bson:"notes,omitempty" json:"notes,omitempty"`
-	RecordedOn              *FHIRDateTime     `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
-	Subject                 *Reference        `bson:"subject,omitempty" json:"subject,omitempty"`
-	TimingTiming            *Timing           `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
-	TimingPeriod            *Period           `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
-	TimingDateTime          *FHIRDateTime     `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
+	Id                      string             `json:"id" bson:"_id"`
+	Meta                    *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules           string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language                string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text                    *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained               ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension               []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension       []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	BodySiteCodeableConcept *CodeableConcept   `bson:"bodySiteCodeableConcept,omitempty" json:"bodySiteCodeableConcept,omitempty"`
+	BodySiteReference       *Reference         `bson:"bodySiteReference,omitempty" json:"bodySiteReference,omitempty"`
+	WhenUsed                *Period            `bson:"whenUsed,omitempty" json:"whenUsed,omitempty"`
+	Device                  *Reference         `bson:"device,omitempty" json:"device,omitempty"`
+	Identifier              []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Indication              []CodeableConcept  `bson:"indication,omitempty" json:"indication,omitempty"`
+	Notes                   []string           `bson:"notes,omitempty" json:"notes,omitempty"`
+	RecordedOn              *FHIRDateTime      `bson:"recordedOn,omitempty" json:"recordedOn,omitempty"`
+	Subject                 *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	TimingTiming            *Timing            `bson:"timingTiming,omitempty" json:"timingTiming,omitempty"`
+	TimingPeriod            *Period            `bson:"timingPeriod,omitempty" json:"timingPeriod,omitempty"`
+	TimingDateTime          *FHIRDateTime      `bson:"timingDateTime,omitempty" json:"timingDateTime,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
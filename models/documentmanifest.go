@@ -29,19 +29,26 @@ package models
 import "encoding/json"
 
 type DocumentManifest struct {
-	Id               string                             `json:"id" bson:"_id"`
-	MasterIdentifier *Identifier                        `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier       []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject          *Reference                         `bson:"subject,omitempty" json:"subject,omitempty"`
-	Recipient        []Reference                        `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Type             *CodeableConcept                   `bson:"type,omitempty" json:"type,omitempty"`
-	Author           []Reference                        `bson:"author,omitempty" json:"author,omitempty"`
-	Created          *FHIRDateTime                      `bson:"created,omitempty" json:"created,omitempty"`
-	Source           string                             `bson:"source,omitempty" json:"source,omitempty"`
-	Status           string                             `bson:"status,omitempty" json:"status,omitempty"`
-	Description      string                             `bson:"description,omitempty" json:"description,omitempty"`
-	Content          []DocumentManifestContentComponent `bson:"content,omitempty" json:"content,omitempty"`
-	Related          []DocumentManifestRelatedComponent `bson:"related,omitempty" json:"related,omitempty"`
+	Id                string                             `json:"id" bson:"_id"`
+	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier  *Identifier                        `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject           *Reference                         `bson:"subject,omitempty" json:"subject,omitempty"`
+	Recipient         []Reference                        `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Type              *CodeableConcept                   `bson:"type,omitempty" json:"type,omitempty"`
+	Author            []Reference                        `bson:"author,omitempty" json:"author,omitempty"`
+	Created           *FHIRDateTime                      `bson:"created,omitempty" json:"created,omitempty"`
+	Source            string                             `bson:"source,omitempty" json:"source,omitempty"`
+	Status            string                             `bson:"status,omitempty" json:"status,omitempty"`
+	Description       string                             `bson:"description,omitempty" json:"description,omitempty"`
+	Content           []DocumentManifestContentComponent `bson:"content,omitempty" json:"content,omitempty"`
+	Related           []DocumentManifestRelatedComponent `bson:"related,omitempty" json:"related,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
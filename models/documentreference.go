@@ -29,24 +29,31 @@ package models
 import "encoding/json"
 
 type DocumentReference struct {
-	Id               string                                `json:"id" bson:"_id"`
-	MasterIdentifier *Identifier                           `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
-	Identifier       []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject          *Reference                            `bson:"subject,omitempty" json:"subject,omitempty"`
-	Type             *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
-	Class            *CodeableConcept                      `bson:"class,omitempty" json:"class,omitempty"`
-	Author           []Reference                           `bson:"author,omitempty" json:"author,omitempty"`
-	Custodian        *Reference                            `bson:"custodian,omitempty" json:"custodian,omitempty"`
-	Authenticator    *Reference                            `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
-	Created          *FHIRDateTime                         `bson:"created,omitempty" json:"created,omitempty"`
-	Indexed          *FHIRDateTime                         `bson:"indexed,omitempty" json:"indexed,omitempty"`
-	Status           string                                `bson:"status,omitempty" json:"status,omitempty"`
-	DocStatus        *CodeableConcept                      `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
-	RelatesTo        []DocumentReferenceRelatesToComponent `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
-	Description      string                                `bson:"description,omitempty" json:"description,omitempty"`
-	SecurityLabel    []CodeableConcept                     `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
-	Content          []DocumentReferenceContentComponent   `bson:"content,omitempty" json:"content,omitempty"`
-	Context          *DocumentReferenceContextComponent    `bson:"context,omitempty" json:"context,omitempty"`
+	Id                string                                `json:"id" bson:"_id"`
+	Meta              *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	MasterIdentifier  *Identifier                           `bson:"masterIdentifier,omitempty" json:"masterIdentifier,omitempty"`
+	Identifier        []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject           *Reference                            `bson:"subject,omitempty" json:"subject,omitempty"`
+	Type              *CodeableConcept                      `bson:"type,omitempty" json:"type,omitempty"`
+	Class             *CodeableConcept                      `bson:"class,omitempty" json:"class,omitempty"`
+	Author            []Reference                           `bson:"author,omitempty" json:"author,omitempty"`
+	Custodian         *Reference                            `bson:"custodian,omitempty" json:"custodian,omitempty"`
+	Authenticator     *Reference                            `bson:"authenticator,omitempty" json:"authenticator,omitempty"`
+	Created           *FHIRDateTime                         `bson:"created,omitempty" json:"created,omitempty"`
+	Indexed           *FHIRDateTime                         `bson:"indexed,omitempty" json:"indexed,omitempty"`
+	Status            string                                `bson:"status,omitempty" json:"status,omitempty"`
+	DocStatus         *CodeableConcept                      `bson:"docStatus,omitempty" json:"docStatus,omitempty"`
+	RelatesTo         []DocumentReferenceRelatesToComponent `bson:"relatesTo,omitempty" json:"relatesTo,omitempty"`
+	Description       string                                `bson:"description,omitempty" json:"description,omitempty"`
+	SecurityLabel     []CodeableConcept                     `bson:"securityLabel,omitempty" json:"securityLabel,omitempty"`
+	Content           []DocumentReferenceContentComponent   `bson:"content,omitempty" json:"content,omitempty"`
+	Context           *DocumentReferenceContextComponent    `bson:"context,omitempty" json:"context,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
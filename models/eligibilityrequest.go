@@ -29,14 +29,21 @@ package models
 import "encoding/json"
 
 type EligibilityRequest struct {
-	Id              string        `json:"id" bson:"_id"`
-	Identifier      []Identifier  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Ruleset         *Coding       `bson:"ruleset,omitempty" json:"ruleset,omitempty"`
-	OriginalRuleset *Coding       `bson:"originalRuleset,omitempty" json:"originalRuleset,omitempty"`
-	Created         *FHIRDateTime `bson:"created,omitempty" json:"created,omitempty"`
-	Target          *Reference    `bson:"target,omitempty" json:"target,omitempty"`
-	Provider        *Reference    `bson:"provider,omitempty" json:"provider,omitempty"`
-	Organization    *Reference    `bson:"organization,omitempty" json:"organization,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Ruleset           *Coding            `bson:"ruleset,omitempty" json:"ruleset,omitempty"`
+	OriginalRuleset   *Coding            `bson:"originalRuleset,omitempty" json:"originalRuleset,omitempty"`
+	Created           *FHIRDateTime      `bson:"created,omitempty" json:"created,omitempty"`
+	Target            *Reference         `bson:"target,omitempty" json:"target,omitempty"`
+	Provider          *Reference         `bson:"provider,omitempty" json:"provider,omitempty"`
+	Organization      *Reference         `bson:"organization,omitempty" json:"organization,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,17 +29,24 @@ package models
 import "encoding/json"
 
 type EligibilityResponse struct {
-	Id                  string        `json:"id" bson:"_id"`
-	Identifier          []Identifier  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Request             *Reference    `bson:"request,omitempty" json:"request,omitempty"`
-	Outcome             string        `bson:"outcome,omitempty" json:"outcome,omitempty"`
-	Disposition         string        `bson:"disposition,omitempty" json:"disposition,omitempty"`
-	Ruleset             *Coding       `bson:"ruleset,omitempty" json:"ruleset,omitempty"`
-	OriginalRuleset     *Coding       `bson:"originalRuleset,omitempty" json:"originalRuleset,omitempty"`
-	Created             *FHIRDateTime `bson:"created,omitempty" json:"created,omitempty"`
-	Organization        *Reference    `bson:"organization,omitempty" json:"organization,omitempty"`
-	RequestProvider     *Reference    `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
-	RequestOrganization *Reference    `bson:"requestOrganization,omitempty" json:"requestOrganization,omitempty"`
+	Id                  string             `json:"id" bson:"_id"`
+	Meta                *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules       string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language            string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text                *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained           ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension           []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension   []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier          []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Request             *Reference         `bson:"request,omitempty" json:"request,omitempty"`
+	Outcome             string             `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	Disposition         string             `bson:"disposition,omitempty" json:"disposition,omitempty"`
+	Ruleset             *Coding            `bson:"ruleset,omitempty" json:"ruleset,omitempty"`
+	OriginalRuleset     *Coding            `bson:"originalRuleset,omitempty" json:"originalRuleset,omitempty"`
+	Created             *FHIRDateTime      `bson:"created,omitempty" json:"created,omitempty"`
+	Organization        *Reference         `bson:"organization,omitempty" json:"organization,omitempty"`
+	RequestProvider     *Reference         `bson:"requestProvider,omitempty" json:"requestProvider,omitempty"`
+	RequestOrganization *Reference         `bson:"requestOrganization,omitempty" json:"requestOrganization,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
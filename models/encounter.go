@@ -29,26 +29,33 @@ package models
 import "encoding/json"
 
 type Encounter struct {
-	Id               string                             `json:"id" bson:"_id"`
-	Identifier       []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status           string                             `bson:"status,omitempty" json:"status,omitempty"`
-	StatusHistory    []EncounterStatusHistoryComponent  `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
-	Class            string                             `bson:"class,omitempty" json:"class,omitempty"`
-	Type             []CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
-	Priority         *CodeableConcept                   `bson:"priority,omitempty" json:"priority,omitempty"`
-	Patient          *Reference                         `bson:"patient,omitempty" json:"patient,omitempty"`
-	EpisodeOfCare    []Reference                        `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
-	IncomingReferral []Reference                        `bson:"incomingReferral,omitempty" json:"incomingReferral,omitempty"`
-	Participant      []EncounterParticipantComponent    `bson:"participant,omitempty" json:"participant,omitempty"`
-	Appointment      *Reference                         `bson:"appointment,omitempty" json:"appointment,omitempty"`
-	Period           *Period                            `bson:"period,omitempty" json:"period,omitempty"`
-	Length           *Quantity                          `bson:"length,omitempty" json:"length,omitempty"`
-	Reason           []CodeableConcept                  `bson:"reason,omitempty" json:"reason,omitempty"`
-	Indication       []Reference                        `bson:"indication,omitempty" json:"indication,omitempty"`
-	Hospitalization  *EncounterHospitalizationComponent `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
-	Location         []EncounterLocationComponent       `bson:"location,omitempty" json:"location,omitempty"`
-	ServiceProvider  *Reference                         `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
-	PartOf           *Reference                         `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Id                string                             `json:"id" bson:"_id"`
+	Meta              *Meta                              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                 `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status            string                             `bson:"status,omitempty" json:"status,omitempty"`
+	StatusHistory     []EncounterStatusHistoryComponent  `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
+	Class             string                             `bson:"class,omitempty" json:"class,omitempty"`
+	Type              []CodeableConcept                  `bson:"type,omitempty" json:"type,omitempty"`
+	Priority          *CodeableConcept                   `bson:"priority,omitempty" json:"priority,omitempty"`
+	Patient           *Reference                         `bson:"patient,omitempty" json:"patient,omitempty"`
+	EpisodeOfCare     []Reference                        `bson:"episodeOfCare,omitempty" json:"episodeOfCare,omitempty"`
+	IncomingReferral  []Reference                        `bson:"incomingReferral,omitempty" json:"incomingReferral,omitempty"`
+	Participant       []EncounterParticipantComponent    `bson:"participant,omitempty" json:"participant,omitempty"`
+	Appointment       *Reference                         `bson:"appointment,omitempty" json:"appointment,omitempty"`
+	Period            *Period                            `bson:"period,omitempty" json:"period,omitempty"`
+	Length            *Quantity                          `bson:"length,omitempty" json:"length,omitempty"`
+	Reason            []CodeableConcept                  `bson:"reason,omitempty" json:"reason,omitempty"`
+	Indication        []Reference                        `bson:"indication,omitempty" json:"indication,omitempty"`
+	Hospitalization   *EncounterHospitalizationComponent `bson:"hospitalization,omitempty" json:"hospitalization,omitempty"`
+	Location          []EncounterLocationComponent       `bson:"location,omitempty" json:"location,omitempty"`
+	ServiceProvider   *Reference                         `bson:"serviceProvider,omitempty" json:"serviceProvider,omitempty"`
+	PartOf            *Reference                         `bson:"partOf,omitempty" json:"partOf,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -30,6 +30,13 @@ import "encoding/json"
 
 type EpisodeOfCare struct {
 	Id                   string                                `json:"id" bson:"_id"`
+	Meta                 *Meta                                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        string                                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language             string                                `bson:"language,omitempty" json:"language,omitempty"`
+	Text                 *Narrative                            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources                    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension                           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension                           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Status               string                                `bson:"status,omitempty" json:"status,omitempty"`
 	StatusHistory        []EpisodeOfCareStatusHistoryComponent `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
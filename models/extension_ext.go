@@ -0,0 +1,86 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Extensions is a list of extensions (e.g., a resource's extension or
+// modifierExtension element) that can be searched by URL.
+type Extensions []Extension
+
+// FindByURL returns a pointer to the first extension with the given URL, or nil
+// if there is no such extension.
+func (slice Extensions) FindByURL(url string) *Extension {
+	for i := range slice {
+		if slice[i].Url == url {
+			return &slice[i]
+		}
+	}
+	return nil
+}
+
+// FilterByURL returns all of the extensions with the given URL.
+func (slice Extensions) FilterByURL(url string) Extensions {
+	var result Extensions
+	for i := range slice {
+		if slice[i].Url == url {
+			result = append(result, slice[i])
+		}
+	}
+	return result
+}
+
+// FindExtension returns a pointer to the first nested extension with the given
+// URL, or nil if there is no such extension.
+func (e *Extension) FindExtension(url string) *Extension {
+	return Extensions(e.Extension).FindByURL(url)
+}
+
+// Value returns the extension's value[x], or nil if the extension has no value
+// (e.g., it is a complex extension made up of nested extensions).
+func (e *Extension) Value() interface{} {
+	v := reflect.ValueOf(e).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if !strings.HasPrefix(t.Field(i).Name, "Value") {
+			continue
+		}
+		f := v.Field(i)
+		switch f.Kind() {
+		case reflect.Ptr:
+			if !f.IsNil() {
+				return f.Interface()
+			}
+		case reflect.String:
+			if f.Len() > 0 {
+				return f.Interface()
+			}
+		}
+	}
+	return nil
+}
+
+// FindExtension returns a pointer to the first extension on the resource with
+// the given URL, or nil if there is no such extension.
+func FindExtension(resource interface{}, url string) *Extension {
+	return findExtensionInField(resource, "Extension", url)
+}
+
+// FindModifierExtension returns a pointer to the first modifier extension on
+// the resource with the given URL, or nil if there is no such extension.
+func FindModifierExtension(resource interface{}, url string) *Extension {
+	return findExtensionInField(resource, "ModifierExtension", url)
+}
+
+func findExtensionInField(resource interface{}, field string, url string) *Extension {
+	v := reflect.Indirect(reflect.ValueOf(resource))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName(field)
+	if !f.IsValid() || f.Type() != reflect.TypeOf([]Extension{}) {
+		return nil
+	}
+	return Extensions(f.Interface().([]Extension)).FindByURL(url)
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/pebbe/util"
+	check "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type ExtensionSuite struct {
+}
+
+var _ = check.Suite(&ExtensionSuite{})
+
+var extendedPatientJSON = []byte(`{
+	"resourceType": "Patient",
+	"id": "123",
+	"extension": [
+		{
+			"url": "http://fhir.org/guides/argonaut/StructureDefinition/argo-race",
+			"extension": [
+				{"url": "ombCategory", "valueCoding": {"system": "urn:oid:2.16.840.1.113883.6.238", "code": "2106-3", "display": "White"}},
+				{"url": "text", "valueString": "White"}
+			]
+		},
+		{"url": "http://example.com/fhir/birthWeight", "valueQuantity": {"value": 3.2, "unit": "kg"}},
+		{"url": "http://example.com/fhir/gp", "valueReference": {"reference": "Practitioner/456"}},
+		{"url": "http://example.com/fhir/score", "valueDecimal": 0.25},
+		{"url": "http://example.com/fhir/source", "valueUri": "http://example.com/source"},
+		{"url": "http://example.com/fhir/status", "valueCode": "active"},
+		{"url": "http://example.com/fhir/registered", "valueInstant": "2015-02-07T13:28:17Z"},
+		{"url": "http://example.com/fhir/enrollment", "valuePeriod": {"start": "2015-01-01"}},
+		{"url": "http://example.com/fhir/mrn", "valueIdentifier": {"system": "http://hospital.example.com", "value": "MRN123"}},
+		{"url": "http://example.com/fhir/consent", "valueAttachment": {"contentType": "application/pdf", "url": "http://example.com/consent.pdf"}}
+	]
+}`)
+
+func (s *ExtensionSuite) TestExtensionJSONAndBSONRoundTrip(c *check.C) {
+	patient := &Patient{}
+	err := json.Unmarshal(extendedPatientJSON, patient)
+	util.CheckErr(err)
+	assertExtendedPatient(c, patient)
+
+	data, err := json.Marshal(patient)
+	util.CheckErr(err)
+	fromJSON := &Patient{}
+	err = json.Unmarshal(data, fromJSON)
+	util.CheckErr(err)
+	assertExtendedPatient(c, fromJSON)
+
+	data, err = bson.Marshal(patient)
+	util.CheckErr(err)
+	fromBSON := &Patient{}
+	err = bson.Unmarshal(data, fromBSON)
+	util.CheckErr(err)
+	assertExtendedPatient(c, fromBSON)
+}
+
+func (s *ExtensionSuite) TestFindExtension(c *check.C) {
+	patient := &Patient{}
+	err := json.Unmarshal(extendedPatientJSON, patient)
+	util.CheckErr(err)
+
+	c.Assert(FindExtension(patient, "http://example.com/fhir/missing"), check.IsNil)
+	c.Assert(FindModifierExtension(patient, "http://example.com/fhir/status"), check.IsNil)
+	c.Assert(FindExtension("not a resource", "http://example.com/fhir/status"), check.IsNil)
+
+	status := FindExtension(patient, "http://example.com/fhir/status")
+	c.Assert(status, check.NotNil)
+	c.Assert(status.Value(), check.Equals, "active")
+
+	race := FindExtension(patient, "http://fhir.org/guides/argonaut/StructureDefinition/argo-race")
+	c.Assert(race.Value(), check.IsNil)
+	c.Assert(race.FindExtension("text").Value(), check.Equals, "White")
+	c.Assert(race.FindExtension("ombCategory").Value(), check.DeepEquals, race.FindExtension("ombCategory").ValueCoding)
+
+	c.Assert(Extensions(patient.Extension).FilterByURL("http://example.com/fhir/gp"), check.HasLen, 1)
+}
+
+func assertExtendedPatient(c *check.C, patient *Patient) {
+	exts := Extensions(patient.Extension)
+	c.Assert(exts, check.HasLen, 10)
+
+	race := exts.FindByURL("http://fhir.org/guides/argonaut/StructureDefinition/argo-race")
+	c.Assert(race.Extension, check.HasLen, 2)
+	c.Assert(race.FindExtension("ombCategory").ValueCoding.Code, check.Equals, "2106-3")
+	c.Assert(race.FindExtension("text").ValueString, check.Equals, "White")
+
+	c.Assert(*exts.FindByURL("http://example.com/fhir/birthWeight").ValueQuantity.Value, check.Equals, 3.2)
+	c.Assert(exts.FindByURL("http://example.com/fhir/gp").ValueReference.ReferencedID, check.Equals, "456")
+	c.Assert(*exts.FindByURL("http://example.com/fhir/score").ValueDecimal, check.Equals, 0.25)
+	c.Assert(exts.FindByURL("http://example.com/fhir/source").ValueUri, check.Equals, "http://example.com/source")
+	c.Assert(exts.FindByURL("http://example.com/fhir/status").ValueCode, check.Equals, "active")
+	c.Assert(exts.FindByURL("http://example.com/fhir/registered").ValueInstant.Time.Unix(), check.Equals, int64(1423315697))
+	c.Assert(exts.FindByURL("http://example.com/fhir/enrollment").ValuePeriod.Start.Precision, check.Equals, Precision(Date))
+	c.Assert(exts.FindByURL("http://example.com/fhir/mrn").ValueIdentifier.Value, check.Equals, "MRN123")
+	c.Assert(exts.FindByURL("http://example.com/fhir/consent").ValueAttachment.ContentType, check.Equals, "application/pdf")
+}
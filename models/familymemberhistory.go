@@ -29,27 +29,34 @@ package models
 import "encoding/json"
 
 type FamilyMemberHistory struct {
-	Id              string                                  `json:"id" bson:"_id"`
-	Identifier      []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Patient         *Reference                              `bson:"patient,omitempty" json:"patient,omitempty"`
-	Date            *FHIRDateTime                           `bson:"date,omitempty" json:"date,omitempty"`
-	Status          string                                  `bson:"status,omitempty" json:"status,omitempty"`
-	Name            string                                  `bson:"name,omitempty" json:"name,omitempty"`
-	Relationship    *CodeableConcept                        `bson:"relationship,omitempty" json:"relationship,omitempty"`
-	Gender          string                                  `bson:"gender,omitempty" json:"gender,omitempty"`
-	BornPeriod      *Period                                 `bson:"bornPeriod,omitempty" json:"bornPeriod,omitempty"`
-	BornDate        *FHIRDateTime                           `bson:"bornDate,omitempty" json:"bornDate,omitempty"`
-	BornString      string                                  `bson:"bornString,omitempty" json:"bornString,omitempty"`
-	AgeAge          *Quantity                               `bson:"ageAge,omitempty" json:"ageAge,omitempty"`
-	AgeRange        *Range                                  `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
-	AgeString       string                                  `bson:"ageString,omitempty" json:"ageString,omitempty"`
-	DeceasedBoolean *bool                                   `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
-	DeceasedAge     *Quantity                               `bson:"deceasedAge,omitempty" json:"deceasedAge,omitempty"`
-	DeceasedRange   *Range                                  `bson:"deceasedRange,omitempty" json:"deceasedRange,omitempty"`
-	DeceasedDate    *FHIRDateTime                           `bson:"deceasedDate,omitempty" json:"deceasedDate,omitempty"`
-	DeceasedString  string                                  `bson:"deceasedString,omitempty" json:"deceasedString,omitempty"`
-	Note            *Annotation                             `bson:"note,omitempty" json:"note,omitempty"`
-	Condition       []FamilyMemberHistoryConditionComponent `bson:"condition,omitempty" json:"condition,omitempty"`
+	Id                string                                  `json:"id" bson:"_id"`
+	Meta              *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Patient           *Reference                              `bson:"patient,omitempty" json:"patient,omitempty"`
+	Date              *FHIRDateTime                           `bson:"date,omitempty" json:"date,omitempty"`
+	Status            string                                  `bson:"status,omitempty" json:"status,omitempty"`
+	Name              string                                  `bson:"name,omitempty" json:"name,omitempty"`
+	Relationship      *CodeableConcept                        `bson:"relationship,omitempty" json:"relationship,omitempty"`
+	Gender            string                                  `bson:"gender,omitempty" json:"gender,omitempty"`
+	BornPeriod        *Period                                 `bson:"bornPeriod,omitempty" json:"bornPeriod,omitempty"`
+	BornDate          *FHIRDateTime                           `bson:"bornDate,omitempty" json:"bornDate,omitempty"`
+	BornString        string                                  `bson:"bornString,omitempty" json:"bornString,omitempty"`
+	AgeAge            *Quantity                               `bson:"ageAge,omitempty" json:"ageAge,omitempty"`
+	AgeRange          *Range                                  `bson:"ageRange,omitempty" json:"ageRange,omitempty"`
+	AgeString         string                                  `bson:"ageString,omitempty" json:"ageString,omitempty"`
+	DeceasedBoolean   *bool                                   `bson:"deceasedBoolean,omitempty" json:"deceasedBoolean,omitempty"`
+	DeceasedAge       *Quantity                               `bson:"deceasedAge,omitempty" json:"deceasedAge,omitempty"`
+	DeceasedRange     *Range                                  `bson:"deceasedRange,omitempty" json:"deceasedRange,omitempty"`
+	DeceasedDate      *FHIRDateTime                           `bson:"deceasedDate,omitempty" json:"deceasedDate,omitempty"`
+	DeceasedString    string                                  `bson:"deceasedString,omitempty" json:"deceasedString,omitempty"`
+	Note              *Annotation                             `bson:"note,omitempty" json:"note,omitempty"`
+	Condition         []FamilyMemberHistoryConditionComponent `bson:"condition,omitempty" json:"condition,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
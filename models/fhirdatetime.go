@@ -3,11 +3,15 @@ package models
 import (
 	"encoding/json"
 	"time"
+
+	"gopkg.in/mgo.v2/bson"
 )
 
 type Precision string
 
 const (
+	Year      = "year"
+	YearMonth = "month"
 	Date      = "date"
 	Timestamp = "timestamp"
 )
@@ -18,11 +22,18 @@ type FHIRDateTime struct {
 }
 
 func (f *FHIRDateTime) UnmarshalJSON(data []byte) (err error) {
-	if len(data) <= 12 {
-		f.Precision = Precision("date")
+	switch {
+	case len(data) <= 6:
+		f.Precision = Precision(Year)
+		f.Time, err = time.Parse("\"2006\"", string(data))
+	case len(data) <= 9:
+		f.Precision = Precision(YearMonth)
+		f.Time, err = time.Parse("\"2006-01\"", string(data))
+	case len(data) <= 12:
+		f.Precision = Precision(Date)
 		f.Time, err = time.Parse("\"2006-01-02\"", string(data))
-	} else {
-		f.Precision = Precision("timestamp")
+	default:
+		f.Precision = Precision(Timestamp)
 		f.Time = time.Time{}
 		f.Time.UnmarshalJSON(data)
 	}
@@ -30,9 +41,62 @@ func (f *FHIRDateTime) UnmarshalJSON(data []byte) (err error) {
 }
 
 func (f FHIRDateTime) MarshalJSON() ([]byte, error) {
-	if f.Precision == Timestamp {
+	switch f.Precision {
+	case Timestamp:
 		return json.Marshal(f.Time.Format(time.RFC3339))
-	} else {
+	case Year:
+		return json.Marshal(f.Time.Format("2006"))
+	case YearMonth:
+		return json.Marshal(f.Time.Format("2006-01"))
+	default:
 		return json.Marshal(f.Time.Format("2006-01-02"))
 	}
 }
+
+// Low returns the (inclusive) start of the range of time the value covers.
+// A value such as "2012-03" is the whole of March 2012, not just its first
+// instant.
+func (f FHIRDateTime) Low() time.Time {
+	return f.Time
+}
+
+// High returns the (exclusive) end of the range of time the value covers.
+func (f FHIRDateTime) High() time.Time {
+	switch f.Precision {
+	case Year:
+		return f.Time.AddDate(1, 0, 0)
+	case YearMonth:
+		return f.Time.AddDate(0, 1, 0)
+	case Date:
+		return f.Time.AddDate(0, 0, 1)
+	default:
+		if f.Time.Nanosecond() != 0 {
+			return f.Time.Add(time.Millisecond)
+		}
+		return f.Time.Add(time.Second)
+	}
+}
+
+// fhirDateTimeBSON is how a FHIRDateTime is stored.  Alongside the time and
+// precision, the range of time the value covers is stored so that it can be
+// indexed and searched.
+type fhirDateTimeBSON struct {
+	Time      time.Time `bson:"time"`
+	Precision Precision `bson:"precision"`
+	Low       time.Time `bson:"low"`
+	High      time.Time `bson:"high"`
+}
+
+func (f FHIRDateTime) GetBSON() (interface{}, error) {
+	return fhirDateTimeBSON{Time: f.Time, Precision: f.Precision, Low: f.Low(), High: f.High()}, nil
+}
+
+func (f *FHIRDateTime) SetBSON(raw bson.Raw) error {
+	var stored fhirDateTimeBSON
+	if err := raw.Unmarshal(&stored); err != nil {
+		return err
+	}
+	f.Time = stored.Time
+	f.Precision = stored.Precision
+	return nil
+}
@@ -3,9 +3,11 @@ package models
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/pebbe/util"
 	check "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
 )
 
 type FDSuite struct {
@@ -38,3 +40,60 @@ func (s *FDSuite) TestFHIRDateTime(c *check.C) {
 	c.Assert(simple.Foo[2].Time.Unix(), check.Equals, int64(728578800))
 	c.Assert(simple.Foo[2].Precision, check.Equals, Precision(Timestamp))
 }
+
+func (s *FDSuite) TestPartialDates(c *check.C) {
+	simple := &Simple{}
+
+	data := []byte("{ \"foo\": [\"1991\", \"1992-02\"]}")
+	err := json.Unmarshal(data, &simple)
+	util.CheckErr(err)
+
+	c.Assert(simple.Foo, check.HasLen, 2)
+	c.Assert(simple.Foo[0].Time, check.DeepEquals, time.Date(1991, time.January, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(simple.Foo[0].Precision, check.Equals, Precision(Year))
+	c.Assert(simple.Foo[1].Time, check.DeepEquals, time.Date(1992, time.February, 1, 0, 0, 0, 0, time.UTC))
+	c.Assert(simple.Foo[1].Precision, check.Equals, Precision(YearMonth))
+
+	output, err := json.Marshal(simple)
+	util.CheckErr(err)
+	c.Assert(string(output), check.Equals, "{\"foo\":[\"1991\",\"1992-02\"]}")
+}
+
+func (s *FDSuite) TestRanges(c *check.C) {
+	t := time.Date(1992, time.February, 28, 10, 30, 0, 0, time.UTC)
+
+	ranges := []struct {
+		value FHIRDateTime
+		high  time.Time
+	}{
+		{FHIRDateTime{Time: t, Precision: Timestamp}, t.Add(time.Second)},
+		{FHIRDateTime{Time: t.Add(500 * time.Millisecond), Precision: Timestamp}, t.Add(501 * time.Millisecond)},
+		{FHIRDateTime{Time: time.Date(1992, time.February, 28, 0, 0, 0, 0, time.UTC), Precision: Date}, time.Date(1992, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{FHIRDateTime{Time: time.Date(1992, time.February, 1, 0, 0, 0, 0, time.UTC), Precision: YearMonth}, time.Date(1992, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{FHIRDateTime{Time: time.Date(1992, time.January, 1, 0, 0, 0, 0, time.UTC), Precision: Year}, time.Date(1993, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, r := range ranges {
+		c.Assert(r.value.Low(), check.DeepEquals, r.value.Time)
+		c.Assert(r.value.High(), check.DeepEquals, r.high)
+	}
+}
+
+func (s *FDSuite) TestBSONStoresRange(c *check.C) {
+	simple := &Simple{Foo: []FHIRDateTime{{Time: time.Date(1992, time.February, 1, 0, 0, 0, 0, time.UTC), Precision: YearMonth}}}
+
+	data, err := bson.Marshal(simple)
+	util.CheckErr(err)
+
+	stored := bson.M{}
+	util.CheckErr(bson.Unmarshal(data, stored))
+	foo := stored["foo"].([]interface{})[0].(bson.M)
+	c.Assert(foo["precision"], check.Equals, YearMonth)
+	c.Assert(foo["low"].(time.Time).Equal(time.Date(1992, time.February, 1, 0, 0, 0, 0, time.UTC)), check.Equals, true)
+	c.Assert(foo["high"].(time.Time).Equal(time.Date(1992, time.March, 1, 0, 0, 0, 0, time.UTC)), check.Equals, true)
+
+	loaded := &Simple{}
+	util.CheckErr(bson.Unmarshal(data, loaded))
+	c.Assert(loaded.Foo, check.HasLen, 1)
+	c.Assert(loaded.Foo[0].Time.Equal(simple.Foo[0].Time), check.Equals, true)
+	c.Assert(loaded.Foo[0].Precision, check.Equals, Precision(YearMonth))
+}
@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Flag struct {
-	Id         string           `json:"id" bson:"_id"`
-	Identifier []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Category   *CodeableConcept `bson:"category,omitempty" json:"category,omitempty"`
-	Status     string           `bson:"status,omitempty" json:"status,omitempty"`
-	Period     *Period          `bson:"period,omitempty" json:"period,omitempty"`
-	Subject    *Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
-	Encounter  *Reference       `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Author     *Reference       `bson:"author,omitempty" json:"author,omitempty"`
-	Code       *CodeableConcept `bson:"code,omitempty" json:"code,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Category          *CodeableConcept   `bson:"category,omitempty" json:"category,omitempty"`
+	Status            string             `bson:"status,omitempty" json:"status,omitempty"`
+	Period            *Period            `bson:"period,omitempty" json:"period,omitempty"`
+	Subject           *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	Encounter         *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Author            *Reference         `bson:"author,omitempty" json:"author,omitempty"`
+	Code              *CodeableConcept   `bson:"code,omitempty" json:"code,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
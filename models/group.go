@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Group struct {
-	Id             string                         `json:"id" bson:"_id"`
-	Identifier     []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type           string                         `bson:"type,omitempty" json:"type,omitempty"`
-	Actual         *bool                          `bson:"actual,omitempty" json:"actual,omitempty"`
-	Code           *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Name           string                         `bson:"name,omitempty" json:"name,omitempty"`
-	Quantity       *uint32                        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	Characteristic []GroupCharacteristicComponent `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
-	Member         []GroupMemberComponent         `bson:"member,omitempty" json:"member,omitempty"`
+	Id                string                         `json:"id" bson:"_id"`
+	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                         `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type              string                         `bson:"type,omitempty" json:"type,omitempty"`
+	Actual            *bool                          `bson:"actual,omitempty" json:"actual,omitempty"`
+	Code              *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Name              string                         `bson:"name,omitempty" json:"name,omitempty"`
+	Quantity          *uint32                        `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	Characteristic    []GroupCharacteristicComponent `bson:"characteristic,omitempty" json:"characteristic,omitempty"`
+	Member            []GroupMemberComponent         `bson:"member,omitempty" json:"member,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,14 +29,21 @@ package models
 import "encoding/json"
 
 type ImagingObjectSelection struct {
-	Id            string                                 `json:"id" bson:"_id"`
-	Uid           string                                 `bson:"uid,omitempty" json:"uid,omitempty"`
-	Patient       *Reference                             `bson:"patient,omitempty" json:"patient,omitempty"`
-	Title         *CodeableConcept                       `bson:"title,omitempty" json:"title,omitempty"`
-	Description   string                                 `bson:"description,omitempty" json:"description,omitempty"`
-	Author        *Reference                             `bson:"author,omitempty" json:"author,omitempty"`
-	AuthoringTime *FHIRDateTime                          `bson:"authoringTime,omitempty" json:"authoringTime,omitempty"`
-	Study         []ImagingObjectSelectionStudyComponent `bson:"study,omitempty" json:"study,omitempty"`
+	Id                string                                 `json:"id" bson:"_id"`
+	Meta              *Meta                                  `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                 `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                 `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                             `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                     `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                            `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                            `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Uid               string                                 `bson:"uid,omitempty" json:"uid,omitempty"`
+	Patient           *Reference                             `bson:"patient,omitempty" json:"patient,omitempty"`
+	Title             *CodeableConcept                       `bson:"title,omitempty" json:"title,omitempty"`
+	Description       string                                 `bson:"description,omitempty" json:"description,omitempty"`
+	Author            *Reference                             `bson:"author,omitempty" json:"author,omitempty"`
+	AuthoringTime     *FHIRDateTime                          `bson:"authoringTime,omitempty" json:"authoringTime,omitempty"`
+	Study             []ImagingObjectSelectionStudyComponent `bson:"study,omitempty" json:"study,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
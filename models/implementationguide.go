@@ -29,24 +29,31 @@ package models
 import "encoding/json"
 
 type ImplementationGuide struct {
-	Id           string                                   `json:"id" bson:"_id"`
-	Url          string                                   `bson:"url,omitempty" json:"url,omitempty"`
-	Version      string                                   `bson:"version,omitempty" json:"version,omitempty"`
-	Name         string                                   `bson:"name,omitempty" json:"name,omitempty"`
-	Status       string                                   `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental *bool                                    `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher    string                                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact      []ImplementationGuideContactComponent    `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date         *FHIRDateTime                            `bson:"date,omitempty" json:"date,omitempty"`
-	Description  string                                   `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext   []CodeableConcept                        `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Copyright    string                                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	FhirVersion  string                                   `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Dependency   []ImplementationGuideDependencyComponent `bson:"dependency,omitempty" json:"dependency,omitempty"`
-	Package      []ImplementationGuidePackageComponent    `bson:"package,omitempty" json:"package,omitempty"`
-	Global       []ImplementationGuideGlobalComponent     `bson:"global,omitempty" json:"global,omitempty"`
-	Binary       []string                                 `bson:"binary,omitempty" json:"binary,omitempty"`
-	Page         *ImplementationGuidePageComponent        `bson:"page,omitempty" json:"page,omitempty"`
+	Id                string                                   `json:"id" bson:"_id"`
+	Meta              *Meta                                    `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                   `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                   `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                               `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                       `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                              `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                              `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                                   `bson:"url,omitempty" json:"url,omitempty"`
+	Version           string                                   `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                                   `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                                   `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                                    `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                                   `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []ImplementationGuideContactComponent    `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                            `bson:"date,omitempty" json:"date,omitempty"`
+	Description       string                                   `bson:"description,omitempty" json:"description,omitempty"`
+	UseContext        []CodeableConcept                        `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Copyright         string                                   `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	FhirVersion       string                                   `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	Dependency        []ImplementationGuideDependencyComponent `bson:"dependency,omitempty" json:"dependency,omitempty"`
+	Package           []ImplementationGuidePackageComponent    `bson:"package,omitempty" json:"package,omitempty"`
+	Global            []ImplementationGuideGlobalComponent     `bson:"global,omitempty" json:"global,omitempty"`
+	Binary            []string                                 `bson:"binary,omitempty" json:"binary,omitempty"`
+	Page              *ImplementationGuidePageComponent        `bson:"page,omitempty" json:"page,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
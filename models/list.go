@@ -29,20 +29,27 @@ package models
 import "encoding/json"
 
 type List struct {
-	Id          string               `json:"id" bson:"_id"`
-	Identifier  []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Title       string               `bson:"title,omitempty" json:"title,omitempty"`
-	Code        *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
-	Subject     *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Source      *Reference           `bson:"source,omitempty" json:"source,omitempty"`
-	Encounter   *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Status      string               `bson:"status,omitempty" json:"status,omitempty"`
-	Date        *FHIRDateTime        `bson:"date,omitempty" json:"date,omitempty"`
-	OrderedBy   *CodeableConcept     `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
-	Mode        string               `bson:"mode,omitempty" json:"mode,omitempty"`
-	Note        string               `bson:"note,omitempty" json:"note,omitempty"`
-	Entry       []ListEntryComponent `bson:"entry,omitempty" json:"entry,omitempty"`
-	EmptyReason *CodeableConcept     `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
+	Id                string               `json:"id" bson:"_id"`
+	Meta              *Meta                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string               `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Title             string               `bson:"title,omitempty" json:"title,omitempty"`
+	Code              *CodeableConcept     `bson:"code,omitempty" json:"code,omitempty"`
+	Subject           *Reference           `bson:"subject,omitempty" json:"subject,omitempty"`
+	Source            *Reference           `bson:"source,omitempty" json:"source,omitempty"`
+	Encounter         *Reference           `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Status            string               `bson:"status,omitempty" json:"status,omitempty"`
+	Date              *FHIRDateTime        `bson:"date,omitempty" json:"date,omitempty"`
+	OrderedBy         *CodeableConcept     `bson:"orderedBy,omitempty" json:"orderedBy,omitempty"`
+	Mode              string               `bson:"mode,omitempty" json:"mode,omitempty"`
+	Note              string               `bson:"note,omitempty" json:"note,omitempty"`
+	Entry             []ListEntryComponent `bson:"entry,omitempty" json:"entry,omitempty"`
+	EmptyReason       *CodeableConcept     `bson:"emptyReason,omitempty" json:"emptyReason,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,19 +29,26 @@ package models
 import "encoding/json"
 
 type Media struct {
-	Id         string           `json:"id" bson:"_id"`
-	Type       string           `bson:"type,omitempty" json:"type,omitempty"`
-	Subtype    *CodeableConcept `bson:"subtype,omitempty" json:"subtype,omitempty"`
-	Identifier []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Subject    *Reference       `bson:"subject,omitempty" json:"subject,omitempty"`
-	Operator   *Reference       `bson:"operator,omitempty" json:"operator,omitempty"`
-	View       *CodeableConcept `bson:"view,omitempty" json:"view,omitempty"`
-	DeviceName string           `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
-	Height     *uint32          `bson:"height,omitempty" json:"height,omitempty"`
-	Width      *uint32          `bson:"width,omitempty" json:"width,omitempty"`
-	Frames     *uint32          `bson:"frames,omitempty" json:"frames,omitempty"`
-	Duration   *uint32          `bson:"duration,omitempty" json:"duration,omitempty"`
-	Content    *Attachment      `bson:"content,omitempty" json:"content,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Type              string             `bson:"type,omitempty" json:"type,omitempty"`
+	Subtype           *CodeableConcept   `bson:"subtype,omitempty" json:"subtype,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Subject           *Reference         `bson:"subject,omitempty" json:"subject,omitempty"`
+	Operator          *Reference         `bson:"operator,omitempty" json:"operator,omitempty"`
+	View              *CodeableConcept   `bson:"view,omitempty" json:"view,omitempty"`
+	DeviceName        string             `bson:"deviceName,omitempty" json:"deviceName,omitempty"`
+	Height            *uint32            `bson:"height,omitempty" json:"height,omitempty"`
+	Width             *uint32            `bson:"width,omitempty" json:"width,omitempty"`
+	Frames            *uint32            `bson:"frames,omitempty" json:"frames,omitempty"`
+	Duration          *uint32            `bson:"duration,omitempty" json:"duration,omitempty"`
+	Content           *Attachment        `bson:"content,omitempty" json:"content,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,12 +29,19 @@ package models
 import "encoding/json"
 
 type Medication struct {
-	Id           string                      `json:"id" bson:"_id"`
-	Code         *CodeableConcept            `bson:"code,omitempty" json:"code,omitempty"`
-	IsBrand      *bool                       `bson:"isBrand,omitempty" json:"isBrand,omitempty"`
-	Manufacturer *Reference                  `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
-	Product      *MedicationProductComponent `bson:"product,omitempty" json:"product,omitempty"`
-	Package      *MedicationPackageComponent `bson:"package,omitempty" json:"package,omitempty"`
+	Id                string                      `json:"id" bson:"_id"`
+	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                      `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Code              *CodeableConcept            `bson:"code,omitempty" json:"code,omitempty"`
+	IsBrand           *bool                       `bson:"isBrand,omitempty" json:"isBrand,omitempty"`
+	Manufacturer      *Reference                  `bson:"manufacturer,omitempty" json:"manufacturer,omitempty"`
+	Product           *MedicationProductComponent `bson:"product,omitempty" json:"product,omitempty"`
+	Package           *MedicationPackageComponent `bson:"package,omitempty" json:"package,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,18 +29,25 @@ package models
 import "encoding/json"
 
 type MessageHeader struct {
-	Id          string                                     `json:"id" bson:"_id"`
-	Timestamp   *FHIRDateTime                              `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
-	Event       *Coding                                    `bson:"event,omitempty" json:"event,omitempty"`
-	Response    *MessageHeaderResponseComponent            `bson:"response,omitempty" json:"response,omitempty"`
-	Source      *MessageHeaderMessageSourceComponent       `bson:"source,omitempty" json:"source,omitempty"`
-	Destination []MessageHeaderMessageDestinationComponent `bson:"destination,omitempty" json:"destination,omitempty"`
-	Enterer     *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
-	Author      *Reference                                 `bson:"author,omitempty" json:"author,omitempty"`
-	Receiver    *Reference                                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
-	Responsible *Reference                                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Reason      *CodeableConcept                           `bson:"reason,omitempty" json:"reason,omitempty"`
-	Data        []Reference                                `bson:"data,omitempty" json:"data,omitempty"`
+	Id                string                                     `json:"id" bson:"_id"`
+	Meta              *Meta                                      `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                     `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                     `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                                 `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                         `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                                `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                                `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Timestamp         *FHIRDateTime                              `bson:"timestamp,omitempty" json:"timestamp,omitempty"`
+	Event             *Coding                                    `bson:"event,omitempty" json:"event,omitempty"`
+	Response          *MessageHeaderResponseComponent            `bson:"response,omitempty" json:"response,omitempty"`
+	Source            *MessageHeaderMessageSourceComponent       `bson:"source,omitempty" json:"source,omitempty"`
+	Destination       []MessageHeaderMessageDestinationComponent `bson:"destination,omitempty" json:"destination,omitempty"`
+	Enterer           *Reference                                 `bson:"enterer,omitempty" json:"enterer,omitempty"`
+	Author            *Reference                                 `bson:"author,omitempty" json:"author,omitempty"`
+	Receiver          *Reference                                 `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	Responsible       *Reference                                 `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Reason            *CodeableConcept                           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Data              []Reference                                `bson:"data,omitempty" json:"data,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,20 +29,27 @@ package models
 import "encoding/json"
 
 type NamingSystem struct {
-	Id          string                          `json:"id" bson:"_id"`
-	Name        string                          `bson:"name,omitempty" json:"name,omitempty"`
-	Status      string                          `bson:"status,omitempty" json:"status,omitempty"`
-	Kind        string                          `bson:"kind,omitempty" json:"kind,omitempty"`
-	Publisher   string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact     []NamingSystemContactComponent  `bson:"contact,omitempty" json:"contact,omitempty"`
-	Responsible string                          `bson:"responsible,omitempty" json:"responsible,omitempty"`
-	Date        *FHIRDateTime                   `bson:"date,omitempty" json:"date,omitempty"`
-	Type        *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
-	Description string                          `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext  []CodeableConcept               `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Usage       string                          `bson:"usage,omitempty" json:"usage,omitempty"`
-	UniqueId    []NamingSystemUniqueIdComponent `bson:"uniqueId,omitempty" json:"uniqueId,omitempty"`
-	ReplacedBy  *Reference                      `bson:"replacedBy,omitempty" json:"replacedBy,omitempty"`
+	Id                string                          `json:"id" bson:"_id"`
+	Meta              *Meta                           `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                          `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                          `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                      `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources              `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                     `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                     `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Name              string                          `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                          `bson:"status,omitempty" json:"status,omitempty"`
+	Kind              string                          `bson:"kind,omitempty" json:"kind,omitempty"`
+	Publisher         string                          `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []NamingSystemContactComponent  `bson:"contact,omitempty" json:"contact,omitempty"`
+	Responsible       string                          `bson:"responsible,omitempty" json:"responsible,omitempty"`
+	Date              *FHIRDateTime                   `bson:"date,omitempty" json:"date,omitempty"`
+	Type              *CodeableConcept                `bson:"type,omitempty" json:"type,omitempty"`
+	Description       string                          `bson:"description,omitempty" json:"description,omitempty"`
+	UseContext        []CodeableConcept               `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Usage             string                          `bson:"usage,omitempty" json:"usage,omitempty"`
+	UniqueId          []NamingSystemUniqueIdComponent `bson:"uniqueId,omitempty" json:"uniqueId,omitempty"`
+	ReplacedBy        *Reference                      `bson:"replacedBy,omitempty" json:"replacedBy,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
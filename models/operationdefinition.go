@@ -29,26 +29,33 @@ package models
 import "encoding/json"
 
 type OperationDefinition struct {
-	Id           string                                  `json:"id" bson:"_id"`
-	Url          string                                  `bson:"url,omitempty" json:"url,omitempty"`
-	Version      string                                  `bson:"version,omitempty" json:"version,omitempty"`
-	Name         string                                  `bson:"name,omitempty" json:"name,omitempty"`
-	Status       string                                  `bson:"status,omitempty" json:"status,omitempty"`
-	Kind         string                                  `bson:"kind,omitempty" json:"kind,omitempty"`
-	Experimental *bool                                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher    string                                  `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact      []OperationDefinitionContactComponent   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date         *FHIRDateTime                           `bson:"date,omitempty" json:"date,omitempty"`
-	Description  string                                  `bson:"description,omitempty" json:"description,omitempty"`
-	Requirements string                                  `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Idempotent   *bool                                   `bson:"idempotent,omitempty" json:"idempotent,omitempty"`
-	Code         string                                  `bson:"code,omitempty" json:"code,omitempty"`
-	Notes        string                                  `bson:"notes,omitempty" json:"notes,omitempty"`
-	Base         *Reference                              `bson:"base,omitempty" json:"base,omitempty"`
-	System       *bool                                   `bson:"system,omitempty" json:"system,omitempty"`
-	Type         []string                                `bson:"type,omitempty" json:"type,omitempty"`
-	Instance     *bool                                   `bson:"instance,omitempty" json:"instance,omitempty"`
-	Parameter    []OperationDefinitionParameterComponent `bson:"parameter,omitempty" json:"parameter,omitempty"`
+	Id                string                                  `json:"id" bson:"_id"`
+	Meta              *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                                  `bson:"url,omitempty" json:"url,omitempty"`
+	Version           string                                  `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                                  `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                                  `bson:"status,omitempty" json:"status,omitempty"`
+	Kind              string                                  `bson:"kind,omitempty" json:"kind,omitempty"`
+	Experimental      *bool                                   `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                                  `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []OperationDefinitionContactComponent   `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                           `bson:"date,omitempty" json:"date,omitempty"`
+	Description       string                                  `bson:"description,omitempty" json:"description,omitempty"`
+	Requirements      string                                  `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Idempotent        *bool                                   `bson:"idempotent,omitempty" json:"idempotent,omitempty"`
+	Code              string                                  `bson:"code,omitempty" json:"code,omitempty"`
+	Notes             string                                  `bson:"notes,omitempty" json:"notes,omitempty"`
+	Base              *Reference                              `bson:"base,omitempty" json:"base,omitempty"`
+	System            *bool                                   `bson:"system,omitempty" json:"system,omitempty"`
+	Type              []string                                `bson:"type,omitempty" json:"type,omitempty"`
+	Instance          *bool                                   `bson:"instance,omitempty" json:"instance,omitempty"`
+	Parameter         []OperationDefinitionParameterComponent `bson:"parameter,omitempty" json:"parameter,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
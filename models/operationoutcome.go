@@ -29,8 +29,15 @@ package models
 import "encoding/json"
 
 type OperationOutcome struct {
-	Id    string                           `json:"id" bson:"_id"`
-	Issue []OperationOutcomeIssueComponent `bson:"issue,omitempty" json:"issue,omitempty"`
+	Id                string                           `json:"id" bson:"_id"`
+	Meta              *Meta                            `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                           `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                           `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                       `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources               `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                      `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                      `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Issue             []OperationOutcomeIssueComponent `bson:"issue,omitempty" json:"issue,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
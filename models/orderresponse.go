@@ -29,14 +29,21 @@ package models
 import "encoding/json"
 
 type OrderResponse struct {
-	Id          string        `json:"id" bson:"_id"`
-	Identifier  []Identifier  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Request     *Reference    `bson:"request,omitempty" json:"request,omitempty"`
-	Date        *FHIRDateTime `bson:"date,omitempty" json:"date,omitempty"`
-	Who         *Reference    `bson:"who,omitempty" json:"who,omitempty"`
-	OrderStatus string        `bson:"orderStatus,omitempty" json:"orderStatus,omitempty"`
-	Description string        `bson:"description,omitempty" json:"description,omitempty"`
-	Fulfillment []Reference   `bson:"fulfillment,omitempty" json:"fulfillment,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Request           *Reference         `bson:"request,omitempty" json:"request,omitempty"`
+	Date              *FHIRDateTime      `bson:"date,omitempty" json:"date,omitempty"`
+	Who               *Reference         `bson:"who,omitempty" json:"who,omitempty"`
+	OrderStatus       string             `bson:"orderStatus,omitempty" json:"orderStatus,omitempty"`
+	Description       string             `bson:"description,omitempty" json:"description,omitempty"`
+	Fulfillment       []Reference        `bson:"fulfillment,omitempty" json:"fulfillment,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
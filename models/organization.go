@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Organization struct {
-	Id         string                         `json:"id" bson:"_id"`
-	Identifier []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active     *bool                          `bson:"active,omitempty" json:"active,omitempty"`
-	Type       *CodeableConcept               `bson:"type,omitempty" json:"type,omitempty"`
-	Name       string                         `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom    []ContactPoint                 `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address    []Address                      `bson:"address,omitempty" json:"address,omitempty"`
-	PartOf     *Reference                     `bson:"partOf,omitempty" json:"partOf,omitempty"`
-	Contact    []OrganizationContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
+	Id                string                         `json:"id" bson:"_id"`
+	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                         `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active            *bool                          `bson:"active,omitempty" json:"active,omitempty"`
+	Type              *CodeableConcept               `bson:"type,omitempty" json:"type,omitempty"`
+	Name              string                         `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom           []ContactPoint                 `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address           []Address                      `bson:"address,omitempty" json:"address,omitempty"`
+	PartOf            *Reference                     `bson:"partOf,omitempty" json:"partOf,omitempty"`
+	Contact           []OrganizationContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -30,6 +30,13 @@ import "encoding/json"
 
 type Person struct {
 	Id                   string                `json:"id" bson:"_id"`
+	Meta                 *Meta                 `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules        string                `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language             string                `bson:"language,omitempty" json:"language,omitempty"`
+	Text                 *Narrative            `bson:"text,omitempty" json:"text,omitempty"`
+	Contained            ContainedResources    `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension            []Extension           `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension    []Extension           `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
 	Identifier           []Identifier          `bson:"identifier,omitempty" json:"identifier,omitempty"`
 	Name                 []HumanName           `bson:"name,omitempty" json:"name,omitempty"`
 	Telecom              []ContactPoint        `bson:"telecom,omitempty" json:"telecom,omitempty"`
@@ -29,18 +29,25 @@ package models
 import "encoding/json"
 
 type Practitioner struct {
-	Id               string                                  `json:"id" bson:"_id"`
-	Identifier       []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Active           *bool                                   `bson:"active,omitempty" json:"active,omitempty"`
-	Name             *HumanName                              `bson:"name,omitempty" json:"name,omitempty"`
-	Telecom          []ContactPoint                          `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	Address          []Address                               `bson:"address,omitempty" json:"address,omitempty"`
-	Gender           string                                  `bson:"gender,omitempty" json:"gender,omitempty"`
-	BirthDate        *FHIRDateTime                           `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
-	Photo            []Attachment                            `bson:"photo,omitempty" json:"photo,omitempty"`
-	PractitionerRole []PractitionerPractitionerRoleComponent `bson:"practitionerRole,omitempty" json:"practitionerRole,omitempty"`
-	Qualification    []PractitionerQualificationComponent    `bson:"qualification,omitempty" json:"qualification,omitempty"`
-	Communication    []CodeableConcept                       `bson:"communication,omitempty" json:"communication,omitempty"`
+	Id                string                                  `json:"id" bson:"_id"`
+	Meta              *Meta                                   `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                  `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                  `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                              `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                      `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                             `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                             `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                            `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Active            *bool                                   `bson:"active,omitempty" json:"active,omitempty"`
+	Name              *HumanName                              `bson:"name,omitempty" json:"name,omitempty"`
+	Telecom           []ContactPoint                          `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	Address           []Address                               `bson:"address,omitempty" json:"address,omitempty"`
+	Gender            string                                  `bson:"gender,omitempty" json:"gender,omitempty"`
+	BirthDate         *FHIRDateTime                           `bson:"birthDate,omitempty" json:"birthDate,omitempty"`
+	Photo             []Attachment                            `bson:"photo,omitempty" json:"photo,omitempty"`
+	PractitionerRole  []PractitionerPractitionerRoleComponent `bson:"practitionerRole,omitempty" json:"practitionerRole,omitempty"`
+	Qualification     []PractitionerQualificationComponent    `bson:"qualification,omitempty" json:"qualification,omitempty"`
+	Communication     []CodeableConcept                       `bson:"communication,omitempty" json:"communication,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,17 +29,24 @@ package models
 import "encoding/json"
 
 type Provenance struct {
-	Id        string                      `json:"id" bson:"_id"`
-	Target    []Reference                 `bson:"target,omitempty" json:"target,omitempty"`
-	Period    *Period                     `bson:"period,omitempty" json:"period,omitempty"`
-	Recorded  *FHIRDateTime               `bson:"recorded,omitempty" json:"recorded,omitempty"`
-	Reason    []CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
-	Activity  *CodeableConcept            `bson:"activity,omitempty" json:"activity,omitempty"`
-	Location  *Reference                  `bson:"location,omitempty" json:"location,omitempty"`
-	Policy    []string                    `bson:"policy,omitempty" json:"policy,omitempty"`
-	Agent     []ProvenanceAgentComponent  `bson:"agent,omitempty" json:"agent,omitempty"`
-	Entity    []ProvenanceEntityComponent `bson:"entity,omitempty" json:"entity,omitempty"`
-	Signature []Signature                 `bson:"signature,omitempty" json:"signature,omitempty"`
+	Id                string                      `json:"id" bson:"_id"`
+	Meta              *Meta                       `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                      `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                      `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                  `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources          `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                 `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                 `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Target            []Reference                 `bson:"target,omitempty" json:"target,omitempty"`
+	Period            *Period                     `bson:"period,omitempty" json:"period,omitempty"`
+	Recorded          *FHIRDateTime               `bson:"recorded,omitempty" json:"recorded,omitempty"`
+	Reason            []CodeableConcept           `bson:"reason,omitempty" json:"reason,omitempty"`
+	Activity          *CodeableConcept            `bson:"activity,omitempty" json:"activity,omitempty"`
+	Location          *Reference                  `bson:"location,omitempty" json:"location,omitempty"`
+	Policy            []string                    `bson:"policy,omitempty" json:"policy,omitempty"`
+	Agent             []ProvenanceAgentComponent  `bson:"agent,omitempty" json:"agent,omitempty"`
+	Entity            []ProvenanceEntityComponent `bson:"entity,omitempty" json:"entity,omitempty"`
+	Signature         []Signature                 `bson:"signature,omitempty" json:"signature,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Questionnaire struct {
-	Id          string                       `json:"id" bson:"_id"`
-	Identifier  []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version     string                       `bson:"version,omitempty" json:"version,omitempty"`
-	Status      string                       `bson:"status,omitempty" json:"status,omitempty"`
-	Date        *FHIRDateTime                `bson:"date,omitempty" json:"date,omitempty"`
-	Publisher   string                       `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Telecom     []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
-	SubjectType []string                     `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
-	Group       *QuestionnaireGroupComponent `bson:"group,omitempty" json:"group,omitempty"`
+	Id                string                       `json:"id" bson:"_id"`
+	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                       `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                 `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version           string                       `bson:"version,omitempty" json:"version,omitempty"`
+	Status            string                       `bson:"status,omitempty" json:"status,omitempty"`
+	Date              *FHIRDateTime                `bson:"date,omitempty" json:"date,omitempty"`
+	Publisher         string                       `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Telecom           []ContactPoint               `bson:"telecom,omitempty" json:"telecom,omitempty"`
+	SubjectType       []string                     `bson:"subjectType,omitempty" json:"subjectType,omitempty"`
+	Group             *QuestionnaireGroupComponent `bson:"group,omitempty" json:"group,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
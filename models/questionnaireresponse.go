@@ -29,16 +29,23 @@ package models
 import "encoding/json"
 
 type QuestionnaireResponse struct {
-	Id            string                               `json:"id" bson:"_id"`
-	Identifier    *Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Questionnaire *Reference                           `bson:"questionnaire,omitempty" json:"questionnaire,omitempty"`
-	Status        string                               `bson:"status,omitempty" json:"status,omitempty"`
-	Subject       *Reference                           `bson:"subject,omitempty" json:"subject,omitempty"`
-	Author        *Reference                           `bson:"author,omitempty" json:"author,omitempty"`
-	Authored      *FHIRDateTime                        `bson:"authored,omitempty" json:"authored,omitempty"`
-	Source        *Reference                           `bson:"source,omitempty" json:"source,omitempty"`
-	Encounter     *Reference                           `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Group         *QuestionnaireResponseGroupComponent `bson:"group,omitempty" json:"group,omitempty"`
+	Id                string                               `json:"id" bson:"_id"`
+	Meta              *Meta                                `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                               `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                               `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                           `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                   `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                          `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                          `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier                          `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Questionnaire     *Reference                           `bson:"questionnaire,omitempty" json:"questionnaire,omitempty"`
+	Status            string                               `bson:"status,omitempty" json:"status,omitempty"`
+	Subject           *Reference                           `bson:"subject,omitempty" json:"subject,omitempty"`
+	Author            *Reference                           `bson:"author,omitempty" json:"author,omitempty"`
+	Authored          *FHIRDateTime                        `bson:"authored,omitempty" json:"authored,omitempty"`
+	Source            *Reference                           `bson:"source,omitempty" json:"source,omitempty"`
+	Encounter         *Reference                           `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Group             *QuestionnaireResponseGroupComponent `bson:"group,omitempty" json:"group,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
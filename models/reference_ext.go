@@ -10,7 +10,13 @@ type reference Reference
 func (r *Reference) UnmarshalJSON(data []byte) (err error) {
 	ref := reference{}
 	if err = json.Unmarshal(data, &ref); err == nil {
-		splitURL := strings.Split(ref.Reference, "/")
+		// A versioned reference (e.g., "Patient/123/_history/2") refers to the
+		// resource's type and id before the version
+		unversioned := ref.Reference
+		if i := strings.Index(unversioned, "/_history/"); i >= 0 {
+			unversioned = unversioned[:i]
+		}
+		splitURL := strings.Split(unversioned, "/")
 		if len(splitURL) >= 2 {
 			ref.ReferencedID = splitURL[len(splitURL)-1]
 			ref.Type = splitURL[len(splitURL)-2]
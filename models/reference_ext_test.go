@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/pebbe/util"
+	check "gopkg.in/check.v1"
+)
+
+type ReferenceSuite struct {
+}
+
+var _ = check.Suite(&ReferenceSuite{})
+
+func (s *ReferenceSuite) TestUnmarshalLocalReference(c *check.C) {
+	ref := &Reference{}
+	util.CheckErr(json.Unmarshal([]byte(`{"reference": "Patient/123"}`), ref))
+	c.Assert(ref.Type, check.Equals, "Patient")
+	c.Assert(ref.ReferencedID, check.Equals, "123")
+	c.Assert(*ref.External, check.Equals, false)
+}
+
+func (s *ReferenceSuite) TestUnmarshalVersionedReference(c *check.C) {
+	ref := &Reference{}
+	util.CheckErr(json.Unmarshal([]byte(`{"reference": "Patient/123/_history/2"}`), ref))
+	c.Assert(ref.Reference, check.Equals, "Patient/123/_history/2")
+	c.Assert(ref.Type, check.Equals, "Patient")
+	c.Assert(ref.ReferencedID, check.Equals, "123")
+
+	ref = &Reference{}
+	util.CheckErr(json.Unmarshal([]byte(`{"reference": "http://acme.org/fhir/Patient/123/_history/2"}`), ref))
+	c.Assert(ref.Type, check.Equals, "Patient")
+	c.Assert(ref.ReferencedID, check.Equals, "123")
+	c.Assert(*ref.External, check.Equals, true)
+}
+
+func (s *ReferenceSuite) TestUnmarshalIdentifierReference(c *check.C) {
+	ref := &Reference{}
+	util.CheckErr(json.Unmarshal([]byte(`{"identifier": {"system": "http://hospital", "value": "MRN123"}, "display": "John Peters"}`), ref))
+	c.Assert(ref.Identifier, check.DeepEquals, &Identifier{System: "http://hospital", Value: "MRN123"})
+	c.Assert(ref.Type, check.Equals, "")
+	c.Assert(ref.ReferencedID, check.Equals, "")
+}
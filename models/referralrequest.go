@@ -29,23 +29,30 @@ package models
 import "encoding/json"
 
 type ReferralRequest struct {
-	Id                    string            `json:"id" bson:"_id"`
-	Status                string            `bson:"status,omitempty" json:"status,omitempty"`
-	Identifier            []Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Date                  *FHIRDateTime     `bson:"date,omitempty" json:"date,omitempty"`
-	Type                  *CodeableConcept  `bson:"type,omitempty" json:"type,omitempty"`
-	Specialty             *CodeableConcept  `bson:"specialty,omitempty" json:"specialty,omitempty"`
-	Priority              *CodeableConcept  `bson:"priority,omitempty" json:"priority,omitempty"`
-	Patient               *Reference        `bson:"patient,omitempty" json:"patient,omitempty"`
-	Requester             *Reference        `bson:"requester,omitempty" json:"requester,omitempty"`
-	Recipient             []Reference       `bson:"recipient,omitempty" json:"recipient,omitempty"`
-	Encounter             *Reference        `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	DateSent              *FHIRDateTime     `bson:"dateSent,omitempty" json:"dateSent,omitempty"`
-	Reason                *CodeableConcept  `bson:"reason,omitempty" json:"reason,omitempty"`
-	Description           string            `bson:"description,omitempty" json:"description,omitempty"`
-	ServiceRequested      []CodeableConcept `bson:"serviceRequested,omitempty" json:"serviceRequested,omitempty"`
-	SupportingInformation []Reference       `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
-	FulfillmentTime       *Period           `bson:"fulfillmentTime,omitempty" json:"fulfillmentTime,omitempty"`
+	Id                    string             `json:"id" bson:"_id"`
+	Meta                  *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules         string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language              string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text                  *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained             ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension             []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension     []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Status                string             `bson:"status,omitempty" json:"status,omitempty"`
+	Identifier            []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Date                  *FHIRDateTime      `bson:"date,omitempty" json:"date,omitempty"`
+	Type                  *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Specialty             *CodeableConcept   `bson:"specialty,omitempty" json:"specialty,omitempty"`
+	Priority              *CodeableConcept   `bson:"priority,omitempty" json:"priority,omitempty"`
+	Patient               *Reference         `bson:"patient,omitempty" json:"patient,omitempty"`
+	Requester             *Reference         `bson:"requester,omitempty" json:"requester,omitempty"`
+	Recipient             []Reference        `bson:"recipient,omitempty" json:"recipient,omitempty"`
+	Encounter             *Reference         `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	DateSent              *FHIRDateTime      `bson:"dateSent,omitempty" json:"dateSent,omitempty"`
+	Reason                *CodeableConcept   `bson:"reason,omitempty" json:"reason,omitempty"`
+	Description           string             `bson:"description,omitempty" json:"description,omitempty"`
+	ServiceRequested      []CodeableConcept  `bson:"serviceRequested,omitempty" json:"serviceRequested,omitempty"`
+	SupportingInformation []Reference        `bson:"supportingInformation,omitempty" json:"supportingInformation,omitempty"`
+	FulfillmentTime       *Period            `bson:"fulfillmentTime,omitempty" json:"fulfillmentTime,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,17 +29,24 @@ package models
 import "encoding/json"
 
 type RiskAssessment struct {
-	Id         string                              `json:"id" bson:"_id"`
-	Subject    *Reference                          `bson:"subject,omitempty" json:"subject,omitempty"`
-	Date       *FHIRDateTime                       `bson:"date,omitempty" json:"date,omitempty"`
-	Condition  *Reference                          `bson:"condition,omitempty" json:"condition,omitempty"`
-	Encounter  *Reference                          `bson:"encounter,omitempty" json:"encounter,omitempty"`
-	Performer  *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
-	Identifier *Identifier                         `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Method     *CodeableConcept                    `bson:"method,omitempty" json:"method,omitempty"`
-	Basis      []Reference                         `bson:"basis,omitempty" json:"basis,omitempty"`
-	Prediction []RiskAssessmentPredictionComponent `bson:"prediction,omitempty" json:"prediction,omitempty"`
-	Mitigation string                              `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
+	Id                string                              `json:"id" bson:"_id"`
+	Meta              *Meta                               `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                              `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                              `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                          `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                  `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                         `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                         `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Subject           *Reference                          `bson:"subject,omitempty" json:"subject,omitempty"`
+	Date              *FHIRDateTime                       `bson:"date,omitempty" json:"date,omitempty"`
+	Condition         *Reference                          `bson:"condition,omitempty" json:"condition,omitempty"`
+	Encounter         *Reference                          `bson:"encounter,omitempty" json:"encounter,omitempty"`
+	Performer         *Reference                          `bson:"performer,omitempty" json:"performer,omitempty"`
+	Identifier        *Identifier                         `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Method            *CodeableConcept                    `bson:"method,omitempty" json:"method,omitempty"`
+	Basis             []Reference                         `bson:"basis,omitempty" json:"basis,omitempty"`
+	Prediction        []RiskAssessmentPredictionComponent `bson:"prediction,omitempty" json:"prediction,omitempty"`
+	Mitigation        string                              `bson:"mitigation,omitempty" json:"mitigation,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
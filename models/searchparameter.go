@@ -29,22 +29,29 @@ package models
 import "encoding/json"
 
 type SearchParameter struct {
-	Id           string                            `json:"id" bson:"_id"`
-	Url          string                            `bson:"url,omitempty" json:"url,omitempty"`
-	Name         string                            `bson:"name,omitempty" json:"name,omitempty"`
-	Status       string                            `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental *bool                             `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher    string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact      []SearchParameterContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date         *FHIRDateTime                     `bson:"date,omitempty" json:"date,omitempty"`
-	Requirements string                            `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Code         string                            `bson:"code,omitempty" json:"code,omitempty"`
-	Base         string                            `bson:"base,omitempty" json:"base,omitempty"`
-	Type         string                            `bson:"type,omitempty" json:"type,omitempty"`
-	Description  string                            `bson:"description,omitempty" json:"description,omitempty"`
-	Xpath        string                            `bson:"xpath,omitempty" json:"xpath,omitempty"`
-	XpathUsage   string                            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
-	Target       []string                          `bson:"target,omitempty" json:"target,omitempty"`
+	Id                string                            `json:"id" bson:"_id"`
+	Meta              *Meta                             `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                            `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                            `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                        `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                       `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                       `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                            `bson:"url,omitempty" json:"url,omitempty"`
+	Name              string                            `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                            `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                             `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                            `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []SearchParameterContactComponent `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                     `bson:"date,omitempty" json:"date,omitempty"`
+	Requirements      string                            `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Code              string                            `bson:"code,omitempty" json:"code,omitempty"`
+	Base              string                            `bson:"base,omitempty" json:"base,omitempty"`
+	Type              string                            `bson:"type,omitempty" json:"type,omitempty"`
+	Description       string                            `bson:"description,omitempty" json:"description,omitempty"`
+	Xpath             string                            `bson:"xpath,omitempty" json:"xpath,omitempty"`
+	XpathUsage        string                            `bson:"xpathUsage,omitempty" json:"xpathUsage,omitempty"`
+	Target            []string                          `bson:"target,omitempty" json:"target,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Slot struct {
-	Id           string           `json:"id" bson:"_id"`
-	Identifier   []Identifier     `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Type         *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Schedule     *Reference       `bson:"schedule,omitempty" json:"schedule,omitempty"`
-	FreeBusyType string           `bson:"freeBusyType,omitempty" json:"freeBusyType,omitempty"`
-	Start        *FHIRDateTime    `bson:"start,omitempty" json:"start,omitempty"`
-	End          *FHIRDateTime    `bson:"end,omitempty" json:"end,omitempty"`
-	Overbooked   *bool            `bson:"overbooked,omitempty" json:"overbooked,omitempty"`
-	Comment      string           `bson:"comment,omitempty" json:"comment,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier       `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Schedule          *Reference         `bson:"schedule,omitempty" json:"schedule,omitempty"`
+	FreeBusyType      string             `bson:"freeBusyType,omitempty" json:"freeBusyType,omitempty"`
+	Start             *FHIRDateTime      `bson:"start,omitempty" json:"start,omitempty"`
+	End               *FHIRDateTime      `bson:"end,omitempty" json:"end,omitempty"`
+	Overbooked        *bool              `bson:"overbooked,omitempty" json:"overbooked,omitempty"`
+	Comment           string             `bson:"comment,omitempty" json:"comment,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
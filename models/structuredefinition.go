@@ -29,32 +29,39 @@ package models
 import "encoding/json"
 
 type StructureDefinition struct {
-	Id              string                                    `json:"id" bson:"_id"`
-	Url             string                                    `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier      []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version         string                                    `bson:"version,omitempty" json:"version,omitempty"`
-	Name            string                                    `bson:"name,omitempty" json:"name,omitempty"`
-	Display         string                                    `bson:"display,omitempty" json:"display,omitempty"`
-	Status          string                                    `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental    *bool                                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher       string                                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact         []StructureDefinitionContactComponent     `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date            *FHIRDateTime                             `bson:"date,omitempty" json:"date,omitempty"`
-	Description     string                                    `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext      []CodeableConcept                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Requirements    string                                    `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Copyright       string                                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Code            []Coding                                  `bson:"code,omitempty" json:"code,omitempty"`
-	FhirVersion     string                                    `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
-	Mapping         []StructureDefinitionMappingComponent     `bson:"mapping,omitempty" json:"mapping,omitempty"`
-	Kind            string                                    `bson:"kind,omitempty" json:"kind,omitempty"`
-	ConstrainedType string                                    `bson:"constrainedType,omitempty" json:"constrainedType,omitempty"`
-	Abstract        *bool                                     `bson:"abstract,omitempty" json:"abstract,omitempty"`
-	ContextType     string                                    `bson:"contextType,omitempty" json:"contextType,omitempty"`
-	Context         []string                                  `bson:"context,omitempty" json:"context,omitempty"`
-	Base            string                                    `bson:"base,omitempty" json:"base,omitempty"`
-	Snapshot        *StructureDefinitionSnapshotComponent     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
-	Differential    *StructureDefinitionDifferentialComponent `bson:"differential,omitempty" json:"differential,omitempty"`
+	Id                string                                    `json:"id" bson:"_id"`
+	Meta              *Meta                                     `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                                    `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                                    `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                                `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources                        `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                               `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                               `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                                    `bson:"url,omitempty" json:"url,omitempty"`
+	Identifier        []Identifier                              `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version           string                                    `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                                    `bson:"name,omitempty" json:"name,omitempty"`
+	Display           string                                    `bson:"display,omitempty" json:"display,omitempty"`
+	Status            string                                    `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                                     `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                                    `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []StructureDefinitionContactComponent     `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                             `bson:"date,omitempty" json:"date,omitempty"`
+	Description       string                                    `bson:"description,omitempty" json:"description,omitempty"`
+	UseContext        []CodeableConcept                         `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Requirements      string                                    `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Copyright         string                                    `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	Code              []Coding                                  `bson:"code,omitempty" json:"code,omitempty"`
+	FhirVersion       string                                    `bson:"fhirVersion,omitempty" json:"fhirVersion,omitempty"`
+	Mapping           []StructureDefinitionMappingComponent     `bson:"mapping,omitempty" json:"mapping,omitempty"`
+	Kind              string                                    `bson:"kind,omitempty" json:"kind,omitempty"`
+	ConstrainedType   string                                    `bson:"constrainedType,omitempty" json:"constrainedType,omitempty"`
+	Abstract          *bool                                     `bson:"abstract,omitempty" json:"abstract,omitempty"`
+	ContextType       string                                    `bson:"contextType,omitempty" json:"contextType,omitempty"`
+	Context           []string                                  `bson:"context,omitempty" json:"context,omitempty"`
+	Base              string                                    `bson:"base,omitempty" json:"base,omitempty"`
+	Snapshot          *StructureDefinitionSnapshotComponent     `bson:"snapshot,omitempty" json:"snapshot,omitempty"`
+	Differential      *StructureDefinitionDifferentialComponent `bson:"differential,omitempty" json:"differential,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,15 +29,22 @@ package models
 import "encoding/json"
 
 type Subscription struct {
-	Id       string                        `json:"id" bson:"_id"`
-	Criteria string                        `bson:"criteria,omitempty" json:"criteria,omitempty"`
-	Contact  []ContactPoint                `bson:"contact,omitempty" json:"contact,omitempty"`
-	Reason   string                        `bson:"reason,omitempty" json:"reason,omitempty"`
-	Status   string                        `bson:"status,omitempty" json:"status,omitempty"`
-	Error    string                        `bson:"error,omitempty" json:"error,omitempty"`
-	Channel  *SubscriptionChannelComponent `bson:"channel,omitempty" json:"channel,omitempty"`
-	End      *FHIRDateTime                 `bson:"end,omitempty" json:"end,omitempty"`
-	Tag      []Coding                      `bson:"tag,omitempty" json:"tag,omitempty"`
+	Id                string                        `json:"id" bson:"_id"`
+	Meta              *Meta                         `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                        `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                        `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                    `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources            `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                   `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                   `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Criteria          string                        `bson:"criteria,omitempty" json:"criteria,omitempty"`
+	Contact           []ContactPoint                `bson:"contact,omitempty" json:"contact,omitempty"`
+	Reason            string                        `bson:"reason,omitempty" json:"reason,omitempty"`
+	Status            string                        `bson:"status,omitempty" json:"status,omitempty"`
+	Error             string                        `bson:"error,omitempty" json:"error,omitempty"`
+	Channel           *SubscriptionChannelComponent `bson:"channel,omitempty" json:"channel,omitempty"`
+	End               *FHIRDateTime                 `bson:"end,omitempty" json:"end,omitempty"`
+	Tag               []Coding                      `bson:"tag,omitempty" json:"tag,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
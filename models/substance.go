@@ -29,13 +29,20 @@ package models
 import "encoding/json"
 
 type Substance struct {
-	Id          string                         `json:"id" bson:"_id"`
-	Identifier  []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Category    []CodeableConcept              `bson:"category,omitempty" json:"category,omitempty"`
-	Code        *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
-	Description string                         `bson:"description,omitempty" json:"description,omitempty"`
-	Instance    []SubstanceInstanceComponent   `bson:"instance,omitempty" json:"instance,omitempty"`
-	Ingredient  []SubstanceIngredientComponent `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
+	Id                string                         `json:"id" bson:"_id"`
+	Meta              *Meta                          `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                         `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                         `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                     `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources             `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                    `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                    `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        []Identifier                   `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Category          []CodeableConcept              `bson:"category,omitempty" json:"category,omitempty"`
+	Code              *CodeableConcept               `bson:"code,omitempty" json:"code,omitempty"`
+	Description       string                         `bson:"description,omitempty" json:"description,omitempty"`
+	Instance          []SubstanceInstanceComponent   `bson:"instance,omitempty" json:"instance,omitempty"`
+	Ingredient        []SubstanceIngredientComponent `bson:"ingredient,omitempty" json:"ingredient,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
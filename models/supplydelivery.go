@@ -29,18 +29,25 @@ package models
 import "encoding/json"
 
 type SupplyDelivery struct {
-	Id           string           `json:"id" bson:"_id"`
-	Identifier   *Identifier      `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Status       string           `bson:"status,omitempty" json:"status,omitempty"`
-	Patient      *Reference       `bson:"patient,omitempty" json:"patient,omitempty"`
-	Type         *CodeableConcept `bson:"type,omitempty" json:"type,omitempty"`
-	Quantity     *Quantity        `bson:"quantity,omitempty" json:"quantity,omitempty"`
-	SuppliedItem *Reference       `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
-	Supplier     *Reference       `bson:"supplier,omitempty" json:"supplier,omitempty"`
-	WhenPrepared *Period          `bson:"whenPrepared,omitempty" json:"whenPrepared,omitempty"`
-	Time         *FHIRDateTime    `bson:"time,omitempty" json:"time,omitempty"`
-	Destination  *Reference       `bson:"destination,omitempty" json:"destination,omitempty"`
-	Receiver     []Reference      `bson:"receiver,omitempty" json:"receiver,omitempty"`
+	Id                string             `json:"id" bson:"_id"`
+	Meta              *Meta              `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string             `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string             `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative         `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension        `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension        `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Identifier        *Identifier        `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Status            string             `bson:"status,omitempty" json:"status,omitempty"`
+	Patient           *Reference         `bson:"patient,omitempty" json:"patient,omitempty"`
+	Type              *CodeableConcept   `bson:"type,omitempty" json:"type,omitempty"`
+	Quantity          *Quantity          `bson:"quantity,omitempty" json:"quantity,omitempty"`
+	SuppliedItem      *Reference         `bson:"suppliedItem,omitempty" json:"suppliedItem,omitempty"`
+	Supplier          *Reference         `bson:"supplier,omitempty" json:"supplier,omitempty"`
+	WhenPrepared      *Period            `bson:"whenPrepared,omitempty" json:"whenPrepared,omitempty"`
+	Time              *FHIRDateTime      `bson:"time,omitempty" json:"time,omitempty"`
+	Destination       *Reference         `bson:"destination,omitempty" json:"destination,omitempty"`
+	Receiver          []Reference        `bson:"receiver,omitempty" json:"receiver,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -29,26 +29,33 @@ package models
 import "encoding/json"
 
 type ValueSet struct {
-	Id           string                       `json:"id" bson:"_id"`
-	Url          string                       `bson:"url,omitempty" json:"url,omitempty"`
-	Identifier   *Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
-	Version      string                       `bson:"version,omitempty" json:"version,omitempty"`
-	Name         string                       `bson:"name,omitempty" json:"name,omitempty"`
-	Status       string                       `bson:"status,omitempty" json:"status,omitempty"`
-	Experimental *bool                        `bson:"experimental,omitempty" json:"experimental,omitempty"`
-	Publisher    string                       `bson:"publisher,omitempty" json:"publisher,omitempty"`
-	Contact      []ValueSetContactComponent   `bson:"contact,omitempty" json:"contact,omitempty"`
-	Date         *FHIRDateTime                `bson:"date,omitempty" json:"date,omitempty"`
-	LockedDate   *FHIRDateTime                `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
-	Description  string                       `bson:"description,omitempty" json:"description,omitempty"`
-	UseContext   []CodeableConcept            `bson:"useContext,omitempty" json:"useContext,omitempty"`
-	Immutable    *bool                        `bson:"immutable,omitempty" json:"immutable,omitempty"`
-	Requirements string                       `bson:"requirements,omitempty" json:"requirements,omitempty"`
-	Copyright    string                       `bson:"copyright,omitempty" json:"copyright,omitempty"`
-	Extensible   *bool                        `bson:"extensible,omitempty" json:"extensible,omitempty"`
-	CodeSystem   *ValueSetCodeSystemComponent `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
-	Compose      *ValueSetComposeComponent    `bson:"compose,omitempty" json:"compose,omitempty"`
-	Expansion    *ValueSetExpansionComponent  `bson:"expansion,omitempty" json:"expansion,omitempty"`
+	Id                string                       `json:"id" bson:"_id"`
+	Meta              *Meta                        `bson:"meta,omitempty" json:"meta,omitempty"`
+	ImplicitRules     string                       `bson:"implicitRules,omitempty" json:"implicitRules,omitempty"`
+	Language          string                       `bson:"language,omitempty" json:"language,omitempty"`
+	Text              *Narrative                   `bson:"text,omitempty" json:"text,omitempty"`
+	Contained         ContainedResources           `bson:"contained,omitempty" json:"contained,omitempty"`
+	Extension         []Extension                  `bson:"extension,omitempty" json:"extension,omitempty"`
+	ModifierExtension []Extension                  `bson:"modifierExtension,omitempty" json:"modifierExtension,omitempty"`
+	Url               string                       `bson:"url,omitempty" json:"url,omitempty"`
+	Identifier        *Identifier                  `bson:"identifier,omitempty" json:"identifier,omitempty"`
+	Version           string                       `bson:"version,omitempty" json:"version,omitempty"`
+	Name              string                       `bson:"name,omitempty" json:"name,omitempty"`
+	Status            string                       `bson:"status,omitempty" json:"status,omitempty"`
+	Experimental      *bool                        `bson:"experimental,omitempty" json:"experimental,omitempty"`
+	Publisher         string                       `bson:"publisher,omitempty" json:"publisher,omitempty"`
+	Contact           []ValueSetContactComponent   `bson:"contact,omitempty" json:"contact,omitempty"`
+	Date              *FHIRDateTime                `bson:"date,omitempty" json:"date,omitempty"`
+	LockedDate        *FHIRDateTime                `bson:"lockedDate,omitempty" json:"lockedDate,omitempty"`
+	Description       string                       `bson:"description,omitempty" json:"description,omitempty"`
+	UseContext        []CodeableConcept            `bson:"useContext,omitempty" json:"useContext,omitempty"`
+	Immutable         *bool                        `bson:"immutable,omitempty" json:"immutable,omitempty"`
+	Requirements      string                       `bson:"requirements,omitempty" json:"requirements,omitempty"`
+	Copyright         string                       `bson:"copyright,omitempty" json:"copyright,omitempty"`
+	Extensible        *bool                        `bson:"extensible,omitempty" json:"extensible,omitempty"`
+	CodeSystem        *ValueSetCodeSystemComponent `bson:"codeSystem,omitempty" json:"codeSystem,omitempty"`
+	Compose           *ValueSetComposeComponent    `bson:"compose,omitempty" json:"compose,omitempty"`
+	Expansion         *ValueSetExpansionComponent  `bson:"expansion,omitempty" json:"expansion,omitempty"`
 }
 
 // Custom marshaller to add the resourceType property, as required by the specification
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// EnsureDateIndexes creates indexes on the ranges stored for the dates that
+// can be searched (see dateSelector and periodSelector), including
+// meta.lastUpdated (see GlobalSearchParameterDictionary).
+func EnsureDateIndexes(db *mgo.Database) error {
+	for resourceType, params := range SearchParameterDictionary {
+		c := db.C(models.PluralizeLowerResourceName(resourceType))
+		var paths []SearchParamPath
+		for _, dictionary := range []map[string]SearchParamInfo{params, GlobalSearchParameterDictionary} {
+			for _, param := range dictionary {
+				if param.Type == "date" {
+					paths = append(paths, param.Paths...)
+				}
+			}
+		}
+
+		ensured := make(map[string]bool)
+		for _, p := range paths {
+			var key []string
+			path := strings.Replace(p.Path, "[]", "", -1)
+			switch p.Type {
+			case "date", "dateTime", "instant":
+				key = []string{path + ".low", path + ".high"}
+			case "Period":
+				key = []string{path + ".start.low", path + ".end.high"}
+			case "Timing":
+				key = []string{path + ".event.low", path + ".event.high"}
+			default:
+				continue
+			}
+			if ensured[key[0]] {
+				continue
+			}
+			if err := c.EnsureIndexKey(key...); err != nil {
+				return err
+			}
+			ensured[key[0]] = true
+		}
+	}
+	return nil
+}
+
+// MigrateDateRanges rewrites every stored resource so that its dates include
+// the ranges used to search them.  It is only needed for resources stored by
+// earlier versions, which saved dates without a range, and returns the number
+// of resources that were rewritten.
+func MigrateDateRanges(db *mgo.Database) (int, error) {
+	migrated := 0
+	for resourceType := range SearchParameterDictionary {
+		c := db.C(models.PluralizeLowerResourceName(resourceType))
+		var raw bson.Raw
+		iter := c.Find(nil).Iter()
+		for iter.Next(&raw) {
+			resource := models.NewStructForResourceName(resourceType)
+			if err := raw.Unmarshal(resource); err != nil {
+				iter.Close()
+				return migrated, err
+			}
+			var idObj struct {
+				ID string `bson:"_id"`
+			}
+			if err := raw.Unmarshal(&idObj); err != nil {
+				iter.Close()
+				return migrated, err
+			}
+			if err := c.UpdateId(idObj.ID, resource); err != nil {
+				iter.Close()
+				return migrated, err
+			}
+			migrated++
+		}
+		if err := iter.Close(); err != nil {
+			return migrated, err
+		}
+	}
+	return migrated, nil
+}
@@ -1,16 +1,29 @@
 package search
 
 import (
+	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/intervention-engine/fhir/models"
 	mgo "gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
+// ChainedSearchLimit is the maximum number of resources that the chained query
+// of a chained (or reverse chained) search may match.  Since MongoDB does not
+// support cross-collection searches, the ids of those resources are found
+// before searching for the references to (or from) them, so searches that
+// would exceed the limit are rejected rather than held in memory.
+var ChainedSearchLimit = 10000
+
 // MongoSearcher implements FHIR searches using the Mongo database.
 type MongoSearcher struct {
 	db *mgo.Database
@@ -41,6 +54,16 @@ func (m *MongoSearcher) CreateQueryWithoutOptions(query Query) *mgo.Query {
 	return m.createQuery(query, false)
 }
 
+// CreateQueryWithoutPaging takes a FHIR-based Query and returns a pointer to
+// the corresponding mgo.Query, sorted according to the _sort option (and then
+// by id).  The _count and _offset options are ignored, so the query returns
+// every matching resource in a stable order.  The caller's projection must
+// select the relevance of a text search sorted by _score (see WithTextScore).
+func (m *MongoSearcher) CreateQueryWithoutPaging(query Query) *mgo.Query {
+	c := m.db.C(models.PluralizeLowerResourceName(query.Resource))
+	return c.Find(m.createQueryObject(query)).Sort(sortFields(query.Options().Sort)...)
+}
+
 func (m *MongoSearcher) createQuery(query Query, withOptions bool) *mgo.Query {
 	c := m.db.C(models.PluralizeLowerResourceName(query.Resource))
 	q := m.createQueryObject(query)
@@ -51,13 +74,305 @@ func (m *MongoSearcher) createQuery(query Query, withOptions bool) *mgo.Query {
 			mgoQuery = mgoQuery.Skip(o.Offset)
 		}
 		mgoQuery = mgoQuery.Limit(o.Count)
+		if len(o.Sort) > 0 {
+			mgoQuery = mgoQuery.Sort(sortFields(o.Sort)...)
+		}
+		projection := Projection(query.Resource, o)
+		if o.TextSearch {
+			projection = WithTextScore(projection)
+		}
+		if projection != nil {
+			mgoQuery = mgoQuery.Select(projection)
+		}
 	}
 	return mgoQuery
 }
 
+// sortFields returns the fields to pass to mgo's Sort for the sort options.
+// The _id field is always sorted on last so that the order (and therefore
+// paging) is stable.  Sorting by _score requires the query to select the
+// relevance (see WithTextScore).
+func sortFields(sorts []SortOption) []string {
+	fields := make([]string, 0, len(sorts)+1)
+	for _, sort := range sorts {
+		if sort.Parameter.Name == ScoreParam {
+			fields = append(fields, "$textScore:"+TextScoreField)
+			continue
+		}
+		field := sortField(sort)
+		if sort.Descending {
+			field = "-" + field
+		}
+		fields = append(fields, field)
+	}
+	return append(fields, "_id")
+}
+
+// sortField returns the field that should be sorted on for the sort option.
+// Arrays are sorted by their first element, since Mongo can't sort on
+// parallel arrays, and complex types are sorted on their most significant
+// element (e.g., the family name of a HumanName).  When a parameter has more
+// than one path, only the first is used.
+func sortField(sort SortOption) string {
+	path := sort.Parameter.Paths[0]
+	var elements []string
+	for _, element := range strings.Split(path.Path, ".") {
+		if strings.HasPrefix(element, "[]") {
+			elements = append(elements, element[2:], "0")
+		} else {
+			elements = append(elements, element)
+		}
+	}
+	field := strings.Join(elements, ".")
+
+	switch path.Type {
+	case "HumanName":
+		return field + ".family.0"
+	case "Address":
+		return field + ".line.0"
+	case "CodeableConcept":
+		return field + ".coding.0.code"
+	case "Coding":
+		return field + ".code"
+	case "Identifier", "ContactPoint", "Quantity", "SimpleQuantity", "Money", "Age", "Duration", "Count", "Distance":
+		return field + ".value"
+	case "Reference":
+		return field + ".reference"
+	case "Range":
+		return field + ".low.value"
+	case "date", "dateTime", "instant":
+		return field + ".time"
+	case "Period":
+		// Ascending sorts use the start of the period, descending the end
+		if sort.Descending {
+			return field + ".end.time"
+		}
+		return field + ".start.time"
+	case "Timing":
+		return field + ".event.0.time"
+	}
+	return field
+}
+
+// Projection returns the Mongo projection that selects the elements requested
+// by the _summary and _elements options, or nil if the whole resource should
+// be returned.  The id and meta elements are always returned.  A summary
+// contains the elements marked as summary elements (see summaryElements).
+func Projection(resourceType string, o *QueryOptions) bson.M {
+	var elements []string
+	switch o.Summary {
+	case "true":
+		elements = append([]string{"implicitRules"}, summaryElements[resourceType]...)
+	case "text":
+		elements = append(elements, "implicitRules", "text")
+	case "data":
+		return bson.M{"text": 0}
+	default:
+		if len(o.Elements) == 0 {
+			return nil
+		}
+		elements = o.Elements
+	}
+
+	projection := bson.M{"_id": 1, "meta": 1}
+	fields := elementFields(resourceType)
+	for _, element := range elements {
+		// The requested elements were checked when the options were parsed
+		if field, ok := fields[element]; ok {
+			projection[field] = 1
+		}
+	}
+	return projection
+}
+
+// elementFields returns the Mongo field that each top-level element of the
+// resource type is stored in, by element name, or nil if the resource type is
+// unknown.
+func elementFields(resourceType string) map[string]string {
+	resource := models.StructForResourceName(resourceType)
+	if resource == nil {
+		return nil
+	}
+	fields := make(map[string]string)
+	t := reflect.TypeOf(resource)
+	for i := 0; i < t.NumField(); i++ {
+		element := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		field := strings.Split(t.Field(i).Tag.Get("bson"), ",")[0]
+		if element != "" && element != "-" && field != "" && field != "-" {
+			fields[element] = field
+		}
+	}
+	return fields
+}
+
+// maxIncludeIterations bounds how many times iterated includes are applied to
+// the resources they include.
+const maxIncludeIterations = 5
+
+// FindIncludes returns the resources that should be included with the results
+// of a search on resourceType (identified by ids), according to the _include
+// and _revinclude options.  Resources that are among the results, or that are
+// included more than once, are only returned once.
+func (m *MongoSearcher) FindIncludes(resourceType string, ids []string, options *QueryOptions) ([]interface{}, error) {
+	found := make(map[string]bool)
+	for _, id := range ids {
+		found[resourceType+"/"+id] = true
+	}
+
+	var results []interface{}
+	current := map[string][]string{resourceType: ids}
+	for i := 0; i < maxIncludeIterations && len(current) > 0; i++ {
+		matched := make(map[string][]string)
+		for _, include := range options.Include {
+			if i > 0 && !include.Iterate {
+				continue
+			}
+			if err := m.findIncluded(include, current, matched); err != nil {
+				return nil, err
+			}
+		}
+		for _, include := range options.RevInclude {
+			if i > 0 && !include.Iterate {
+				continue
+			}
+			if err := m.findRevIncluded(include, current, matched); err != nil {
+				return nil, err
+			}
+		}
+
+		next := make(map[string][]string)
+		for includedType, includedIDs := range matched {
+			var newIDs []string
+			for _, id := range includedIDs {
+				if key := includedType + "/" + id; !found[key] {
+					found[key] = true
+					newIDs = append(newIDs, id)
+				}
+			}
+			if len(newIDs) == 0 {
+				continue
+			}
+			resources, err := m.findByIDs(includedType, newIDs)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, resources...)
+			next[includedType] = newIDs
+		}
+		current = next
+	}
+	return results, nil
+}
+
+// findIncluded adds the ids of the resources referred to by the include's
+// parameter on the current resources to matched, keyed by resource type.
+func (m *MongoSearcher) findIncluded(include IncludeOption, current map[string][]string, matched map[string][]string) error {
+	ids := current[include.Resource]
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var docs []bson.M
+	c := m.db.C(models.PluralizeLowerResourceName(include.Resource))
+	if err := c.Find(bson.M{"_id": bson.M{"$in": ids}}).All(&docs); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		for _, path := range include.Parameter.Paths {
+			for _, ref := range valuesAtPath(doc, path.Path) {
+				refType, _ := ref["type"].(string)
+				refID, _ := ref["referenceid"].(string)
+				if refType == "" || refID == "" || (include.Target != "" && refType != include.Target) {
+					continue
+				}
+				matched[refType] = append(matched[refType], refID)
+			}
+		}
+	}
+	return nil
+}
+
+// findRevIncluded adds the ids of the resources whose include parameter refers
+// to any of the current resources to matched, keyed by resource type.
+func (m *MongoSearcher) findRevIncluded(include IncludeOption, current map[string][]string, matched map[string][]string) error {
+	var criteria []bson.M
+	for currentType, ids := range current {
+		if !include.Parameter.targets(currentType) || (include.Target != "" && include.Target != currentType) {
+			continue
+		}
+		single := func(p SearchParamPath) bson.M {
+			return buildBSON(p.Path, bson.M{"referenceid": bson.M{"$in": ids}, "type": currentType})
+		}
+		criteria = append(criteria, orPaths(single, include.Parameter.Paths))
+	}
+	if len(criteria) == 0 {
+		return nil
+	}
+
+	var idObjs []struct {
+		ID string `bson:"_id"`
+	}
+	c := m.db.C(models.PluralizeLowerResourceName(include.Resource))
+	if err := c.Find(bson.M{"$or": criteria}).Select(bson.M{"_id": 1}).All(&idObjs); err != nil {
+		return err
+	}
+	for _, idObj := range idObjs {
+		matched[include.Resource] = append(matched[include.Resource], idObj.ID)
+	}
+	return nil
+}
+
+// findByIDs returns pointers to the resources of the given type with the ids.
+func (m *MongoSearcher) findByIDs(resourceType string, ids []string) ([]interface{}, error) {
+	result := models.NewSliceForResourceName(resourceType, 0, 0)
+	c := m.db.C(models.PluralizeLowerResourceName(resourceType))
+	if err := c.Find(bson.M{"_id": bson.M{"$in": ids}}).Sort("_id").All(result); err != nil {
+		return nil, err
+	}
+
+	resultVal := reflect.ValueOf(result).Elem()
+	resources := make([]interface{}, resultVal.Len())
+	for i := range resources {
+		resources[i] = resultVal.Index(i).Addr().Interface()
+	}
+	return resources, nil
+}
+
+// valuesAtPath returns the subdocuments found at the search parameter path
+// (e.g., "[]participant.individual") in a document, flattening any arrays
+// along the way.
+func valuesAtPath(doc bson.M, path string) []bson.M {
+	values := []interface{}{doc}
+	for _, element := range strings.Split(strings.Replace(path, "[]", "", -1), ".") {
+		var next []interface{}
+		for _, value := range values {
+			if d, ok := value.(bson.M); ok {
+				switch v := d[element].(type) {
+				case []interface{}:
+					next = append(next, v...)
+				case nil:
+				default:
+					next = append(next, v)
+				}
+			}
+		}
+		values = next
+	}
+
+	var results []bson.M
+	for _, value := range values {
+		if d, ok := value.(bson.M); ok {
+			results = append(results, d)
+		}
+	}
+	return results
+}
+
 func (m *MongoSearcher) createQueryObject(query Query) bson.M {
 	result := bson.M{}
-	for _, p := range m.createParamObjects(query.Resource, query.Params()) {
+	params := query.Params()
+	panicOnRepeatedTextSearches(params)
+	for _, p := range m.createParamObjects(query.Resource, params) {
 		merge(result, p)
 	}
 	return result
@@ -84,6 +399,12 @@ func (m *MongoSearcher) createParamObjects(resource string, params []SearchParam
 			results[i] = m.createTokenQueryObject(p)
 		case *URIParam:
 			results[i] = m.createURIQueryObject(p)
+		case *TextSearchParam:
+			results[i] = m.createTextSearchQueryObject(p)
+		case *MissingParam:
+			results[i] = m.createMissingQueryObject(p)
+		case *ReverseChainParam:
+			results[i] = m.createReverseChainQueryObject(resource, p)
 		case *OrParam:
 			results[i] = m.createOrQueryObject(resource, p)
 		default:
@@ -95,25 +416,107 @@ func (m *MongoSearcher) createParamObjects(resource string, params []SearchParam
 }
 
 func panicOnUnsupportedFeatures(p SearchParam) {
-	// No prefixes are supported except EQ (the default) and date prefixes
-	_, isDate := p.(*DateParam)
-	prefix := p.getInfo().Prefix
-	if prefix != "" && prefix != EQ && !isDate {
-		panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", p.getInfo().Name)))
+	// No prefixes are supported except EQ (the default) and date, number and quantity prefixes
+	switch p.(type) {
+	case *DateParam, *NumberParam, *QuantityParam:
+	default:
+		if prefix := p.getInfo().Prefix; prefix != "" && prefix != EQ {
+			panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", p.getInfo().Name)))
+		}
 	}
 
-	// No modifiers are supported except for resource types in reference parameters
-	_, isRef := p.(*ReferenceParam)
-	modifier := p.getInfo().Modifier
-	if modifier != "" {
-		if _, ok := SearchParameterDictionary[modifier]; !isRef || !ok {
-			panic(createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", fmt.Sprintf("Parameter \"%s\" modifier is invalid", p.getInfo().Name)))
+	// Only the modifiers in SupportedModifiers are supported, and resource types
+	// in reference parameters (i.e., the "type" modifier)
+	if info := p.getInfo(); info.Modifier != "" && !isSupportedModifier(p) {
+		panic(createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", fmt.Sprintf("Parameter \"%s\" modifier is invalid", info.Name)))
+	}
+}
+
+func isSupportedModifier(p SearchParam) bool {
+	info := p.getInfo()
+	if _, isText := p.(*TextSearchParam); isText {
+		return false
+	}
+	if _, isRef := p.(*ReferenceParam); isRef {
+		_, ok := SearchParameterDictionary[info.Modifier]
+		return ok || info.Modifier == "identifier"
+	}
+	for _, modifier := range SupportedModifiers[info.Type] {
+		if modifier == info.Modifier {
+			return true
 		}
 	}
+	return false
 }
 
+// Each of the components of a composite must match, and when the components
+// are within the same repeating element (e.g., Observation.component), they
+// must all match the same one.  For example, component-code-value-quantity
+// searches for an Observation component with both the code and the quantity.
 func (m *MongoSearcher) createCompositeQueryObject(resource string, c *CompositeParam) bson.M {
-	panic(createUnsupportedSearchError("MSG_PARAM_UNKNOWN", fmt.Sprintf("Parameter \"%s\" not understood", c.Name)))
+	if len(c.CompositeValues) != len(c.Composites) {
+		panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", c.Name)))
+	}
+
+	infos := make([]SearchParamInfo, len(c.Composites))
+	var paths []string
+	for i, name := range c.Composites {
+		info, ok := SearchParameterDictionary[resource][name]
+		if !ok {
+			panic(createInternalServerError("MSG_PARAM_UNKNOWN", fmt.Sprintf("Parameter \"%s\" not understood", name)))
+		}
+		infos[i] = info
+		for _, p := range info.Paths {
+			paths = append(paths, p.Path)
+		}
+	}
+
+	// Search the components relative to the repeating element they share
+	arrayPath := commonArrayPath(paths)
+	components := make([]SearchParam, len(infos))
+	for i, info := range infos {
+		if arrayPath != "" {
+			relativePaths := make([]SearchParamPath, len(info.Paths))
+			for j, p := range info.Paths {
+				relativePaths[j] = SearchParamPath{Path: strings.TrimPrefix(p.Path, arrayPath+"."), Type: p.Type}
+			}
+			info.Paths = relativePaths
+		}
+		components[i] = info.CreateSearchParam(c.CompositeValues[i])
+	}
+
+	criteria := bson.M{}
+	for _, o := range m.createParamObjects(resource, components) {
+		merge(criteria, o)
+	}
+	if arrayPath == "" {
+		return criteria
+	}
+	return bson.M{strings.Replace(arrayPath, "[]", "", -1): bson.M{"$elemMatch": criteria}}
+}
+
+// commonArrayPath returns the longest array path that all of the paths are
+// within (e.g., "[]component" for "[]component.code" and
+// "[]component.valueQuantity"), or "" if they are not within the same array.
+func commonArrayPath(paths []string) string {
+	var common []string
+	for i, path := range paths {
+		parts := strings.Split(path, ".")
+		parents := parts[:len(parts)-1]
+		if i == 0 {
+			common = parents
+			continue
+		}
+		n := 0
+		for n < len(common) && n < len(parents) && common[n] == parents[n] {
+			n++
+		}
+		common = common[:n]
+	}
+	for len(common) > 0 && !strings.HasPrefix(common[len(common)-1], "[]") {
+		common = common[:len(common)-1]
+	}
+	return strings.Join(common, ".")
 }
 
 func (m *MongoSearcher) createDateQueryObject(d *DateParam) bson.M {
@@ -124,7 +527,7 @@ func (m *MongoSearcher) createDateQueryObject(d *DateParam) bson.M {
 		case "Period":
 			return buildBSON(p.Path, periodSelector(d))
 		case "Timing":
-			return buildBSON(p.Path+".event", dateSelector(d))
+			return buildBSON(p.Path+".[]event", dateSelector(d))
 		default:
 			return bson.M{}
 		}
@@ -133,128 +536,103 @@ func (m *MongoSearcher) createDateQueryObject(d *DateParam) bson.M {
 	return orPaths(single, d.Paths)
 }
 
-// Note that this solution is not 100% correct because we don't represent dates as ranges in the
-// database -- but the FHIR spec calls for this sort of behavior to correctly implement these
-// searches.  An easy example is that while 2012-01-01 should be compared as the range from
-// 00:00:00.000 to 23:59:59.999, we currently only compare against 00:00:00.000 -- so some things
-// that should match, might not.
-// TODO: Fix this via more complex search criteria or by a different representation in the database.
+// Dates are stored with the range of time they cover (see models.FHIRDateTime),
+// from "low" (inclusive) to "high" (exclusive), so they are compared to the
+// range of the search value as the FHIR spec describes.  For example, eq
+// matches values whose range is entirely within the search value's range, so
+// 2012-01 matches 2012-01-15 but 2012-01-15 doesn't match 2012-01.
 func dateSelector(d *DateParam) bson.M {
-	var timeCriteria bson.M
+	low, high := d.Date.RangeLowIncl(), d.Date.RangeHighExcl()
 	switch d.Prefix {
 	case EQ:
-		timeCriteria = bson.M{
-			"$gte": d.Date.RangeLowIncl(),
-			"$lt":  d.Date.RangeHighExcl(),
+		return bson.M{
+			"low":  bson.M{"$gte": low},
+			"high": bson.M{"$lte": high},
 		}
-	case GT:
-		timeCriteria = bson.M{
-			"$gt": d.Date.RangeLowIncl(),
+	case NE:
+		return bson.M{
+			"$or": []bson.M{
+				bson.M{"low": bson.M{"$lt": low}},
+				bson.M{"high": bson.M{"$gt": high}},
+			},
 		}
+	case GT:
+		return bson.M{"high": bson.M{"$gt": high}}
 	case LT:
-		timeCriteria = bson.M{
-			"$lt": d.Date.RangeLowIncl(),
-		}
+		return bson.M{"low": bson.M{"$lt": low}}
 	case GE:
-		timeCriteria = bson.M{
-			"$gte": d.Date.RangeLowIncl(),
+		return bson.M{
+			"$or": []bson.M{
+				bson.M{"high": bson.M{"$gt": high}},
+				bson.M{"low": bson.M{"$gte": low}},
+			},
 		}
 	case LE:
-		timeCriteria = bson.M{
-			"$lt": d.Date.RangeHighExcl(),
+		return bson.M{
+			"$or": []bson.M{
+				bson.M{"low": bson.M{"$lt": low}},
+				bson.M{"high": bson.M{"$lte": high}},
+			},
+		}
+	case SA:
+		return bson.M{"low": bson.M{"$gte": high}}
+	case EB:
+		return bson.M{"high": bson.M{"$lte": low}}
+	case AP:
+		return bson.M{
+			"low":  bson.M{"$lt": d.Date.ApproxRangeHighExcl()},
+			"high": bson.M{"$gt": d.Date.ApproxRangeLowIncl()},
 		}
-	default:
-		panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", d.Name)))
 	}
-
-	return bson.M{"time": timeCriteria}
+	panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", d.Name)))
 }
 
-// Note that this solution is not 100% correct because we don't represent dates as ranges in the
-// database -- but the FHIR spec calls for this sort of behavior to correctly implement these
-// searches.  An easy example is that while 2012-01-01 should be compared as the range from
-// 00:00:00.000 to 23:59:59.999, we currently only compare against 00:00:00.000 -- so some things
-// that should match, might not.
-// TODO: Fix this via more complex search criteria or by a different representation in the database.
+// A period covers the time from the low end of its start to the high end of
+// its end.  A period with no start is treated as starting infinitely long ago,
+// and one with no end (i.e., ongoing) as ending infinitely far in the future.
 func periodSelector(d *DateParam) bson.M {
+	low, high := d.Date.RangeLowIncl(), d.Date.RangeHighExcl()
+
+	startsBefore := func(t time.Time) []bson.M {
+		return []bson.M{
+			bson.M{"start.low": bson.M{"$lt": t}},
+			// Also support instances where period exists, but start is null
+			bson.M{"$ne": nil, "start": nil},
+		}
+	}
+	endsAfter := func(t time.Time) []bson.M {
+		return []bson.M{
+			bson.M{"end.high": bson.M{"$gt": t}},
+			// Also support instances where period exists, but end is null (ongoing)
+			bson.M{"$ne": nil, "end": nil},
+		}
+	}
+
 	switch d.Prefix {
 	case EQ:
 		return bson.M{
-			"start.time": bson.M{
-				"$gte": d.Date.RangeLowIncl(),
-			},
-			"end.time": bson.M{
-				"$lt": d.Date.RangeHighExcl(),
-			},
+			"start.low": bson.M{"$gte": low},
+			"end.high":  bson.M{"$lte": high},
 		}
+	case NE:
+		return bson.M{"$or": append(startsBefore(low), endsAfter(high)...)}
 	case GT:
-		return bson.M{
-			"$or": []bson.M{
-				bson.M{
-					"end.time": bson.M{
-						"$gt": d.Date.RangeLowIncl(),
-					},
-				},
-				// Also support instances where period exists, but end is null (ongoing)
-				bson.M{
-					"$ne": nil,
-					"end": nil,
-				},
-			},
-		}
+		return bson.M{"$or": endsAfter(high)}
 	case LT:
-		return bson.M{
-			"$or": []bson.M{
-				bson.M{
-					"start.time": bson.M{
-						"$lt": d.Date.RangeLowIncl(),
-					},
-				},
-				// Also support instances where period exists, but start is null
-				bson.M{
-					"$ne":   nil,
-					"start": nil,
-				},
-			},
-		}
+		return bson.M{"$or": startsBefore(low)}
 	case GE:
-		return bson.M{
-			"$or": []bson.M{
-				bson.M{
-					"start.time": bson.M{
-						"$gte": d.Date.RangeLowIncl(),
-					},
-				},
-				bson.M{
-					"end.time": bson.M{
-						"$gt": d.Date.RangeLowIncl(),
-					},
-				},
-				// Also support instances where period exists, but end is null (ongoing)
-				bson.M{
-					"$ne": nil,
-					"end": nil,
-				},
-			},
-		}
+		return bson.M{"$or": append(endsAfter(high), bson.M{"start.low": bson.M{"$gte": low}})}
 	case LE:
+		return bson.M{"$or": append(startsBefore(low), bson.M{"end.high": bson.M{"$lte": high}})}
+	case SA:
+		return bson.M{"start.low": bson.M{"$gte": high}}
+	case EB:
+		return bson.M{"end.high": bson.M{"$lte": low}}
+	case AP:
 		return bson.M{
-			"$or": []bson.M{
-				bson.M{
-					"end.time": bson.M{
-						"$lt": d.Date.RangeHighExcl(),
-					},
-				},
-				bson.M{
-					"start.time": bson.M{
-						"$lt": d.Date.RangeLowIncl(),
-					},
-				},
-				// Also support instances where period exists, but start is null
-				bson.M{
-					"$ne":   nil,
-					"start": nil,
-				},
+			"$and": []bson.M{
+				bson.M{"$or": startsBefore(d.Date.ApproxRangeHighExcl())},
+				bson.M{"$or": endsAfter(d.Date.ApproxRangeLowIncl())},
 			},
 		}
 	}
@@ -263,12 +641,7 @@ func periodSelector(d *DateParam) bson.M {
 
 func (m *MongoSearcher) createNumberQueryObject(n *NumberParam) bson.M {
 	single := func(p SearchParamPath) bson.M {
-		l, _ := n.Number.RangeLowIncl().Float64()
-		h, _ := n.Number.RangeHighExcl().Float64()
-		return buildBSON(p.Path, bson.M{
-			"$gte": l,
-			"$lt":  h,
-		})
+		return buildBSON(p.Path, numberSelector(n.Prefix, n.Number, n.Name))
 	}
 
 	return orPaths(single, n.Paths)
@@ -276,20 +649,18 @@ func (m *MongoSearcher) createNumberQueryObject(n *NumberParam) bson.M {
 
 func (m *MongoSearcher) createQuantityQueryObject(q *QuantityParam) bson.M {
 	single := func(p SearchParamPath) bson.M {
-		l, _ := q.Number.RangeLowIncl().Float64()
-		h, _ := q.Number.RangeHighExcl().Float64()
 		criteria := bson.M{
-			"value": bson.M{
-				"$gte": l,
-				"$lt":  h,
-			},
+			"value": numberSelector(q.Prefix, q.Number, q.Name),
 		}
-		if q.System == "" {
+		switch {
+		case q.System == "" && q.Code == "":
+			// A number without units matches quantities in any units
+		case q.System == "":
 			criteria["$or"] = []bson.M{
 				bson.M{"code": ci(q.Code)},
 				bson.M{"unit": ci(q.Code)},
 			}
-		} else {
+		default:
 			criteria["code"] = ci(q.Code)
 			criteria["system"] = ci(q.System)
 		}
@@ -299,7 +670,46 @@ func (m *MongoSearcher) createQuantityQueryObject(q *QuantityParam) bson.M {
 	return orPaths(single, q.Paths)
 }
 
+// numberSelector returns the criteria for matching a number against the search
+// number, taking the prefix and the precision of the search number into
+// account.  The precision makes the search number a range: a search for 100
+// matches the range [99.5, 100.5), "gt100" and "sa100" match numbers above the
+// range (100.5 or more) and "ge100" matches numbers in or above it (99.5 or
+// more).  Since the stored numbers aren't ranges themselves, a number that
+// starts after (or ends before) the range is simply one above (or below) it.
+func numberSelector(prefix Prefix, n *Number, name string) bson.M {
+	low, _ := n.RangeLowIncl().Float64()
+	high, _ := n.RangeHighExcl().Float64()
+
+	switch prefix {
+	case EQ, "":
+		return bson.M{"$gte": low, "$lt": high}
+	case NE:
+		return bson.M{"$exists": true, "$not": bson.M{"$gte": low, "$lt": high}}
+	case GT, SA:
+		return bson.M{"$gte": high}
+	case LT, EB:
+		return bson.M{"$lt": low}
+	case GE:
+		return bson.M{"$gte": low}
+	case LE:
+		return bson.M{"$lt": high}
+	case AP:
+		approxLow, _ := n.ApproxRangeLowIncl().Float64()
+		approxHigh, _ := n.ApproxRangeHighIncl().Float64()
+		return bson.M{"$gte": approxLow, "$lte": approxHigh}
+	}
+	panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", name)))
+}
+
 func (m *MongoSearcher) createReferenceQueryObject(r *ReferenceParam) bson.M {
+	switch ref := r.Reference.(type) {
+	case IdentifierReference:
+		return m.createIdentifierReferenceQueryObject(r, ref)
+	case ChainedQueryReference:
+		return m.createChainedReferenceQueryObject(r, ref)
+	}
+
 	single := func(p SearchParamPath) bson.M {
 		criteria := bson.M{}
 		switch ref := r.Reference.(type) {
@@ -309,61 +719,202 @@ func (m *MongoSearcher) createReferenceQueryObject(r *ReferenceParam) bson.M {
 				criteria["type"] = ref.Type
 			}
 		case ExternalReference:
-			criteria["reference"] = ci(ref.URL)
-		case ChainedQueryReference:
-			// Since MongoDB does not support cross-collection searches, we must break this into two:
-			// (1) perform search against referenced collection using chained search Query
-			// (2) use ID results from first query to build second query
-			var idObjs []struct {
-				ID string `bson:"_id"`
-			}
-			q := m.CreateQueryWithoutOptions(ref.ChainedQuery)
-			q.Select(bson.M{"_id": 1}).All(&idObjs)
-			ids := make([]string, len(idObjs))
-			for i := range idObjs {
-				ids[i] = idObjs[i].ID
+			// The URL may also refer to a specific version of the resource
+			criteria["reference"] = bson.RegEx{Pattern: fmt.Sprintf("^%s(/_history/[^/]+)?$", regexp.QuoteMeta(ref.URL)), Options: "i"}
+		}
+		return buildBSON(p.Path, criteria)
+	}
+
+	return orPaths(single, r.Paths)
+}
+
+// Since MongoDB does not support cross-collection searches, we must break a
+// chained search into two: (1) find the ids of the resources matching the
+// chained query and (2) use them to find the references to those resources.
+// The chained query may itself be chained (e.g., "organization.name" in
+// "subject:Patient.organization.name"), so chains of any length are searched
+// one level at a time, starting from the end of the chain.
+func (m *MongoSearcher) createChainedReferenceQueryObject(r *ReferenceParam, ref ChainedQueryReference) bson.M {
+	types := []string{ref.Type}
+	if ref.Type == "" {
+		types = r.chainedTargets()
+	}
+	ids := make([][]string, len(types))
+	for i, typ := range types {
+		ids[i] = m.findIDs(Query{Resource: typ, Query: ref.ChainedQuery.Query})
+	}
+
+	single := func(p SearchParamPath) bson.M {
+		matches := make([]bson.M, len(types))
+		for i, typ := range types {
+			matches[i] = buildBSON(p.Path, bson.M{"referenceid": bson.M{"$in": ids[i]}, "type": typ})
+		}
+		if len(matches) == 1 {
+			return matches[0]
+		}
+		return bson.M{"$or": matches}
+	}
+
+	return orPaths(single, r.Paths)
+}
+
+// A reverse chained search matches the resources referred to by the resources
+// matching the chained query (e.g., the patients referred to by the patient
+// parameter of observations with the code 1234-5 for
+// "_has:Observation:patient:code=1234-5").  Like chained searches, it is
+// broken into two: (1) find the ids that the matching resources refer to and
+// (2) use them to find the referenced resources.
+func (m *MongoSearcher) createReverseChainQueryObject(resource string, r *ReverseChainParam) bson.M {
+	return bson.M{"_id": bson.M{"$in": m.findReferencedIDs(r.ChainedQuery, r.Reference, resource)}}
+}
+
+// A reference by identifier matches references that have the identifier (see
+// models.Reference) and references to resources that have the identifier.
+// The resources are those of the parameter's declared target types that have
+// an identifier search parameter, so a parameter that can refer to any type
+// only matches references that have the identifier.  Like chained searches,
+// at most ChainedSearchLimit resources may have the identifier.
+func (m *MongoSearcher) createIdentifierReferenceQueryObject(r *ReferenceParam, ref IdentifierReference) bson.M {
+	identifier := bson.M{"identifier.value": ci(ref.Value)}
+	if !ref.AnySystem {
+		identifier["identifier.system"] = ci(ref.System)
+	}
+
+	var targets []string
+	for _, resourceType := range r.Targets {
+		if _, ok := SearchParameterDictionary[resourceType]["identifier"]; ok {
+			targets = append(targets, resourceType)
+		}
+	}
+	sort.Strings(targets)
+
+	referenced := make(map[string][]string)
+	found := 0
+	for _, resourceType := range targets {
+		query := Query{Resource: resourceType, Query: "identifier=" + url.QueryEscape(ref.tokenValue())}
+		if ids := m.findIDs(query); len(ids) > 0 {
+			if found += len(ids); found > ChainedSearchLimit {
+				panic(createChainedSearchTooCostlyError(query))
 			}
-			criteria["referenceid"] = bson.M{"$in": ids}
-			if ref.Type != "" {
-				criteria["type"] = ref.Type
+			referenced[resourceType] = ids
+		}
+	}
+
+	single := func(p SearchParamPath) bson.M {
+		matches := []bson.M{buildBSON(p.Path, identifier)}
+		for _, resourceType := range targets {
+			if ids, ok := referenced[resourceType]; ok {
+				matches = append(matches, buildBSON(p.Path, bson.M{"referenceid": bson.M{"$in": ids}, "type": resourceType}))
 			}
 		}
-		return buildBSON(p.Path, criteria)
+		if len(matches) == 1 {
+			return matches[0]
+		}
+		return bson.M{"$or": matches}
 	}
 
 	return orPaths(single, r.Paths)
 }
 
+// findIDs returns the ids of the resources matching the (chained) query, of
+// which there may be at most ChainedSearchLimit.
+func (m *MongoSearcher) findIDs(query Query) []string {
+	var ids []string
+	var idObj struct {
+		ID string `bson:"_id"`
+	}
+	iter := m.CreateQueryWithoutOptions(query).Select(bson.M{"_id": 1}).Iter()
+	for iter.Next(&idObj) {
+		if len(ids) == ChainedSearchLimit {
+			iter.Close()
+			panic(createChainedSearchTooCostlyError(query))
+		}
+		ids = append(ids, idObj.ID)
+	}
+	if err := iter.Close(); err != nil {
+		panic(createInternalServerError("MSG_DB_ERROR", err.Error()))
+	}
+	return ids
+}
+
+// findReferencedIDs returns the ids of the resources of the given type that are
+// referred to by the reference parameter of the resources matching the
+// (chained) query, of which there may be at most ChainedSearchLimit.  Only the
+// references are read from the matching resources.
+func (m *MongoSearcher) findReferencedIDs(query Query, reference SearchParamInfo, resourceType string) []string {
+	projection := bson.M{}
+	for _, p := range reference.Paths {
+		projection[strings.Replace(p.Path, "[]", "", -1)] = 1
+	}
+
+	ids := []string{}
+	found := make(map[string]bool)
+	var doc bson.M
+	iter := m.CreateQueryWithoutOptions(query).Select(projection).Iter()
+	for iter.Next(&doc) {
+		for _, p := range reference.Paths {
+			for _, ref := range valuesAtPath(doc, p.Path) {
+				refType, _ := ref["type"].(string)
+				refID, _ := ref["referenceid"].(string)
+				if refType != resourceType || refID == "" || found[refID] {
+					continue
+				}
+				if len(ids) == ChainedSearchLimit {
+					iter.Close()
+					panic(createChainedSearchTooCostlyError(query))
+				}
+				found[refID] = true
+				ids = append(ids, refID)
+			}
+		}
+		doc = nil
+	}
+	if err := iter.Close(); err != nil {
+		panic(createInternalServerError("MSG_DB_ERROR", err.Error()))
+	}
+	return ids
+}
+
+// By default, strings match values that start with the string, regardless of
+// case and accents.  The "contains" modifier matches values that contain the
+// string anywhere (also regardless of case and accents), and the "exact"
+// modifier only matches values that are exactly the same as the string.
 func (m *MongoSearcher) createStringQueryObject(s *StringParam) bson.M {
+	var match interface{}
+	switch {
+	case s.Modifier == "exact":
+		match = s.String
+	case s.Modifier == "contains":
+		match = cic(s.String)
+	case s.Name == "_id":
+		match = ci(s.String)
+	default:
+		match = cisw(s.String)
+	}
+
 	single := func(p SearchParamPath) bson.M {
 		switch p.Type {
 		case "HumanName":
 			return buildBSON(p.Path, bson.M{
 				"$or": []bson.M{
-					bson.M{"text": cisw(s.String)},
-					bson.M{"family": cisw(s.String)},
-					bson.M{"given": cisw(s.String)},
+					bson.M{"text": match},
+					bson.M{"family": match},
+					bson.M{"given": match},
 				},
 			})
 		case "Address":
 			return buildBSON(p.Path, bson.M{
 				"$or": []bson.M{
-					bson.M{"text": cisw(s.String)},
-					bson.M{"line": cisw(s.String)},
-					bson.M{"city": cisw(s.String)},
-					bson.M{"state": cisw(s.String)},
-					bson.M{"postalCode": cisw(s.String)},
-					bson.M{"country": cisw(s.String)},
+					bson.M{"text": match},
+					bson.M{"line": match},
+					bson.M{"city": match},
+					bson.M{"state": match},
+					bson.M{"postalCode": match},
+					bson.M{"country": match},
 				},
 			})
 		default:
-			var criteria bson.RegEx
-			if s.Name == "_id" {
-				criteria = ci(s.String)
-			} else {
-				criteria = cisw(s.String)
-			}
-			return buildBSON(p.Path, criteria)
+			return buildBSON(p.Path, match)
 		}
 	}
 
@@ -371,6 +922,26 @@ func (m *MongoSearcher) createStringQueryObject(s *StringParam) bson.M {
 }
 
 func (m *MongoSearcher) createTokenQueryObject(t *TokenParam) bson.M {
+	switch t.Modifier {
+	case "not":
+		return bson.M{"$nor": []bson.M{tokenSelector(t)}}
+	case "text":
+		return tokenTextSelector(t)
+	case "in":
+		return codesSelector(t, m.valueSetCodes(t.Name, t.Code))
+	case "not-in":
+		return bson.M{"$nor": []bson.M{codesSelector(t, m.valueSetCodes(t.Name, t.Code))}}
+	case "above", "below":
+		if t.AnySystem {
+			panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", t.Name)))
+		}
+		return codesSelector(t, m.hierarchyCodes(t.Name, t.System, t.Code, t.Modifier == "above"))
+	}
+	return tokenSelector(t)
+}
+
+// tokenSelector returns the criteria for matching the token's system and code.
+func tokenSelector(t *TokenParam) bson.M {
 	single := func(p SearchParamPath) bson.M {
 		criteria := bson.M{}
 		switch p.Type {
@@ -407,6 +978,104 @@ func (m *MongoSearcher) createTokenQueryObject(t *TokenParam) bson.M {
 	return orPaths(single, t.Paths)
 }
 
+// tokenTextSelector returns the criteria for matching the text associated with
+// the codes and identifiers, which is matched in the same way as strings.
+func tokenTextSelector(t *TokenParam) bson.M {
+	var paths []SearchParamPath
+	for _, p := range t.Paths {
+		switch p.Type {
+		case "Coding", "CodeableConcept", "Identifier":
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == 0 {
+		panic(createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", fmt.Sprintf("Parameter \"%s\" modifier is invalid", t.Name)))
+	}
+
+	single := func(p SearchParamPath) bson.M {
+		switch p.Type {
+		case "Coding":
+			return buildBSON(p.Path, bson.M{"display": cisw(t.Code)})
+		case "CodeableConcept":
+			return buildBSON(p.Path, bson.M{
+				"$or": []bson.M{
+					bson.M{"text": cisw(t.Code)},
+					bson.M{"coding.display": cisw(t.Code)},
+				},
+			})
+		default:
+			return buildBSON(p.Path, bson.M{
+				"$or": []bson.M{
+					bson.M{"type.text": cisw(t.Code)},
+					bson.M{"type.coding.display": cisw(t.Code)},
+				},
+			})
+		}
+	}
+
+	return orPaths(single, paths)
+}
+
+// codesSelector returns the criteria for matching any of the codes, which are
+// grouped by their systems (see valueSetCodes).
+func codesSelector(t *TokenParam, codes map[string][]string) bson.M {
+	if len(codes) == 0 {
+		return matchNothing()
+	}
+
+	systems := make([]string, 0, len(codes))
+	for system := range codes {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+
+	single := func(p SearchParamPath) bson.M {
+		var results []bson.M
+		for _, system := range systems {
+			in := bson.M{"$in": codes[system]}
+			var criteria interface{}
+			switch p.Type {
+			case "Coding":
+				criteria = withSystem(bson.M{"code": in}, system)
+			case "CodeableConcept":
+				criteria = bson.M{"coding": bson.M{"$elemMatch": withSystem(bson.M{"code": in}, system)}}
+			case "Identifier":
+				criteria = withSystem(bson.M{"value": in}, system)
+			case "ContactPoint":
+				criteria = bson.M{"value": in}
+			case "code", "boolean", "string":
+				criteria = in
+			default:
+				continue
+			}
+			results = append(results, buildBSON(p.Path, criteria))
+		}
+
+		switch len(results) {
+		case 0:
+			return matchNothing()
+		case 1:
+			return results[0]
+		}
+		return bson.M{"$or": results}
+	}
+
+	return orPaths(single, t.Paths)
+}
+
+// withSystem adds the system to the criteria, unless the codes have no system.
+func withSystem(criteria bson.M, system string) bson.M {
+	if system != "" {
+		criteria["system"] = system
+	}
+	return criteria
+}
+
+// matchNothing returns criteria that no resource matches.
+func matchNothing() bson.M {
+	return bson.M{"_id": bson.M{"$in": []string{}}}
+}
+
 func (m *MongoSearcher) createURIQueryObject(u *URIParam) bson.M {
 	single := func(p SearchParamPath) bson.M {
 		return buildBSON(p.Path, u.URI)
@@ -415,6 +1084,31 @@ func (m *MongoSearcher) createURIQueryObject(u *URIParam) bson.M {
 	return orPaths(single, u.Paths)
 }
 
+// A parameter is missing when none of its paths have a value, i.e., the
+// elements are absent, null, or (for arrays) empty.
+func (m *MongoSearcher) createMissingQueryObject(p *MissingParam) bson.M {
+	if p.Missing {
+		present := make([]bson.M, len(p.Paths))
+		for i := range p.Paths {
+			present[i] = presentSelector(p.Paths[i])
+		}
+		return bson.M{"$nor": present}
+	}
+
+	return orPaths(presentSelector, p.Paths)
+}
+
+// presentSelector returns the criteria for a path having a value.  Values in
+// arrays along the path are found using dot notation, so the criteria match if
+// any of the elements of the arrays have a value.
+func presentSelector(p SearchParamPath) bson.M {
+	normalizedPath := strings.Replace(p.Path, "[]", "", -1)
+	if leaf := p.Path[strings.LastIndex(p.Path, ".")+1:]; strings.HasPrefix(leaf, "[]") {
+		return bson.M{normalizedPath + ".0": bson.M{"$exists": true}}
+	}
+	return bson.M{normalizedPath: bson.M{"$ne": nil}}
+}
+
 func (m *MongoSearcher) createOrQueryObject(resource string, o *OrParam) bson.M {
 	return bson.M{
 		"$or": m.createParamObjects(resource, o.Items),
@@ -425,8 +1119,9 @@ func createOpOutcome(severity, code, detailsCode, detailsDisplay string) *models
 	outcome := &models.OperationOutcome{
 		Issue: []models.OperationOutcomeIssueComponent{
 			models.OperationOutcomeIssueComponent{
-				Severity: severity,
-				Code:     code,
+				Severity:    severity,
+				Code:        code,
+				Diagnostics: detailsDisplay,
 			},
 		},
 	}
@@ -452,6 +1147,17 @@ type Error struct {
 	OperationOutcome *models.OperationOutcome
 }
 
+// Error returns the display text of the first issue in the operation outcome,
+// so that search errors can also be handled as regular Go errors.
+func (e *Error) Error() string {
+	if e.OperationOutcome != nil && len(e.OperationOutcome.Issue) > 0 {
+		if details := e.OperationOutcome.Issue[0].Details; details != nil && details.Text != "" {
+			return details.Text
+		}
+	}
+	return http.StatusText(e.HTTPStatus)
+}
+
 func createUnsupportedSearchError(code, display string) *Error {
 	return &Error{
 		HTTPStatus:       http.StatusNotImplemented,
@@ -466,6 +1172,13 @@ func createInvalidSearchError(code, display string) *Error {
 	}
 }
 
+func createChainedSearchTooCostlyError(query Query) *Error {
+	return &Error{
+		HTTPStatus:       http.StatusForbidden,
+		OperationOutcome: createOpOutcome("error", "too-costly", "MSG_PARAM_CHAINED", fmt.Sprintf("Chained search on %s matches more than %d resources", query.Resource, ChainedSearchLimit)),
+	}
+}
+
 func createInternalServerError(code, display string) *Error {
 	return &Error{
 		HTTPStatus:       http.StatusInternalServerError,
@@ -525,25 +1238,26 @@ func isQueryOperator(key string) bool {
 
 func processQueryOperatorCriteria(path string, key string, value interface{}, result bson.M) {
 	switch key {
-	case "$or":
-		processOrCriteria(path, value, result)
+	case "$or", "$and":
+		processLogicalCriteria(path, key, value, result)
 	default:
-		criteria, ok := result[path]
+		normalizedPath := strings.Replace(path, "[]", "", -1)
+		criteria, ok := result[normalizedPath]
 		if !ok {
 			criteria = bson.M{}
-			result[path] = criteria
+			result[normalizedPath] = criteria
 		}
 		criteria.(bson.M)[key] = value
 	}
 }
 
-func processOrCriteria(path string, orValue interface{}, result bson.M) {
-	if ors, ok := orValue.([]bson.M); ok {
-		newOrs := make([]bson.M, len(ors))
-		for i := range ors {
-			newOrs[i] = buildBSON(path, ors[i])
+func processLogicalCriteria(path string, key string, value interface{}, result bson.M) {
+	if clauses, ok := value.([]bson.M); ok {
+		newClauses := make([]bson.M, len(clauses))
+		for i := range clauses {
+			newClauses[i] = buildBSON(path, clauses[i])
 		}
-		result["$or"] = newOrs
+		result[key] = newClauses
 	} else {
 		panic(createInternalServerError("", ""))
 	}
@@ -554,9 +1268,68 @@ func ci(s string) bson.RegEx {
 	return bson.RegEx{Pattern: fmt.Sprintf("^%s$", regexp.QuoteMeta(s)), Options: "i"}
 }
 
-// Case-insensitive starts-with
+// Case- and accent-insensitive starts-with
 func cisw(s string) bson.RegEx {
-	return bson.RegEx{Pattern: fmt.Sprintf("^%s", regexp.QuoteMeta(s)), Options: "i"}
+	return bson.RegEx{Pattern: fmt.Sprintf("^%s", accentInsensitive(s)), Options: "i"}
+}
+
+// Case- and accent-insensitive contains
+func cic(s string) bson.RegEx {
+	return bson.RegEx{Pattern: accentInsensitive(s), Options: "i"}
+}
+
+// accentVariants lists the accented forms of letters.
+var accentVariants = map[rune]string{
+	'a': "àáâãäåāăą",
+	'c': "çćĉċč",
+	'd': "ďđ",
+	'e': "èéêëēĕėęě",
+	'g': "ĝğġģ",
+	'h': "ĥħ",
+	'i': "ìíîïĩīĭįı",
+	'j': "ĵ",
+	'k': "ķ",
+	'l': "ĺļľŀł",
+	'n': "ñńņňŉ",
+	'o': "òóôõöøōŏő",
+	'r': "ŕŗř",
+	's': "śŝşšș",
+	't': "ţťŧț",
+	'u': "ùúûüũūŭůűų",
+	'w': "ŵ",
+	'y': "ýÿŷ",
+	'z': "źżž",
+}
+
+// accentBases maps accented letters to the letters without the accents.
+var accentBases = func() map[rune]rune {
+	bases := make(map[rune]rune)
+	for base, variants := range accentVariants {
+		for _, variant := range variants {
+			bases[variant] = base
+		}
+	}
+	return bases
+}()
+
+// accentInsensitive returns a regular expression matching the string regardless
+// of accents, so "Jose" and "José" both match "Jose" and "José".  Each letter is
+// replaced by a character class of the letter and its accented forms (in both
+// cases, since the database may not fold the case of the accented forms).
+func accentInsensitive(s string) string {
+	var pattern bytes.Buffer
+	for _, r := range s {
+		letter := unicode.ToLower(r)
+		if base, ok := accentBases[letter]; ok {
+			letter = base
+		}
+		if variants, ok := accentVariants[letter]; ok {
+			pattern.WriteString("[" + string(letter) + variants + strings.ToUpper(variants) + "]")
+		} else {
+			pattern.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return pattern.String()
 }
 
 // When multiple paths are present, they should be represented as an OR.
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -59,6 +60,7 @@ func (m *MongoSearchSuite) SetUpSuite(c *C) {
 		collection := models.PluralizeLowerResourceName(reflect.TypeOf(r).Elem().Name())
 		util.CheckErr(db.C(collection).Insert(r))
 	}
+	util.CheckErr(EnsureTextIndexes(db))
 }
 
 func (m *MongoSearchSuite) TearDownSuite(c *C) {
@@ -141,6 +143,192 @@ func (m *MongoSearchSuite) TestConditionCodeQueryByCode(c *C) {
 	c.Assert(foundIvd && foundCad, Equals, true)
 }
 
+// Tests token searches with modifiers
+
+func (m *MongoSearchSuite) TestConditionCodeQueryObjectWithNotModifier(c *C) {
+	q := Query{"Condition", "code:not=http://snomed.info/sct|123641001"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$nor": []bson.M{
+			bson.M{
+				"code.coding": bson.M{
+					"$elemMatch": bson.M{
+						"system": bson.RegEx{Pattern: "^http://snomed\\.info/sct$", Options: "i"},
+						"code":   bson.RegEx{Pattern: "^123641001$", Options: "i"},
+					},
+				},
+			},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestConditionCodeQueryObjectWithTextModifier(c *C) {
+	q := Query{"Condition", "code:text=Pertussis"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"code.text": cisw("Pertussis")},
+			bson.M{"code.coding.display": cisw("Pertussis")},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestConditionCodeQueryWithModifiers(c *C) {
+	for query, expected := range map[string]int{
+		"code:not=http://snomed.info/sct|123641001": 4,
+		"code:not=123641001":                        4,
+		"code:not=http://snomed.info/sct|":          0,
+		"code:text=diagnosis":                       5,
+		"code:text=Pertussis":                       1,
+		"code:text=Heart":                           0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Condition", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestCodesSelector(c *C) {
+	t := &TokenParam{SearchParamInfo: SearchParameterDictionary["Condition"]["code"]}
+	o := codesSelector(t, map[string][]string{
+		"http://snomed.info/sct":        []string{"10091002", "123641001"},
+		"http://hl7.org/fhir/sid/icd-9": []string{"401.1"},
+	})
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{
+				"code.coding": bson.M{
+					"$elemMatch": bson.M{
+						"system": "http://hl7.org/fhir/sid/icd-9",
+						"code":   bson.M{"$in": []string{"401.1"}},
+					},
+				},
+			},
+			bson.M{
+				"code.coding": bson.M{
+					"$elemMatch": bson.M{
+						"system": "http://snomed.info/sct",
+						"code":   bson.M{"$in": []string{"10091002", "123641001"}},
+					},
+				},
+			},
+		},
+	})
+
+	c.Assert(codesSelector(t, map[string][]string{}), DeepEquals, matchNothing())
+}
+
+func (m *MongoSearchSuite) TestConditionCodeQueryWithValueSetModifiers(c *C) {
+	valueSets := m.Session.DB("fhir-test").C("valuesets")
+	for _, vs := range testValueSets() {
+		util.CheckErr(valueSets.Insert(vs))
+		defer valueSets.RemoveId(vs.Id)
+	}
+
+	for query, expected := range map[string]int{
+		"code:in=http://example.org/fhir/ValueSet/heart-disease":     3,
+		"code:in=ValueSet/heart-disease":                             3,
+		"code:in=http://example.org/fhir/ValueSet/hypertension":      1,
+		"code:in=http://example.org/fhir/ValueSet/ischemic":          2,
+		"code:in=http://example.org/fhir/ValueSet/non-ischemic":      2,
+		"code:in=http://example.org/fhir/ValueSet/cardiovascular":    4,
+		"code:not-in=http://example.org/fhir/ValueSet/heart-disease": 3,
+		"code:below=http://snomed.info/sct|56265001":                 3,
+		"code:below=http://snomed.info/sct|49601007":                 4,
+		"code:below=http://snomed.info/sct|123641001":                2,
+		"code:below=http://snomed.info/sct|404684003":                0,
+		"code:above=http://snomed.info/sct|123641001":                2,
+		"code:above=http://snomed.info/sct|414545008":                0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Condition", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+
+	q := Query{"Condition", "code:in=http://example.org/fhir/ValueSet/unknown"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"code\" refers to an unknown value set \"http://example.org/fhir/ValueSet/unknown\""))
+
+	q = Query{"Condition", "code:below=http://example.org/unknown|123"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"code\" refers to an unknown code system \"http://example.org/unknown\""))
+
+	q = Query{"Condition", "code:below=123641001"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"code\" content is invalid"))
+}
+
+// testValueSets returns a (very) small part of the SNOMED CT hierarchy and some
+// value sets built on it.
+func testValueSets() []*models.ValueSet {
+	snomed := "http://snomed.info/sct"
+	concept := func(code string, children ...models.ValueSetConceptDefinitionComponent) models.ValueSetConceptDefinitionComponent {
+		return models.ValueSetConceptDefinitionComponent{Code: code, Concept: children}
+	}
+	return []*models.ValueSet{
+		&models.ValueSet{
+			Id:  "snomed",
+			Url: "http://example.org/fhir/ValueSet/snomed",
+			CodeSystem: &models.ValueSetCodeSystemComponent{
+				System: snomed,
+				Concept: []models.ValueSetConceptDefinitionComponent{
+					concept("49601007", // Disorder of cardiovascular system
+						concept("56265001", // Heart disease
+							concept("84114007", concept("10091002")),   // Heart failure
+							concept("414545008", concept("123641001")), // Ischemic heart disease
+						),
+						concept("38341003", concept("10725009")), // Hypertensive disorder
+					),
+				},
+			},
+		},
+		&models.ValueSet{
+			Id:  "heart-disease",
+			Url: "http://example.org/fhir/ValueSet/heart-disease",
+			Compose: &models.ValueSetComposeComponent{
+				Include: []models.ValueSetConceptSetComponent{
+					{System: snomed, Filter: []models.ValueSetConceptSetFilterComponent{{Property: "concept", Op: "is-a", Value: "56265001"}}},
+				},
+			},
+		},
+		&models.ValueSet{
+			Id:  "hypertension",
+			Url: "http://example.org/fhir/ValueSet/hypertension",
+			Expansion: &models.ValueSetExpansionComponent{
+				Contains: []models.ValueSetExpansionContainsComponent{
+					{System: "http://hl7.org/fhir/sid/icd-9", Code: "401.1"},
+				},
+			},
+		},
+		&models.ValueSet{
+			Id:  "ischemic",
+			Url: "http://example.org/fhir/ValueSet/ischemic",
+			Compose: &models.ValueSetComposeComponent{
+				Include: []models.ValueSetConceptSetComponent{
+					{System: "http://hl7.org/fhir/sid/icd-10", Concept: []models.ValueSetConceptReferenceComponent{{Code: "I20.0"}}},
+				},
+			},
+		},
+		&models.ValueSet{
+			Id:  "non-ischemic",
+			Url: "http://example.org/fhir/ValueSet/non-ischemic",
+			Compose: &models.ValueSetComposeComponent{
+				Import: []string{"http://example.org/fhir/ValueSet/cardiovascular"},
+				Exclude: []models.ValueSetConceptSetComponent{
+					{System: snomed, Filter: []models.ValueSetConceptSetFilterComponent{{Property: "concept", Op: "is-a", Value: "414545008"}}},
+				},
+			},
+		},
+		&models.ValueSet{
+			Id:  "cardiovascular",
+			Url: "http://example.org/fhir/ValueSet/cardiovascular",
+			Compose: &models.ValueSetComposeComponent{
+				Import: []string{"http://example.org/fhir/ValueSet/non-ischemic"},
+				Include: []models.ValueSetConceptSetComponent{
+					{System: snomed},
+				},
+			},
+		},
+	}
+}
+
 // Tests token searches on Coding
 
 func (m *MongoSearchSuite) TestImagingStudyBodySiteQueryObjectBySystemAndCode(c *C) {
@@ -248,11 +436,93 @@ func (m *MongoSearchSuite) TestConditionReferenceQueryObjectByPatientURL(c *C) {
 	q := Query{"Condition", "patient=http://acme.com/Patient/123456789"}
 
 	o := m.MongoSearcher.createQueryObject(q)
-	c.Assert(o, DeepEquals, bson.M{"patient.reference": bson.RegEx{Pattern: "^http://acme\\.com/Patient/123456789$", Options: "i"}})
+	c.Assert(o, DeepEquals, bson.M{"patient.reference": bson.RegEx{Pattern: "^http://acme\\.com/Patient/123456789(/_history/[^/]+)?$", Options: "i"}})
 }
 
 // TODO: Test execution of reference search on PatientURL (as above)
 
+func (m *MongoSearchSuite) TestConditionReferenceQueryObjectByVersionedPatient(c *C) {
+	q := Query{"Condition", "patient=Patient/4954037118555241963/_history/2"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{"patient.referenceid": bson.RegEx{Pattern: "^4954037118555241963$", Options: "i"}, "patient.type": "Patient"})
+
+	q = Query{"Condition", "patient=http://acme.com/Patient/123456789/_history/2"}
+
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{"patient.reference": bson.RegEx{Pattern: "^http://acme\\.com/Patient/123456789(/_history/[^/]+)?$", Options: "i"}})
+}
+
+func (m *MongoSearchSuite) TestConditionReferenceQueryByVersionedPatient(c *C) {
+	q := Query{"Condition", "patient=Patient/4954037118555241963/_history/2"}
+	mq := m.MongoSearcher.CreateQuery(q)
+	num, err := mq.Count()
+	util.CheckErr(err)
+	c.Assert(num, Equals, 5)
+}
+
+// Test reference searches by identifier
+
+func (m *MongoSearchSuite) TestObservationReferenceQueryObjectByEncounterIdentifier(c *C) {
+	q := Query{"Observation", "encounter:identifier=http://acme.com|1"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{
+				"encounter.identifier.system": bson.RegEx{Pattern: "^http://acme\\.com$", Options: "i"},
+				"encounter.identifier.value":  bson.RegEx{Pattern: "^1$", Options: "i"},
+			},
+			bson.M{
+				"encounter.referenceid": bson.M{"$in": []string{"6648204100111387580"}},
+				"encounter.type":        "Encounter",
+			},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestAnyReferenceQueryObjectByIdentifier(c *C) {
+	// References to any type only match by the identifier in the reference
+	q := Query{"Basic", "subject:identifier=http://hospital|MRN123"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"subject.identifier.system": bson.RegEx{Pattern: "^http://hospital$", Options: "i"},
+		"subject.identifier.value":  bson.RegEx{Pattern: "^MRN123$", Options: "i"},
+	})
+}
+
+func (m *MongoSearchSuite) TestReferenceQueryByIdentifier(c *C) {
+	// An observation that refers to its subject by identifier only
+	observations := m.Session.DB("fhir-test").C("observations")
+	util.CheckErr(observations.Insert(&models.Observation{
+		Id: "identified-subject",
+		Subject: &models.Reference{
+			Identifier: &models.Identifier{System: "http://hospital", Value: "MRN123"},
+		},
+	}))
+	defer observations.RemoveId("identified-subject")
+
+	for query, expected := range map[string]int{
+		"encounter:identifier=http://acme.com|1":    1,
+		"encounter:identifier=a":                    1,
+		"encounter:identifier=http://acme.com|2":    0,
+		"encounter:identifier=http://acme.com|a":    0,
+		"subject:identifier=http://hospital|MRN123": 1,
+		"subject:identifier=mrn123":                 1,
+		"subject:identifier=http://hospital|MRN456": 0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Observation", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+
+	q := Query{"Procedure", "encounter:identifier=http://example.com|a"}
+	num, err := m.MongoSearcher.CreateQuery(q).Count()
+	util.CheckErr(err)
+	c.Assert(num, Equals, 1)
+}
+
 // Test reference searches on chained queries
 
 func (m *MongoSearchSuite) TestConditionReferenceQueryObjectByPatientGender(c *C) {
@@ -279,24 +549,114 @@ func (m *MongoSearchSuite) TestConditionReferenceQueryByPatientGender(c *C) {
 	c.Assert(num, Equals, 1)
 }
 
+func (m *MongoSearchSuite) TestMultiLevelChainedReferenceQuery(c *C) {
+	for query, expected := range map[string]int{
+		"encounter.patient.gender=male":                              1,
+		"encounter:Encounter.patient:Patient.gender=male":            1,
+		"encounter.patient.gender=female":                            0,
+		"encounter.patient.gender=female,male":                       1,
+		"encounter.identifier=http://acme.com|1&subject.gender=male": 1,
+		"subject.gender=male":                                        4,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Observation", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestChainedReferenceQueryOnMultipleTargets(c *C) {
+	// Observations on a patient and a device that both belong to Acme
+	db := m.Session.DB("fhir-test")
+	acme := &models.Reference{Reference: "Organization/acme", Type: "Organization", ReferencedID: "acme"}
+	util.CheckErr(db.C("organizations").Insert(&models.Organization{Id: "acme", Name: "Acme Healthcare"}))
+	defer db.C("organizations").RemoveId("acme")
+	util.CheckErr(db.C("patients").Insert(&models.Patient{Id: "acme-patient", ManagingOrganization: acme}))
+	defer db.C("patients").RemoveId("acme-patient")
+	util.CheckErr(db.C("devices").Insert(&models.Device{Id: "acme-device", Owner: acme}))
+	defer db.C("devices").RemoveId("acme-device")
+	for _, subject := range []string{"Patient/acme-patient", "Device/acme-device"} {
+		split := strings.Split(subject, "/")
+		util.CheckErr(db.C("observations").Insert(&models.Observation{
+			Id:      "acme-" + split[1],
+			Subject: &models.Reference{Reference: subject, Type: split[0], ReferencedID: split[1]},
+		}))
+		defer db.C("observations").RemoveId("acme-" + split[1])
+	}
+
+	for query, expected := range map[string]int{
+		"subject.organization.name=Acme":                     2,
+		"subject:Patient.organization.name=Acme":             1,
+		"subject:Device.organization.name=Acme":              1,
+		"subject:Location.organization.name=Acme":            0,
+		"subject.organization.name=Widgets":                  0,
+		"subject.organization:Organization._id=acme":         2,
+		"subject:Patient.organization:Organization._id=acme": 1,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Observation", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+// Test reverse chained (_has) searches
+
+func (m *MongoSearchSuite) TestReverseChainQueryObject(c *C) {
+	q := Query{"Patient", "_has:Condition:patient:code=http://snomed.info/sct|27836007"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{"_id": bson.M{"$in": []string{"4954037118555579315"}}})
+}
+
+func (m *MongoSearchSuite) TestReverseChainQuery(c *C) {
+	for query, expected := range map[string]int{
+		"_has:Condition:patient:code=http://snomed.info/sct|27836007":               1,
+		"_has:Condition:patient:code=123641001":                                     1,
+		"_has:Condition:patient:code=123641001,27836007":                            2,
+		"_has:Condition:patient:code=http://snomed.info/sct|27836007&gender=male":   0,
+		"_has:Condition:patient:code=http://snomed.info/sct|27836007&gender=female": 1,
+		"_has:Condition:patient:code=foo":                                           0,
+		"_has:Observation:subject:code=17856-6":                                     1,
+		"_has:Observation:patient:code=3141-9":                                      0,
+		"_has:Encounter:patient:_has:Observation:encounter:code=17856-6":            1,
+		"_has:Encounter:patient:_has:Observation:encounter:code=3141-9":             0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Patient", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestChainedSearchLimitPanics(c *C) {
+	defer func(limit int) { ChainedSearchLimit = limit }(ChainedSearchLimit)
+	ChainedSearchLimit = 1
+
+	q := Query{"Condition", "patient.gender=male"}
+	_, err := m.MongoSearcher.CreateQuery(q).Count()
+	util.CheckErr(err)
+
+	q = Query{"Observation", "encounter.patient.gender=male"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createChainedSearchTooCostlyError(Query{Resource: "Encounter"}))
+
+	q = Query{"Patient", "_has:Condition:patient:code=123641001,27836007"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createChainedSearchTooCostlyError(Query{Resource: "Condition"}))
+}
+
 // Test date searches on DateTime / Period
 
 func (m *MongoSearchSuite) TestConditionOnsetQueryObject(c *C) {
 	q := Query{"Condition", "onset=2012-03-01T07:00-05:00"}
 
 	o := m.MongoSearcher.createQueryObject(q)
-	// 2012-03-01T07:00-05:00 <= onsetDateTime < 2012-03-01T07:01-05:00
-	onsetDt := o["$or"].([]bson.M)[0]["onsetDateTime.time"].(bson.M)
-	c.Assert(onsetDt, HasLen, 2)
-	gte := onsetDt["$gte"].(time.Time)
-	lt := onsetDt["$lt"].(time.Time)
-	c.Assert(gte.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
-	c.Assert(lt.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
-
-	// 2012-03-01T07:00:00-05:00 <= onsetPeriod.start <= onsetPeriod.end < 2012-03-01T07:01:00-05:00
-	start := o["$or"].([]bson.M)[1]["onsetPeriod.start.time"].(bson.M)["$gte"].(time.Time)
+	// 2012-03-01T07:00-05:00 <= onsetDateTime.low <= onsetDateTime.high <= 2012-03-01T07:01-05:00
+	low := o["$or"].([]bson.M)[0]["onsetDateTime.low"].(bson.M)["$gte"].(time.Time)
+	c.Assert(low.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
+	high := o["$or"].([]bson.M)[0]["onsetDateTime.high"].(bson.M)["$lte"].(time.Time)
+	c.Assert(high.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
+
+	// 2012-03-01T07:00:00-05:00 <= onsetPeriod.start.low <= onsetPeriod.end.high <= 2012-03-01T07:01:00-05:00
+	start := o["$or"].([]bson.M)[1]["onsetPeriod.start.low"].(bson.M)["$gte"].(time.Time)
 	c.Assert(start.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
-	end := o["$or"].([]bson.M)[1]["onsetPeriod.end.time"].(bson.M)["$lt"].(time.Time)
+	end := o["$or"].([]bson.M)[1]["onsetPeriod.end.high"].(bson.M)["$lte"].(time.Time)
 	c.Assert(end.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
 }
 
@@ -323,6 +683,19 @@ func (m *MongoSearchSuite) TestConditionOnsetQueryToDay(c *C) {
 	c.Assert(num, Equals, 5)
 }
 
+func (m *MongoSearchSuite) TestConditionOnsetQueryToMonthAndYear(c *C) {
+	for query, expected := range map[string]int{
+		"onset=2012-03": 5,
+		"onset=2012-02": 0,
+		"onset=2012":    5,
+		"onset=2011":    0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Condition", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 func (m *MongoSearchSuite) TestConditionOnsetQueryWrongTime(c *C) {
 	q := Query{"Condition", "onset=2012-03-01T08:00-05:00"}
 	mq := m.MongoSearcher.CreateQuery(q)
@@ -338,13 +711,13 @@ func (m *MongoSearchSuite) TestConditionOnsetGTQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"onsetDateTime.time": bson.M{
-					"$gt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
+				"onsetDateTime.high": bson.M{
+					"$gt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.end.time": bson.M{
-					"$gt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
+				"onsetPeriod.end.high": bson.M{
+					"$gt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
 				},
 			},
 			bson.M{
@@ -370,12 +743,12 @@ func (m *MongoSearchSuite) TestConditionOnsetLTQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"onsetDateTime.time": bson.M{
+				"onsetDateTime.low": bson.M{
 					"$lt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.start.time": bson.M{
+				"onsetPeriod.start.low": bson.M{
 					"$lt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
 				},
 			},
@@ -402,24 +775,29 @@ func (m *MongoSearchSuite) TestConditionOnsetGEQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"onsetDateTime.time": bson.M{
-					"$gte": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
+				"onsetDateTime.high": bson.M{
+					"$gt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.start.time": bson.M{
+				"onsetDateTime.low": bson.M{
 					"$gte": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.end.time": bson.M{
-					"$gt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
+				"onsetPeriod.end.high": bson.M{
+					"$gt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
 				},
 			},
 			bson.M{
 				"onsetPeriod":     bson.M{"$ne": nil},
 				"onsetPeriod.end": nil,
 			},
+			bson.M{
+				"onsetPeriod.start.low": bson.M{
+					"$gte": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
+				},
+			},
 		},
 	})
 }
@@ -439,17 +817,17 @@ func (m *MongoSearchSuite) TestConditionOnsetLEQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"onsetDateTime.time": bson.M{
-					"$lt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
+				"onsetDateTime.low": bson.M{
+					"$lt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.end.time": bson.M{
-					"$lt": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
+				"onsetDateTime.high": bson.M{
+					"$lte": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
 				},
 			},
 			bson.M{
-				"onsetPeriod.start.time": bson.M{
+				"onsetPeriod.start.low": bson.M{
 					"$lt": time.Date(2012, time.March, 1, 7, 0, 0, 0, m.Local),
 				},
 			},
@@ -457,6 +835,11 @@ func (m *MongoSearchSuite) TestConditionOnsetLEQueryObject(c *C) {
 				"onsetPeriod":       bson.M{"$ne": nil},
 				"onsetPeriod.start": nil,
 			},
+			bson.M{
+				"onsetPeriod.end.high": bson.M{
+					"$lte": time.Date(2012, time.March, 1, 7, 1, 0, 0, m.Local),
+				},
+			},
 		},
 	})
 }
@@ -477,14 +860,14 @@ func (m *MongoSearchSuite) TestEncounterPeriodQueryObject(c *C) {
 	o := m.MongoSearcher.createQueryObject(q)
 	c.Assert(o, HasLen, 2)
 
-	// 2012-11-01T08:50:00-05:00 <= period.start <= period.end < 2012-11-01T08:51:00-05:00
+	// 2012-11-01T08:50:00-05:00 <= period.start.low <= period.end.high <= 2012-11-01T08:51:00-05:00
 
-	c.Assert(o["period.start.time"].(bson.M), HasLen, 1)
-	start := o["period.start.time"].(bson.M)["$gte"].(time.Time)
+	c.Assert(o["period.start.low"].(bson.M), HasLen, 1)
+	start := o["period.start.low"].(bson.M)["$gte"].(time.Time)
 	c.Assert(start.UnixNano(), Equals, time.Date(2012, time.November, 1, 8, 50, 0, 0, m.EST).UnixNano())
 
-	c.Assert(o["period.end.time"].(bson.M), HasLen, 1)
-	end := o["period.end.time"].(bson.M)["$lt"].(time.Time)
+	c.Assert(o["period.end.high"].(bson.M), HasLen, 1)
+	end := o["period.end.high"].(bson.M)["$lte"].(time.Time)
 	c.Assert(end.UnixNano(), Equals, time.Date(2012, time.November, 1, 8, 51, 0, 0, m.EST).UnixNano())
 }
 
@@ -512,8 +895,8 @@ func (m *MongoSearchSuite) TestEncounterPeriodGTQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"period.end.time": bson.M{
-					"$gt": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
+				"period.end.high": bson.M{
+					"$gt": time.Date(2012, time.November, 1, 8, 31, 0, 0, m.Local),
 				},
 			},
 			bson.M{
@@ -525,11 +908,12 @@ func (m *MongoSearchSuite) TestEncounterPeriodGTQueryObject(c *C) {
 }
 
 func (m *MongoSearchSuite) TestEncounterPeriodGTQuery(c *C) {
+	// The encounter ending at 08:50:45 ends within the minute, not after it
 	q := Query{"Encounter", "date=gt2012-11-01T08:50-05:00"}
 	mq := m.MongoSearcher.CreateQuery(q)
 	num, err := mq.Count()
 	util.CheckErr(err)
-	c.Assert(num, Equals, 2)
+	c.Assert(num, Equals, 1)
 }
 
 func (m *MongoSearchSuite) TestEncounterPeriodLTQueryObject(c *C) {
@@ -540,7 +924,7 @@ func (m *MongoSearchSuite) TestEncounterPeriodLTQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"period.start.time": bson.M{
+				"period.start.low": bson.M{
 					"$lt": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
 				},
 			},
@@ -568,19 +952,19 @@ func (m *MongoSearchSuite) TestEncounterPeriodGEQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"period.start.time": bson.M{
-					"$gte": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
-				},
-			},
-			bson.M{
-				"period.end.time": bson.M{
-					"$gt": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
+				"period.end.high": bson.M{
+					"$gt": time.Date(2012, time.November, 1, 8, 31, 0, 0, m.Local),
 				},
 			},
 			bson.M{
 				"period":     bson.M{"$ne": nil},
 				"period.end": nil,
 			},
+			bson.M{
+				"period.start.low": bson.M{
+					"$gte": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
+				},
+			},
 		},
 	})
 }
@@ -601,12 +985,7 @@ func (m *MongoSearchSuite) TestEncounterPeriodLEQueryObject(c *C) {
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
 			bson.M{
-				"period.end.time": bson.M{
-					"$lt": time.Date(2012, time.November, 1, 8, 31, 0, 0, m.Local),
-				},
-			},
-			bson.M{
-				"period.start.time": bson.M{
+				"period.start.low": bson.M{
 					"$lt": time.Date(2012, time.November, 1, 8, 30, 0, 0, m.Local),
 				},
 			},
@@ -614,6 +993,11 @@ func (m *MongoSearchSuite) TestEncounterPeriodLEQueryObject(c *C) {
 				"period":       bson.M{"$ne": nil},
 				"period.start": nil,
 			},
+			bson.M{
+				"period.end.high": bson.M{
+					"$lte": time.Date(2012, time.November, 1, 8, 31, 0, 0, m.Local),
+				},
+			},
 		},
 	})
 }
@@ -626,7 +1010,155 @@ func (m *MongoSearchSuite) TestEncounterPeriodLEQuery(c *C) {
 	c.Assert(num, Equals, 4)
 }
 
-// TODO: Test date searches on date, instant, and Timing
+// Test date searches with the ne, sa, eb and ap prefixes
+
+func (m *MongoSearchSuite) TestConditionOnsetPrefixedQueryObjects(c *C) {
+	q := Query{"Condition", "onset=sa2012-03-01"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[0], DeepEquals, bson.M{
+		"onsetDateTime.low": bson.M{"$gte": time.Date(2012, time.March, 2, 0, 0, 0, 0, m.Local)},
+	})
+
+	q = Query{"Condition", "onset=eb2012-03-01"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[0], DeepEquals, bson.M{
+		"onsetDateTime.high": bson.M{"$lte": time.Date(2012, time.March, 1, 0, 0, 0, 0, m.Local)},
+	})
+
+	q = Query{"Condition", "onset=ne2012-03-01"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[:2], DeepEquals, []bson.M{
+		bson.M{"onsetDateTime.low": bson.M{"$lt": time.Date(2012, time.March, 1, 0, 0, 0, 0, m.Local)}},
+		bson.M{"onsetDateTime.high": bson.M{"$gt": time.Date(2012, time.March, 2, 0, 0, 0, 0, m.Local)}},
+	})
+
+	q = Query{"Condition", "onset=ap2012-03-01"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[0], DeepEquals, bson.M{
+		"onsetDateTime.low":  bson.M{"$lt": time.Date(2012, time.March, 9, 0, 0, 0, 0, m.Local)},
+		"onsetDateTime.high": bson.M{"$gt": time.Date(2012, time.February, 23, 0, 0, 0, 0, m.Local)},
+	})
+}
+
+func (m *MongoSearchSuite) TestApproxDateWindowIsConfigurable(c *C) {
+	defer func(window time.Duration) { ApproxDateWindow = window }(ApproxDateWindow)
+	ApproxDateWindow = time.Hour
+
+	q := Query{"Condition", "onset=ap2012-03-01"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[0], DeepEquals, bson.M{
+		"onsetDateTime.low":  bson.M{"$lt": time.Date(2012, time.March, 2, 1, 0, 0, 0, m.Local)},
+		"onsetDateTime.high": bson.M{"$gt": time.Date(2012, time.February, 29, 23, 0, 0, 0, m.Local)},
+	})
+}
+
+func (m *MongoSearchSuite) TestConditionOnsetPrefixedQueries(c *C) {
+	for query, expected := range map[string]int{
+		"onset=sa2012-03-01T07:05-05:00": 1,
+		"onset=eb2012-03-01T07:05-05:00": 2,
+		"onset=ne2012-03-01":             1,
+		"onset=ap2012-02-25":             5,
+		"onset=ap2012-02-20":             0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Condition", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestEncounterPeriodPrefixedQueryObjects(c *C) {
+	q := Query{"Encounter", "date=sa2012-11-01"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"period.start.low": bson.M{"$gte": time.Date(2012, time.November, 2, 0, 0, 0, 0, m.Local)},
+	})
+
+	q = Query{"Encounter", "date=eb2012-11-01"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"period.end.high": bson.M{"$lte": time.Date(2012, time.November, 1, 0, 0, 0, 0, m.Local)},
+	})
+
+	q = Query{"Encounter", "date=ap2012-11-01"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$and": []bson.M{
+			bson.M{
+				"$or": []bson.M{
+					bson.M{"period.start.low": bson.M{"$lt": time.Date(2012, time.November, 9, 0, 0, 0, 0, m.Local)}},
+					bson.M{"period": bson.M{"$ne": nil}, "period.start": nil},
+				},
+			},
+			bson.M{
+				"$or": []bson.M{
+					bson.M{"period.end.high": bson.M{"$gt": time.Date(2012, time.October, 25, 0, 0, 0, 0, m.Local)}},
+					bson.M{"period": bson.M{"$ne": nil}, "period.end": nil},
+				},
+			},
+		},
+	})
+
+	q = Query{"Encounter", "date=ne2012-11-01"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"period.start.low": bson.M{"$lt": time.Date(2012, time.November, 1, 0, 0, 0, 0, m.Local)}},
+			bson.M{"period": bson.M{"$ne": nil}, "period.start": nil},
+			bson.M{"period.end.high": bson.M{"$gt": time.Date(2012, time.November, 2, 0, 0, 0, 0, m.Local)}},
+			bson.M{"period": bson.M{"$ne": nil}, "period.end": nil},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestEncounterPeriodPrefixedQueries(c *C) {
+	for query, expected := range map[string]int{
+		"date=sa2012-11-01": 0,
+		"date=sa2012-10-01": 2,
+		"date=eb2012-10-02": 2,
+		"date=ap2012-10-03": 1,
+		"date=ne2012-11-01": 2,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Encounter", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+// Test date searches on date and Timing
+
+func (m *MongoSearchSuite) TestPatientBirthDateQueryObject(c *C) {
+	q := Query{"Patient", "birthdate=1991-02"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"birthDate.low":  bson.M{"$gte": time.Date(1991, time.February, 1, 0, 0, 0, 0, m.Local)},
+		"birthDate.high": bson.M{"$lte": time.Date(1991, time.March, 1, 0, 0, 0, 0, m.Local)},
+	})
+}
+
+func (m *MongoSearchSuite) TestPatientBirthDateQuery(c *C) {
+	for query, expected := range map[string]int{
+		"birthdate=1991-02-01T12:00:00Z": 0,
+		"birthdate=1991-02":              1,
+		"birthdate=1991":                 1,
+		"birthdate=gt1990":               1,
+		"birthdate=lt1991":               1,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Patient", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestCarePlanActivityTimingQueryObject(c *C) {
+	q := Query{"CarePlan", "activitydate=2012"}
+
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["$or"].([]bson.M)[1], DeepEquals, bson.M{
+		"activity.detail.scheduledTiming.event": bson.M{
+			"$elemMatch": bson.M{
+				"low":  bson.M{"$gte": time.Date(2012, time.January, 1, 0, 0, 0, 0, m.Local)},
+				"high": bson.M{"$lte": time.Date(2013, time.January, 1, 0, 0, 0, 0, m.Local)},
+			},
+		},
+	})
+}
 
 // Test number searches on positiveInt
 
@@ -668,6 +1200,70 @@ func (m *MongoSearchSuite) TestImmunizationDoseSequenceWrongNumberQuery(c *C) {
 	c.Assert(num, Equals, 0)
 }
 
+func (m *MongoSearchSuite) TestImmunizationDoseSequencePrefixedNumberQueryObjects(c *C) {
+	q := Query{"Immunization", "dose-sequence=gt1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$gte": float64(1.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=le1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$lt": float64(1.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=ge1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$gte": float64(0.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=lt1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$lt": float64(0.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=sa1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$gte": float64(1.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=eb1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol.doseSequence": bson.M{"$lt": float64(0.5)},
+	})
+
+	q = Query{"Immunization", "dose-sequence=ne1"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"vaccinationProtocol": bson.M{
+			"$elemMatch": bson.M{
+				"doseSequence": bson.M{
+					"$exists": true,
+					"$not":    bson.M{"$gte": float64(0.5), "$lt": float64(1.5)},
+				},
+			},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestImmunizationDoseSequencePrefixedNumberQueries(c *C) {
+	for query, expected := range map[string]int{
+		"dose-sequence=gt0":  1,
+		"dose-sequence=gt1":  0,
+		"dose-sequence=sa0":  1,
+		"dose-sequence=lt2":  1,
+		"dose-sequence=lt1":  0,
+		"dose-sequence=eb1":  0,
+		"dose-sequence=ge1":  1,
+		"dose-sequence=le1":  1,
+		"dose-sequence=le0":  0,
+		"dose-sequence=ap1":  1,
+		"dose-sequence=ap10": 0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Immunization", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 // TODO: Test number searches on decimal, integer, and unsignedInt
 
 // Test string searches on string
@@ -676,7 +1272,7 @@ func (m *MongoSearchSuite) TestDeviceStringQueryObject(c *C) {
 	q := Query{"Device", "manufacturer=Acme"}
 
 	o := m.MongoSearcher.createQueryObject(q)
-	c.Assert(o, DeepEquals, bson.M{"manufacturer": bson.RegEx{Pattern: "^Acme", Options: "i"}})
+	c.Assert(o, DeepEquals, bson.M{"manufacturer": cisw("Acme")})
 }
 
 func (m *MongoSearchSuite) TestDeviceStringQuery(c *C) {
@@ -704,6 +1300,32 @@ func (m *MongoSearchSuite) TestNonMatchingDeviceStringQuery(c *C) {
 	c.Assert(num, Equals, 0)
 }
 
+func (m *MongoSearchSuite) TestDeviceStringQueryObjectWithModifiers(c *C) {
+	q := Query{"Device", "manufacturer:exact=Acme"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{"manufacturer": "Acme"})
+
+	q = Query{"Device", "manufacturer:contains=Acme"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{"manufacturer": cic("Acme")})
+}
+
+func (m *MongoSearchSuite) TestDeviceStringQueryWithModifiers(c *C) {
+	for query, expected := range map[string]int{
+		"manufacturer:exact=Acme Devices, Inc": 1,
+		"manufacturer:exact=acme devices, inc": 0,
+		"manufacturer:exact=Acme":              0,
+		"manufacturer:contains=devices":        1,
+		"manufacturer:contains=dévices":        1,
+		"manufacturer:contains=widgets":        0,
+		"manufacturer=Ácme":                    1,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Device", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 // Test string searches on HumanName
 
 func (m *MongoSearchSuite) TestPatientNameStringQueryObject(c *C) {
@@ -712,9 +1334,9 @@ func (m *MongoSearchSuite) TestPatientNameStringQueryObject(c *C) {
 	o := m.MongoSearcher.createQueryObject(q)
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
-			bson.M{"name.text": bson.RegEx{Pattern: "^Peters", Options: "i"}},
-			bson.M{"name.family": bson.RegEx{Pattern: "^Peters", Options: "i"}},
-			bson.M{"name.given": bson.RegEx{Pattern: "^Peters", Options: "i"}},
+			bson.M{"name.text": cisw("Peters")},
+			bson.M{"name.family": cisw("Peters")},
+			bson.M{"name.given": cisw("Peters")},
 		},
 	})
 }
@@ -741,6 +1363,45 @@ func (m *MongoSearchSuite) TestNonMatchingPatientNameStringQuery(c *C) {
 	c.Assert(num, Equals, 0)
 }
 
+func (m *MongoSearchSuite) TestPatientNameStringQueryObjectWithModifiers(c *C) {
+	q := Query{"Patient", "name:exact=Peters"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"name.text": "Peters"},
+			bson.M{"name.family": "Peters"},
+			bson.M{"name.given": "Peters"},
+		},
+	})
+
+	q = Query{"Patient", "name:contains=eter"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"name.text": cic("eter")},
+			bson.M{"name.family": cic("eter")},
+			bson.M{"name.given": cic("eter")},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestPatientNameStringQueryWithModifiers(c *C) {
+	for query, expected := range map[string]int{
+		"name:exact=Peters":   2,
+		"name:exact=peters":   0,
+		"name:exact=Pet":      0,
+		"name:contains=eter":  2,
+		"name:contains=ALL":   1,
+		"name:contains=ohnny": 0,
+		"name=Pétèrs":         2,
+		"name=PÉTERS":         2,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Patient", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 // Test string searches on Address
 
 func (m *MongoSearchSuite) TestPatientAddressStringQueryObject(c *C) {
@@ -749,12 +1410,12 @@ func (m *MongoSearchSuite) TestPatientAddressStringQueryObject(c *C) {
 	o := m.MongoSearcher.createQueryObject(q)
 	c.Assert(o, DeepEquals, bson.M{
 		"$or": []bson.M{
-			bson.M{"address.text": bson.RegEx{Pattern: "^AK", Options: "i"}},
-			bson.M{"address.line": bson.RegEx{Pattern: "^AK", Options: "i"}},
-			bson.M{"address.city": bson.RegEx{Pattern: "^AK", Options: "i"}},
-			bson.M{"address.state": bson.RegEx{Pattern: "^AK", Options: "i"}},
-			bson.M{"address.postalCode": bson.RegEx{Pattern: "^AK", Options: "i"}},
-			bson.M{"address.country": bson.RegEx{Pattern: "^AK", Options: "i"}},
+			bson.M{"address.text": cisw("AK")},
+			bson.M{"address.line": cisw("AK")},
+			bson.M{"address.city": cisw("AK")},
+			bson.M{"address.state": cisw("AK")},
+			bson.M{"address.postalCode": cisw("AK")},
+			bson.M{"address.country": cisw("AK")},
 		},
 	})
 }
@@ -767,6 +1428,37 @@ func (m *MongoSearchSuite) TestPatientAddressStringQuery(c *C) {
 	c.Assert(num, Equals, 2)
 }
 
+func (m *MongoSearchSuite) TestPatientAddressStringQueryObjectWithModifiers(c *C) {
+	q := Query{"Patient", "address:exact=Middletown"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"address.text": "Middletown"},
+			bson.M{"address.line": "Middletown"},
+			bson.M{"address.city": "Middletown"},
+			bson.M{"address.state": "Middletown"},
+			bson.M{"address.postalCode": "Middletown"},
+			bson.M{"address.country": "Middletown"},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestPatientAddressStringQueryWithModifiers(c *C) {
+	for query, expected := range map[string]int{
+		"address:exact=Middletown":    2,
+		"address:exact=middletown":    0,
+		"address:contains=town":       2,
+		"address:contains=Broad":      2,
+		"address:contains=Narrow":     0,
+		"address:exact=534 Broad St":  2,
+		"address:exact=534 Broad St.": 0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Patient", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 func (m *MongoSearchSuite) TestNonMatchingPatientAddressStringQuery(c *C) {
 	q := Query{"Patient", "address=CA"}
 	mq := m.MongoSearcher.CreateQuery(q)
@@ -792,6 +1484,25 @@ func (m *MongoSearchSuite) TestValueQuantityQueryObjectByValueAndUnit(c *C) {
 	})
 }
 
+func (m *MongoSearchSuite) TestValueQuantityQueryObjectByValueOnly(c *C) {
+	q := Query{"Observation", "value-quantity=185"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"valueQuantity.value": bson.M{
+			"$gte": float64(184.5),
+			"$lt":  float64(185.5),
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestValueQuantityQueryByValueOnly(c *C) {
+	q := Query{"Observation", "value-quantity=185"}
+	mq := m.MongoSearcher.CreateQuery(q)
+	num, err := mq.Count()
+	util.CheckErr(err)
+	c.Assert(num, Equals, 1)
+}
+
 func (m *MongoSearchSuite) TestValueQuantityQueryByValueAndUnit(c *C) {
 	q := Query{"Observation", "value-quantity=185||lbs"}
 	mq := m.MongoSearcher.CreateQuery(q)
@@ -869,6 +1580,57 @@ func (m *MongoSearchSuite) TestValueQuantityQueryByValueAndSystemAndWrongCode(c
 	c.Assert(num, Equals, 0)
 }
 
+func (m *MongoSearchSuite) TestValueQuantityPrefixedQueryObject(c *C) {
+	q := Query{"Observation", "value-quantity=gt140|http://unitsofmeasure.org|[lb_av]"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"valueQuantity.value":  bson.M{"$gte": float64(140.5)},
+		"valueQuantity.code":   bson.RegEx{Pattern: "^\\[lb_av\\]$", Options: "i"},
+		"valueQuantity.system": bson.RegEx{Pattern: "^http://unitsofmeasure\\.org$", Options: "i"},
+	})
+
+	q = Query{"Observation", "value-quantity=ap100||lbs"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["valueQuantity.value"], DeepEquals, bson.M{"$gte": float64(90), "$lte": float64(110)})
+
+	// The precision of the value applies to every prefix, so sa100 doesn't match
+	// 100.4 (which is 100 at that precision) but le100 does
+	q = Query{"Observation", "value-quantity=sa100||mm[Hg]"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["valueQuantity.value"], DeepEquals, bson.M{"$gte": float64(100.5)})
+
+	q = Query{"Observation", "value-quantity=le100||mm[Hg]"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["valueQuantity.value"], DeepEquals, bson.M{"$lt": float64(100.5)})
+
+	q = Query{"Observation", "value-quantity=eb100.0||mm[Hg]"}
+	o = m.MongoSearcher.createQueryObject(q)
+	c.Assert(o["valueQuantity.value"], DeepEquals, bson.M{"$lt": float64(99.95)})
+}
+
+func (m *MongoSearchSuite) TestValueQuantityPrefixedQueries(c *C) {
+	for query, expected := range map[string]int{
+		"value-quantity=gt140||lbs": 1,
+		"value-quantity=gt185||lbs": 0,
+		"value-quantity=lt200||lbs": 1,
+		"value-quantity=lt185||lbs": 0,
+		"value-quantity=ge185||lbs": 1,
+		"value-quantity=le185||lbs": 1,
+		"value-quantity=ne185||lbs": 0,
+		"value-quantity=ne180||lbs": 1,
+		"value-quantity=ap170||lbs": 1,
+		"value-quantity=ap150||lbs": 0,
+		"value-quantity=sa184||lbs": 1,
+		"value-quantity=sa185||lbs": 0,
+		"value-quantity=eb186||lbs": 1,
+		"value-quantity=eb185||lbs": 0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Observation", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
 // TODO: Test quantity searches on Money, SimpleQuantity, Duration, Count, Distance, and Age
 
 // Test URI searches on URI
@@ -889,7 +1651,163 @@ func (m *MongoSearchSuite) TestSubscriptionURLQuery(c *C) {
 	c.Assert(num, Equals, 1)
 }
 
-// TODO: Test composite searches
+// Test composite searches
+
+func (m *MongoSearchSuite) TestObservationCodeValueQuantityQueryObject(c *C) {
+	q := Query{"Observation", "code-value-quantity=http://loinc.org|3141-9$gt180"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"code.coding": bson.M{
+			"$elemMatch": bson.M{
+				"system": bson.RegEx{Pattern: "^http://loinc\\.org$", Options: "i"},
+				"code":   bson.RegEx{Pattern: "^3141-9$", Options: "i"},
+			},
+		},
+		"valueQuantity.value": bson.M{"$gte": float64(180.5)},
+	})
+}
+
+func (m *MongoSearchSuite) TestObservationCodeValueQueries(c *C) {
+	for query, expected := range map[string]int{
+		"code-value-quantity=http://loinc.org|3141-9$gt180":                                          1,
+		"code-value-quantity=http://loinc.org|3141-9$lt180":                                          0,
+		"code-value-quantity=http://loinc.org|17856-6$185":                                           0,
+		"code-value-concept=http://snomed.info/sct|116783008$http://snomed.info/sct|433581000124101": 1,
+		"code-value-concept=http://snomed.info/sct|116783008$http://snomed.info/sct|433581000000000": 0,
+		"code-value-quantity=http://loinc.org|3141-9$gt180,http://loinc.org|17856-6$8":               2,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Observation", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestObservationComponentCodeValueQuantityQueryObject(c *C) {
+	q := Query{"Observation", "component-code-value-quantity=http://loinc.org|8480-6$gt140"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"component": bson.M{
+			"$elemMatch": bson.M{
+				"code.coding": bson.M{
+					"$elemMatch": bson.M{
+						"system": bson.RegEx{Pattern: "^http://loinc\\.org$", Options: "i"},
+						"code":   bson.RegEx{Pattern: "^8480-6$", Options: "i"},
+					},
+				},
+				"valueQuantity.value": bson.M{"$gte": float64(140.5)},
+			},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestGroupCharacteristicValueQueryObject(c *C) {
+	q := Query{"Group", "characteristic-value=gender$male"}
+	o := m.MongoSearcher.createQueryObject(q)
+	c.Assert(o, DeepEquals, bson.M{
+		"characteristic": bson.M{
+			"$elemMatch": bson.M{
+				"code.coding.code": bson.RegEx{Pattern: "^gender$", Options: "i"},
+				"$or": []bson.M{
+					bson.M{"valueBoolean": bson.RegEx{Pattern: "^male$", Options: "i"}},
+					bson.M{"valueCodeableConcept.coding.code": bson.RegEx{Pattern: "^male$", Options: "i"}},
+				},
+			},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestCompositeWithWrongNumberOfValuesPanics(c *C) {
+	q := Query{"Group", "characteristic-value=gender"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"characteristic-value\" content is invalid"))
+}
+
+// Test searches with the missing modifier
+
+func (m *MongoSearchSuite) TestMissingQueryObjects(c *C) {
+	q := Query{"Patient", "birthdate:missing=true"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$nor": []bson.M{
+			bson.M{"birthDate": bson.M{"$ne": nil}},
+		},
+	})
+
+	q = Query{"Observation", "encounter:missing=false"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"encounter": bson.M{"$ne": nil},
+	})
+
+	q = Query{"Condition", "onset:missing=true"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$nor": []bson.M{
+			bson.M{"onsetDateTime": bson.M{"$ne": nil}},
+			bson.M{"onsetPeriod": bson.M{"$ne": nil}},
+		},
+	})
+
+	q = Query{"Condition", "onset:missing=false"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$or": []bson.M{
+			bson.M{"onsetDateTime": bson.M{"$ne": nil}},
+			bson.M{"onsetPeriod": bson.M{"$ne": nil}},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestMissingQueryObjectsWithArrays(c *C) {
+	// Arrays are missing when they are empty
+	q := Query{"Encounter", "identifier:missing=true"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$nor": []bson.M{
+			bson.M{"identifier.0": bson.M{"$exists": true}},
+		},
+	})
+
+	q = Query{"Patient", "family:missing=false"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"name.family.0": bson.M{"$exists": true},
+	})
+
+	// Elements within arrays are missing when no element of the array has them
+	q = Query{"Observation", "component-value-quantity:missing=true"}
+	c.Assert(m.MongoSearcher.createQueryObject(q), DeepEquals, bson.M{
+		"$nor": []bson.M{
+			bson.M{"component.valueQuantity": bson.M{"$ne": nil}},
+		},
+	})
+}
+
+func (m *MongoSearchSuite) TestMissingQueries(c *C) {
+	for query, expected := range map[string]int{
+		"Patient?birthdate:missing=true":         0,
+		"Patient?birthdate:missing=false":        2,
+		"Observation?encounter:missing=true":     4,
+		"Observation?encounter:missing=false":    1,
+		"Encounter?identifier:missing=true":      2,
+		"Encounter?identifier:missing=false":     2,
+		"Condition?onset:missing=false":          6,
+		"Patient?name:missing=false&gender=male": 1,
+	} {
+		split := strings.SplitN(query, "?", 2)
+		num, err := m.MongoSearcher.CreateQuery(Query{split[0], split[1]}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+func (m *MongoSearchSuite) TestMissingQueryWithEmptyArray(c *C) {
+	coll := m.Session.DB("fhir-test").C("encounters")
+	util.CheckErr(coll.Insert(bson.M{"_id": "empty-identifiers", "identifier": []interface{}{}}))
+	defer coll.RemoveId("empty-identifiers")
+
+	num, err := m.MongoSearcher.CreateQuery(Query{"Encounter", "identifier:missing=true"}).Count()
+	util.CheckErr(err)
+	c.Assert(num, Equals, 3)
+}
+
+func (m *MongoSearchSuite) TestMissingOnCompositePanics(c *C) {
+	q := Query{"Group", "characteristic-value:missing=true"}
+	c.Assert(func() { m.MongoSearcher.createQueryObject(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", "Parameter \"characteristic-value\" modifier is invalid"))
+}
 
 // Tests special searches on _id
 
@@ -917,7 +1835,169 @@ func (m *MongoSearchSuite) TestConditionIdQuery(c *C) {
 	c.Assert(cond, DeepEquals, cond2)
 }
 
-// TODO: Test special searches: _content, _lastUpdated, _profile, _query, _security, _tag, _text
+// TODO: Test special searches: _query
+
+// Test searches on global parameters
+
+func (m *MongoSearchSuite) TestGlobalParamQueryObjects(c *C) {
+	o := m.MongoSearcher.createQueryObject(Query{"Patient", "_tag=http://acme.com/tags|vip"})
+	c.Assert(o, DeepEquals, bson.M{
+		"meta.tag": bson.M{
+			"$elemMatch": bson.M{
+				"system": bson.RegEx{Pattern: "^http://acme\\.com/tags$", Options: "i"},
+				"code":   bson.RegEx{Pattern: "^vip$", Options: "i"},
+			},
+		},
+	})
+
+	o = m.MongoSearcher.createQueryObject(Query{"Encounter", "_security=R"})
+	c.Assert(o, DeepEquals, bson.M{"meta.security.code": bson.RegEx{Pattern: "^R$", Options: "i"}})
+
+	o = m.MongoSearcher.createQueryObject(Query{"Condition", "_profile=http://acme.com/profiles/condition"})
+	c.Assert(o, DeepEquals, bson.M{"meta.profile": "http://acme.com/profiles/condition"})
+
+	o = m.MongoSearcher.createQueryObject(Query{"Observation", "_lastUpdated=gt2016-03-15"})
+	c.Assert(o, DeepEquals, bson.M{"meta.lastUpdated.high": bson.M{"$gt": time.Date(2016, time.March, 16, 0, 0, 0, 0, m.Local)}})
+}
+
+func (m *MongoSearchSuite) TestGlobalParamQueries(c *C) {
+	patients := m.Session.DB("fhir-test").C("patients")
+	util.CheckErr(patients.Insert(&models.Patient{
+		Id: "tagged-vip",
+		Meta: &models.Meta{
+			LastUpdated: &models.FHIRDateTime{Time: time.Date(2016, time.March, 1, 10, 0, 0, 0, time.UTC), Precision: models.Timestamp},
+			Profile:     []string{"http://acme.com/profiles/patient"},
+			Security:    []models.Coding{{System: "http://hl7.org/fhir/v3/Confidentiality", Code: "R"}},
+			Tag:         []models.Coding{{System: "http://acme.com/tags", Code: "vip"}},
+		},
+	}))
+	defer patients.RemoveId("tagged-vip")
+	util.CheckErr(patients.Insert(&models.Patient{
+		Id: "tagged-research",
+		Meta: &models.Meta{
+			LastUpdated: &models.FHIRDateTime{Time: time.Date(2016, time.April, 15, 8, 30, 0, 0, time.UTC), Precision: models.Timestamp},
+			Tag:         []models.Coding{{System: "http://acme.com/tags", Code: "research"}, {System: "http://other.com/tags", Code: "vip"}},
+		},
+	}))
+	defer patients.RemoveId("tagged-research")
+
+	for query, expected := range map[string]int{
+		"_lastUpdated=2016":                                       2,
+		"_lastUpdated=2016-03-01":                                 1,
+		"_lastUpdated=2015":                                       0,
+		"_lastUpdated=gt2016-03-15":                               1,
+		"_lastUpdated=lt2016-03-15":                               1,
+		"_lastUpdated=ge2016-03-01T10:00:00Z":                     2,
+		"_lastUpdated=gt2016-03-01T10:00:00Z":                     1,
+		"_tag=http://acme.com/tags|vip":                           1,
+		"_tag=vip":                                                2,
+		"_tag=http://acme.com/tags|":                              2,
+		"_tag=http://acme.com/tags|research,vip":                  2,
+		"_tag:not=vip":                                            2,
+		"_tag:missing=true":                                       2,
+		"_security=R":                                             1,
+		"_security=http://hl7.org/fhir/v3/Confidentiality|N":      0,
+		"_profile=http://acme.com/profiles/patient":               1,
+		"_profile=http://acme.com/profiles/other":                 0,
+		"_lastUpdated=gt2016-03-15&_tag=vip":                      1,
+		"_lastUpdated=gt2016-03-15&_tag=http://acme.com/tags|vip": 0,
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{"Patient", query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, expected, Commentf("query: %s", query))
+	}
+}
+
+// Test text searches
+
+func (m *MongoSearchSuite) TestTextSearchQueryObjects(c *C) {
+	o := m.MongoSearcher.createQueryObject(Query{"Condition", "_content=metformin"})
+	c.Assert(o, DeepEquals, bson.M{"$text": bson.M{"$search": "metformin"}})
+
+	o = m.MongoSearcher.createQueryObject(Query{"MedicationOrder", "_text=metformin"})
+	c.Assert(o, DeepEquals, bson.M{
+		"$text":    bson.M{"$search": "metformin"},
+		"text.div": cic("metformin"),
+	})
+
+	// The narrative must contain one of the words or phrases that aren't excluded
+	o = m.MongoSearcher.createQueryObject(Query{"Condition", "_text=metformin+-insulin+%22type+2%22"})
+	c.Assert(o, DeepEquals, bson.M{
+		"$text":    bson.M{"$search": "metformin -insulin \"type 2\""},
+		"text.div": bson.RegEx{Pattern: accentInsensitive("metformin") + "|" + accentInsensitive("type 2"), Options: "i"},
+	})
+
+	o = m.MongoSearcher.createQueryObject(Query{"Observation", "_content=metformin&subject=Patient/4954037118555241963"})
+	c.Assert(o, DeepEquals, bson.M{
+		"$text":               bson.M{"$search": "metformin"},
+		"subject.referenceid": ci("4954037118555241963"),
+		"subject.type":        "Patient",
+	})
+}
+
+func (m *MongoSearchSuite) TestTextSearchPanics(c *C) {
+	q := Query{"Condition", "_text=metformin&_content=insulin"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_NO_REPEAT", "Parameters \"_text\" and \"_content\" can only be searched on once"))
+
+	q = Query{"Condition", "_content:exact=metformin"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", "Parameter \"_content\" modifier is invalid"))
+}
+
+func (m *MongoSearchSuite) TestTextSearchQueries(c *C) {
+	db := m.Session.DB("fhir-test")
+	orders := db.C("medicationorders")
+	util.CheckErr(orders.Insert(&models.MedicationOrder{
+		Id:                        "metformin-narrative",
+		Text:                      &models.Narrative{Status: "generated", Div: "<div>Metformin 500 mg twice daily</div>"},
+		MedicationCodeableConcept: &models.CodeableConcept{Text: "Metformin"},
+	}))
+	defer orders.RemoveId("metformin-narrative")
+	util.CheckErr(orders.Insert(&models.MedicationOrder{
+		Id:       "metformin-code",
+		Language: "en-US",
+		MedicationCodeableConcept: &models.CodeableConcept{
+			Coding: []models.Coding{{System: "http://www.nlm.nih.gov/research/umls/rxnorm", Code: "861007", Display: "Metformin hydrochloride 500 MG Oral Tablet"}},
+		},
+	}))
+	defer orders.RemoveId("metformin-code")
+	observations := db.C("observations")
+	util.CheckErr(observations.Insert(&models.Observation{Id: "metformin-comment", Comments: "Patient stopped taking metformin"}))
+	defer observations.RemoveId("metformin-comment")
+	conditions := db.C("conditions")
+	util.CheckErr(conditions.Insert(&models.Condition{Id: "metformin-notes", Notes: "Started on metformin"}))
+	defer conditions.RemoveId("metformin-notes")
+
+	for _, test := range []struct {
+		resource string
+		query    string
+		expected int
+	}{
+		{"MedicationOrder", "_content=metformin", 2},
+		{"MedicationOrder", "_content=METFORMIN", 2},
+		{"MedicationOrder", "_content=metformin+-daily", 1},
+		{"MedicationOrder", "_content=%22oral+tablet%22", 1},
+		{"MedicationOrder", "_text=metformin", 1},
+		{"MedicationOrder", "_text=tablet", 0},
+		{"Observation", "_content=metformin", 1},
+		{"Observation", "_text=metformin", 0},
+		{"Condition", "_content=metformin", 1},
+		{"Condition", "_content=insulin", 0},
+	} {
+		num, err := m.MongoSearcher.CreateQuery(Query{test.resource, test.query}).Count()
+		util.CheckErr(err)
+		c.Assert(num, Equals, test.expected, Commentf("query: %s?%s", test.resource, test.query))
+	}
+
+	// The results are scored by relevance, and can be sorted by it
+	var results []models.MedicationOrder
+	scores, err := AllWithTextScores(m.MongoSearcher.CreateQuery(Query{"MedicationOrder", "_content=metformin&_sort=_score"}), &results)
+	util.CheckErr(err)
+	c.Assert(results, HasLen, 2)
+	c.Assert(scores, HasLen, 2)
+	c.Assert(scores[results[0].Id] >= scores[results[1].Id], Equals, true)
+	c.Assert(scores[results[1].Id] > 0, Equals, true)
+	c.Assert(results[0].MedicationCodeableConcept, NotNil)
+}
 
 // Test searches with multiple values
 func (m *MongoSearchSuite) TestConditionMultipleCodesQueryObject(c *C) {
@@ -988,18 +2068,16 @@ func (m *MongoSearchSuite) TestConditionPatientAndCodeAndOnsetQueryObject(c *C)
 	})
 
 	// Check the onset part of the query
-	// 2012-03-01T07:00-05:00 <= onsetDateTime < 2012-03-01T07:01-05:00
-	onsetDt := o["$or"].([]bson.M)[0]["onsetDateTime.time"].(bson.M)
-	c.Assert(onsetDt, HasLen, 2)
-	gte := onsetDt["$gte"].(time.Time)
-	lt := onsetDt["$lt"].(time.Time)
-	c.Assert(gte.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
-	c.Assert(lt.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
-
-	// 2012-03-01T07:00:00-05:00 <= onsetPeriod.start <= onsetPeriod.end < 2012-03-01T07:01:00-05:00
-	start := o["$or"].([]bson.M)[1]["onsetPeriod.start.time"].(bson.M)["$gte"].(time.Time)
+	// 2012-03-01T07:00-05:00 <= onsetDateTime.low <= onsetDateTime.high <= 2012-03-01T07:01-05:00
+	low := o["$or"].([]bson.M)[0]["onsetDateTime.low"].(bson.M)["$gte"].(time.Time)
+	c.Assert(low.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
+	high := o["$or"].([]bson.M)[0]["onsetDateTime.high"].(bson.M)["$lte"].(time.Time)
+	c.Assert(high.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
+
+	// 2012-03-01T07:00:00-05:00 <= onsetPeriod.start.low <= onsetPeriod.end.high <= 2012-03-01T07:01:00-05:00
+	start := o["$or"].([]bson.M)[1]["onsetPeriod.start.low"].(bson.M)["$gte"].(time.Time)
 	c.Assert(start.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 0, 0, 0, m.EST).UnixNano())
-	end := o["$or"].([]bson.M)[1]["onsetPeriod.end.time"].(bson.M)["$lt"].(time.Time)
+	end := o["$or"].([]bson.M)[1]["onsetPeriod.end.high"].(bson.M)["$lte"].(time.Time)
 	c.Assert(end.UnixNano(), Equals, time.Date(2012, time.March, 1, 7, 1, 0, 0, m.EST).UnixNano())
 }
 
@@ -1244,36 +2322,152 @@ func (m *MongoSearchSuite) TestEncounterTypeQueryWithCountAndOffset(c *C) {
 	c.Assert(offset1.Id, Not(Equals), offset2.Id)
 }
 
-// Test that invalid search parameters PANIC (to ensure people know they are broken)
-func (m *MongoSearchSuite) TestInvalidSearchParameterPanics(c *C) {
-	q := Query{"Condition", "abatement=2012"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("SEARCH_NONE", "Error: no processable search found for Condition search parameters \"abatement\""))
+// Test queries with _sort
+
+func (m *MongoSearchSuite) TestConditionQueryWithDescendingSort(c *C) {
+	var conditions []*models.Condition
+	q := Query{"Condition", "_sort=-onset&_count=4"}
+	mq := m.MongoSearcher.CreateQuery(q)
+	err := mq.All(&conditions)
+	util.CheckErr(err)
+	c.Assert(conditions, HasLen, 4)
+
+	// Conditions with the same onset are sorted by id
+	c.Assert(conditions[0].Id, Equals, "4072118967138896162")
+	c.Assert(conditions[1].Id, Equals, "4248502720904412195")
+	c.Assert(conditions[2].Id, Equals, "5852315345721171557")
+	c.Assert(conditions[3].Id, Equals, "8382342521862968868")
 }
 
-// Test that unimplemented features PANIC (to ensure people know they are broken)
-func (m *MongoSearchSuite) TestCompositeSearchPanics(c *C) {
-	q := Query{"Group", "characteristic-value=gender$male"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_UNKNOWN", "Parameter \"characteristic-value\" not understood"))
+func (m *MongoSearchSuite) TestPatientQueryWithMultipleSorts(c *C) {
+	var patients []*models.Patient
+	q := Query{"Patient", "_sort=name,-birthdate"}
+	mq := m.MongoSearcher.CreateQuery(q)
+	err := mq.All(&patients)
+	util.CheckErr(err)
+	c.Assert(patients, HasLen, 2)
+	c.Assert(patients[0].Id, Equals, "4954037118555241963")
+	c.Assert(patients[1].Id, Equals, "4954037118555579315")
+}
+
+func (m *MongoSearchSuite) TestSortFields(c *C) {
+	patients := SearchParameterDictionary["Patient"]
+	fields := sortFields([]SortOption{
+		SortOption{Parameter: patients["name"]},
+		SortOption{Parameter: patients["family"], Descending: true},
+		SortOption{Parameter: patients["birthdate"]},
+		SortOption{Parameter: patients["language"]},
+	})
+	c.Assert(fields, DeepEquals, []string{"name.0.family.0", "-name.0.family.0", "birthDate.time", "communication.0.language.coding.0.code", "_id"})
+
+	period := SearchParamInfo{Name: "date", Paths: []SearchParamPath{SearchParamPath{Path: "period", Type: "Period"}}}
+	fields = sortFields([]SortOption{SortOption{Parameter: period}, SortOption{Parameter: period, Descending: true}})
+	c.Assert(fields, DeepEquals, []string{"period.start.time", "-period.end.time", "_id"})
+
+	fields = sortFields([]SortOption{SortOption{Parameter: SearchParamInfo{Name: "_score"}}, SortOption{Parameter: period}})
+	c.Assert(fields, DeepEquals, []string{"$textScore:_score", "period.start.time", "_id"})
 }
 
-func (m *MongoSearchSuite) TestPrefixedDateSearchPanicsForUnsupportedPrefix(c *C) {
-	q := Query{"Condition", "onset=ap2012"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_INVALID", "Parameter \"onset\" content is invalid"))
+// Test _summary and _elements
+
+func (m *MongoSearchSuite) TestPatientQueryWithElements(c *C) {
+	var patients []*models.Patient
+	q := Query{"Patient", "_id=4954037118555241963&_elements=gender"}
+	err := m.MongoSearcher.CreateQuery(q).All(&patients)
+	util.CheckErr(err)
+	c.Assert(patients, HasLen, 1)
+	c.Assert(patients[0].Id, Equals, "4954037118555241963")
+	c.Assert(patients[0].Gender, Not(Equals), "")
+	c.Assert(patients[0].Name, HasLen, 0)
+}
+
+func (m *MongoSearchSuite) TestProjection(c *C) {
+	c.Assert(Projection("Patient", &QueryOptions{}), IsNil)
+	c.Assert(Projection("Patient", &QueryOptions{Summary: "false"}), IsNil)
+	c.Assert(Projection("Patient", &QueryOptions{Summary: "data"}), DeepEquals, bson.M{"text": 0})
+	c.Assert(Projection("Patient", &QueryOptions{Summary: "text"}), DeepEquals, bson.M{"_id": 1, "meta": 1, "implicitRules": 1, "text": 1})
+	c.Assert(Projection("Patient", &QueryOptions{Elements: []string{"name", "gender"}}), DeepEquals, bson.M{"_id": 1, "meta": 1, "name": 1, "gender": 1})
+	c.Assert(Projection("Patient", &QueryOptions{Elements: []string{"id", "deceasedBoolean"}}), DeepEquals, bson.M{"_id": 1, "meta": 1, "deceasedBoolean": 1})
+
+	c.Assert(Projection("Patient", &QueryOptions{Summary: "true"}), DeepEquals, bson.M{
+		"_id": 1, "meta": 1, "implicitRules": 1, "identifier": 1, "active": 1, "name": 1, "telecom": 1,
+		"gender": 1, "birthDate": 1, "deceasedBoolean": 1, "deceasedDateTime": 1, "address": 1,
+		"animal": 1, "managingOrganization": 1, "link": 1,
+	})
+
+	// Large elements that aren't summary elements are left out
+	obs := Projection("Observation", &QueryOptions{Summary: "true"})
+	c.Assert(obs["valueQuantity"], Equals, 1)
+	c.Assert(obs["component"], Equals, 1)
+	for _, element := range []string{"referenceRange", "related", "comments"} {
+		_, ok := obs[element]
+		c.Assert(ok, Equals, false, Commentf("Observation.%s", element))
+	}
+	_, ok := Projection("StructureDefinition", &QueryOptions{Summary: "true"})["snapshot"]
+	c.Assert(ok, Equals, false)
 }
 
-func (m *MongoSearchSuite) TestPrefixedNumberSearchPanics(c *C) {
-	q := Query{"Immunization", "dose-sequence=gt1"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_INVALID", "Parameter \"dose-sequence\" content is invalid"))
+func (m *MongoSearchSuite) TestSummaryElementsExist(c *C) {
+	for resourceType, elements := range summaryElements {
+		fields := elementFields(resourceType)
+		c.Assert(fields, NotNil, Commentf(resourceType))
+		for _, element := range elements {
+			_, ok := fields[element]
+			c.Assert(ok, Equals, true, Commentf("%s.%s", resourceType, element))
+		}
+	}
+}
+
+// Test _include and _revinclude
+
+func (m *MongoSearchSuite) TestObservationIncludesWithIterate(c *C) {
+	q := Query{"Observation", "_id=5637152931209212154&_include=Observation:encounter&_include:iterate=Encounter:patient"}
+	included, err := m.MongoSearcher.FindIncludes("Observation", []string{"5637152931209212154"}, q.Options())
+	util.CheckErr(err)
+	c.Assert(included, HasLen, 2)
+	c.Assert(included[0].(*models.Encounter).Id, Equals, "6648204100111387580")
+	c.Assert(included[1].(*models.Patient).Id, Equals, "4954037118555241963")
+}
+
+func (m *MongoSearchSuite) TestPatientRevIncludes(c *C) {
+	q := Query{"Patient", "_revinclude=Condition:patient"}
+	included, err := m.MongoSearcher.FindIncludes("Patient", []string{"4954037118555579315"}, q.Options())
+	util.CheckErr(err)
+	c.Assert(included, HasLen, 1)
+	c.Assert(included[0].(*models.Condition).Id, Equals, "8664777288161038467")
+
+	// A resource that is already in the results is not included again
+	q = Query{"Condition", "_include=Condition:patient&_revinclude:iterate=Condition:patient"}
+	included, err = m.MongoSearcher.FindIncludes("Condition", []string{"8664777288161038467"}, q.Options())
+	util.CheckErr(err)
+	c.Assert(included, HasLen, 1)
+	c.Assert(included[0].(*models.Patient).Id, Equals, "4954037118555579315")
 }
 
-func (m *MongoSearchSuite) TestPrefixedQuantitySearchPanics(c *C) {
-	q := Query{"Observation", "value-quantity=ap1||mg"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_INVALID", "Parameter \"value-quantity\" content is invalid"))
+func (m *MongoSearchSuite) TestValuesAtPath(c *C) {
+	doc := bson.M{"participant": []interface{}{
+		bson.M{"individual": bson.M{"referenceid": "a"}},
+		bson.M{"type": "foo"},
+		bson.M{"individual": bson.M{"referenceid": "b"}},
+	}}
+	values := valuesAtPath(doc, "[]participant.individual")
+	c.Assert(values, DeepEquals, []bson.M{bson.M{"referenceid": "a"}, bson.M{"referenceid": "b"}})
+	c.Assert(valuesAtPath(doc, "subject"), HasLen, 0)
 }
 
+// Test that invalid search parameters PANIC (to ensure people know they are broken)
+func (m *MongoSearchSuite) TestInvalidSearchParameterPanics(c *C) {
+	q := Query{"Condition", "abatement=2012"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createInvalidSearchError("SEARCH_NONE", "Error: no processable search found for Condition search parameters \"abatement\""))
+}
+
+// Test that unimplemented features PANIC (to ensure people know they are broken)
 func (m *MongoSearchSuite) TestModifierSearchPanics(c *C) {
-	q := Query{"Condition", "code:text=headache"}
+	q := Query{"Condition", "code:exact=headache"}
 	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", "Parameter \"code\" modifier is invalid"))
+
+	q = Query{"Patient", "gender:text=male"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_MODIFIER_INVALID", "Parameter \"gender\" modifier is invalid"))
 }
 
 func (m *MongoSearchSuite) TestUnsupportedSearchResultParameterPanics(c *C) {
@@ -1282,12 +2476,49 @@ func (m *MongoSearchSuite) TestUnsupportedSearchResultParameterPanics(c *C) {
 }
 
 func (m *MongoSearchSuite) TestUsupportedGlobalSearchParameterPanics(c *C) {
-	q := Query{"Condition", "_text=diabetes"}
-	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_UNKNOWN", "Parameter \"_text\" not understood"))
+	q := Query{"Condition", "_query=current-high-risk"}
+	c.Assert(func() { m.MongoSearcher.CreateQuery(q) }, Panics, createUnsupportedSearchError("MSG_PARAM_UNKNOWN", "Parameter \"_query\" not understood"))
 }
 
 // Test internally used functions
 
+func (m *MongoSearchSuite) TestConceptHierarchy(c *C) {
+	concepts := testValueSets()[0].CodeSystem.Concept
+
+	c.Assert(conceptsBelow(concepts, "56265001"), DeepEquals, []string{"56265001", "84114007", "10091002", "414545008", "123641001"})
+	c.Assert(conceptsBelow(concepts, "10725009"), DeepEquals, []string{"10725009"})
+	c.Assert(conceptsBelow(concepts, "404684003"), IsNil)
+
+	c.Assert(conceptsAbove(concepts, "123641001"), DeepEquals, []string{"49601007", "56265001", "414545008", "123641001"})
+	c.Assert(conceptsAbove(concepts, "49601007"), DeepEquals, []string{"49601007"})
+	c.Assert(conceptsAbove(concepts, "404684003"), IsNil)
+}
+
+func (m *MongoSearchSuite) TestAccentInsensitive(c *C) {
+	matches := func(search, value string) bool {
+		return regexp.MustCompile("(?i)^" + accentInsensitive(search) + "$").MatchString(value)
+	}
+
+	c.Assert(matches("Jose", "Jose"), Equals, true)
+	c.Assert(matches("Jose", "José"), Equals, true)
+	c.Assert(matches("Jose", "JOSÉ"), Equals, true)
+	c.Assert(matches("José", "Jose"), Equals, true)
+	c.Assert(matches("Jöse", "jøse"), Equals, true)
+	c.Assert(matches("Jose", "Jase"), Equals, false)
+	c.Assert(matches("a.b", "a.b"), Equals, true)
+	c.Assert(matches("a.b", "axb"), Equals, false)
+	c.Assert(accentInsensitive("1+2"), Equals, "1\\+2")
+}
+
+func (m *MongoSearchSuite) TestCommonArrayPath(c *C) {
+	c.Assert(commonArrayPath([]string{"[]component.code", "[]component.valueQuantity"}), Equals, "[]component")
+	c.Assert(commonArrayPath([]string{"[]a.[]b.c", "[]a.[]b.d.e"}), Equals, "[]a.[]b")
+	c.Assert(commonArrayPath([]string{"[]a.b.c", "[]a.b.d"}), Equals, "[]a")
+	c.Assert(commonArrayPath([]string{"code", "valueQuantity"}), Equals, "")
+	c.Assert(commonArrayPath([]string{"[]a.b", "[]c.d"}), Equals, "")
+	c.Assert(commonArrayPath([]string{"[]identifier", "[]identifier"}), Equals, "")
+}
+
 func (m *MongoSearchSuite) TestBuildBsonForCompositeCriteriaAndPathWithArrayAncestor(c *C) {
 	b := buildBSON("a.[]b.c.d.e", bson.M{"x": 1, "y": 2})
 	c.Assert(b, DeepEquals, bson.M{
@@ -1535,6 +2766,15 @@ func (m *MongoSearchSuite) TestBuildBsonWithQueryOperatorsAndArrayLeaf(c *C) {
 	})
 }
 
+func (m *MongoSearchSuite) TestBuildBsonWithSingleQueryOperatorAndArrayAncestors(c *C) {
+	b := buildBSON("a.b.[]c.d.e", bson.M{"$gte": 0})
+	c.Assert(b, DeepEquals, bson.M{
+		"a.b.c.d.e": bson.M{
+			"$gte": 0,
+		},
+	})
+}
+
 func (m *MongoSearchSuite) TestMergeObjectsWithNoCommonKeys(c *C) {
 	o1 := bson.M{
 		"a.b.c": 1,
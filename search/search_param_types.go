@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,7 +14,8 @@ import (
 // Constant values for search paramaters and search result parameters
 const (
 	IDParam            = "_id"
-	LastUpdatedParam   = "_lastUpdate"
+	HasParam           = "_has"
+	LastUpdatedParam   = "_lastUpdated"
 	TagParam           = "_tag"
 	ProfileParam       = "_profile"
 	SecurityParam      = "_security"
@@ -30,26 +32,86 @@ const (
 	ContainedParam     = "_contained"
 	ContainedTypeParam = "_containedType"
 	OffsetParam        = "_offset" // Custom param, not in FHIR spec
+	PageParam          = "_page"   // Custom param, not in FHIR spec
+	ScoreParam         = "_score"  // Sorts text searches by relevance
 )
 
 var globalSearchParams = map[string]bool{IDParam: true, LastUpdatedParam: true, TagParam: true,
 	ProfileParam: true, SecurityParam: true, TextParam: true, ContentParam: true, ListParam: true,
-	QueryParam: true}
+	QueryParam: true, HasParam: true}
 
 func isGlobalSearchParam(param string) bool {
 	_, found := globalSearchParams[param]
 	return found
 }
 
+// GlobalSearchParameterDictionary defines the global search parameters that
+// can be searched on every resource type (using its Meta, or for _text and
+// _content, its text), in addition to those in the SearchParameterDictionary.
+// The text search parameters have no paths, since they search the resource's
+// text index (see EnsureTextIndexes).
+var GlobalSearchParameterDictionary = map[string]SearchParamInfo{
+	ContentParam: SearchParamInfo{
+		Name: ContentParam,
+		Type: "string",
+	},
+	LastUpdatedParam: SearchParamInfo{
+		Name: LastUpdatedParam,
+		Type: "date",
+		Paths: []SearchParamPath{
+			SearchParamPath{Path: "meta.lastUpdated", Type: "instant"},
+		},
+	},
+	ProfileParam: SearchParamInfo{
+		Name: ProfileParam,
+		Type: "uri",
+		Paths: []SearchParamPath{
+			SearchParamPath{Path: "meta.[]profile", Type: "uri"},
+		},
+	},
+	SecurityParam: SearchParamInfo{
+		Name: SecurityParam,
+		Type: "token",
+		Paths: []SearchParamPath{
+			SearchParamPath{Path: "meta.[]security", Type: "Coding"},
+		},
+	},
+	TagParam: SearchParamInfo{
+		Name: TagParam,
+		Type: "token",
+		Paths: []SearchParamPath{
+			SearchParamPath{Path: "meta.[]tag", Type: "Coding"},
+		},
+	},
+	TextParam: SearchParamInfo{
+		Name: TextParam,
+		Type: "string",
+	},
+}
+
 var searchResultParams = map[string]bool{SortParam: true, CountParam: true, IncludeParam: true,
 	RevIncludeParam: true, SummaryParam: true, ElementsParam: true, ContainedParam: true,
-	ContainedTypeParam: true, OffsetParam: true}
+	ContainedTypeParam: true, OffsetParam: true, PageParam: true}
 
 func isSearchResultParam(param string) bool {
 	_, found := searchResultParams[param]
 	return found
 }
 
+// SupportedModifiers lists the search modifiers that are supported for each
+// type of search parameter, using the codes of the FHIR search-modifier-code
+// value set.  The "type" modifier refers to restricting a reference parameter
+// to a resource type (e.g., "subject:Patient").
+var SupportedModifiers = map[string][]string{
+	"date":      []string{"missing"},
+	"number":    []string{"missing"},
+	"quantity":  []string{"missing"},
+	"reference": []string{"missing", "type", "identifier"},
+	"string":    []string{"missing", "exact", "contains"},
+	"token":     []string{"missing", "not", "text", "in", "not-in", "above", "below"},
+	"uri":       []string{"missing"},
+}
+
 // Query describes a string-based FHIR query and the resource it is associated
 // with.  For example, the URL http://acme.com/Condition?patient=123&onset=2012
 // should be represented as:
@@ -72,7 +134,14 @@ func (q *Query) Params() []SearchParam {
 			continue
 		}
 
-		info, ok := SearchParameterDictionary[q.Resource][param]
+		if param == HasParam {
+			for _, value := range values {
+				results = append(results, ParseReverseChainParam(modifier, value, q.Resource))
+			}
+			continue
+		}
+
+		info, ok := lookupSearchParamInfo(q.Resource, param)
 		if ok {
 			info.Postfix = postfix
 			info.Modifier = modifier
@@ -80,8 +149,8 @@ func (q *Query) Params() []SearchParam {
 				results = append(results, info.CreateSearchParam(value))
 			}
 		} else {
-			// Check if it's a global search parameter. If so, we must not support it yet.
-			if isGlobalSearchParam(param) {
+			// Check if it's a global search parameter that we don't support yet.
+			if _, supported := GlobalSearchParameterDictionary[param]; isGlobalSearchParam(param) && !supported {
 				panic(createUnsupportedSearchError("MSG_PARAM_UNKNOWN", fmt.Sprintf("Parameter \"%s\" not understood", param)))
 			} else {
 				panic(createInvalidSearchError("SEARCH_NONE", fmt.Sprintf("Error: no processable search found for %s search parameters \"%s\"", q.Resource, param)))
@@ -91,16 +160,113 @@ func (q *Query) Params() []SearchParam {
 	return results
 }
 
+// lookupSearchParamInfo looks up the named search parameter on the resource,
+// including the global search parameters (e.g., "_lastUpdated").
+// Composite parameters on choice elements are defined once (e.g.,
+// "code-value-[x]") but searched by the type of the element (e.g.,
+// "code-value-quantity"), so such names resolve to the composite with its
+// components resolved to the same type (e.g., "code" and "value-quantity").
+func lookupSearchParamInfo(resource, name string) (SearchParamInfo, bool) {
+	params := SearchParameterDictionary[resource]
+	if info, ok := params[name]; ok {
+		return info, true
+	}
+	if info, ok := GlobalSearchParameterDictionary[name]; ok && params != nil {
+		return info, true
+	}
+
+	for choiceName, info := range params {
+		prefix := strings.TrimSuffix(choiceName, "[x]")
+		if info.Type != "composite" || prefix == choiceName || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		resolved := info
+		resolved.Name = name
+		resolved.Composites = make([]string, len(info.Composites))
+		for i, component := range info.Composites {
+			if strings.HasSuffix(component, "[x]") {
+				component = strings.TrimSuffix(component, "[x]") + "-" + name[len(prefix):]
+			}
+			resolved.Composites[i] = component
+		}
+		if resolved.hasComponentsOn(resource) {
+			return resolved, true
+		}
+	}
+	return SearchParamInfo{}, false
+}
+
+// SearchableParams returns the search parameters that can be searched on the
+// resource, by name.  Composite parameters on choice elements are returned by
+// the names they are searched by (e.g., "code-value-quantity" rather than
+// "code-value-[x]").
+func SearchableParams(resource string) map[string]SearchParamInfo {
+	params := SearchParameterDictionary[resource]
+	searchable := make(map[string]SearchParamInfo)
+	for name, info := range params {
+		prefix := strings.TrimSuffix(name, "[x]")
+		if info.Type != "composite" || prefix == name {
+			searchable[name] = info
+			continue
+		}
+		// The choices are the types of the choice component (e.g., "value-quantity")
+		for _, component := range info.Composites {
+			if !strings.HasSuffix(component, "[x]") {
+				continue
+			}
+			choicePrefix := strings.TrimSuffix(component, "[x]") + "-"
+			for other := range params {
+				if !strings.HasPrefix(other, choicePrefix) {
+					continue
+				}
+				if resolved, ok := lookupSearchParamInfo(resource, prefix+other[len(choicePrefix):]); ok {
+					searchable[resolved.Name] = resolved
+				}
+			}
+			break
+		}
+	}
+	return searchable
+}
+
+// hasComponentsOn returns true if all of the composite's components are search
+// parameters on the resource.
+func (s SearchParamInfo) hasComponentsOn(resource string) bool {
+	for _, component := range s.Composites {
+		if _, ok := SearchParameterDictionary[resource][component]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // Options parses the query string and returns the QueryOptions.
 func (q *Query) Options() *QueryOptions {
 	options := NewQueryOptions()
+	options.TextSearch = q.hasTextSearch()
 	queryMap, _ := url.ParseQuery(q.Query)
 	for param, values := range queryMap {
-		param, _, _ := ParseParamNameModifierAndPostFix(param)
+		param, modifier, _ := ParseParamNameModifierAndPostFix(param)
 		if !strings.HasPrefix(param, "_") || isGlobalSearchParam(param) {
 			continue
 		}
 
+		// Includes may repeat, and may be iterated (e.g., "_include:iterate")
+		if param == IncludeParam || param == RevIncludeParam {
+			if modifier != "" && modifier != "iterate" {
+				panic(createInvalidSearchError("MSG_PARAM_MODIFIER_INVALID", fmt.Sprintf("Parameter \"%s\" modifier is invalid", param)))
+			}
+			for _, value := range values {
+				include := q.includeOption(param, value, modifier == "iterate")
+				if param == IncludeParam {
+					options.Include = append(options.Include, include)
+				} else {
+					options.RevInclude = append(options.RevInclude, include)
+				}
+			}
+			continue
+		}
+
 		if len(values) != 1 {
 			panic(createInvalidSearchError("MSG_PARAM_NO_REPEAT", fmt.Sprintf("Parameter \"%s\" is not allowed to repeat", param)))
 		}
@@ -123,6 +289,30 @@ func (q *Query) Options() *QueryOptions {
 			if offset >= 0 {
 				options.Offset = offset
 			}
+		case SortParam:
+			options.Sort = q.sortOptions(value)
+		case PageParam:
+			// Page tokens refer to stored search results, which the server resolves
+		case SummaryParam:
+			switch value {
+			case "true", "text", "data", "count", "false":
+				options.Summary = value
+			default:
+				panic(createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"_summary\" content is invalid"))
+			}
+		case ElementsParam:
+			fields := elementFields(q.Resource)
+			for _, element := range strings.Split(value, ",") {
+				element = strings.TrimSpace(element)
+				if element == "" {
+					panic(createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"_elements\" content is invalid"))
+				}
+				// Only the top-level elements of the resource can be selected
+				if _, ok := fields[element]; !ok {
+					panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"_elements\" content is invalid: \"%s\" is not an element of %s", element, q.Resource)))
+				}
+				options.Elements = append(options.Elements, element)
+			}
 		default:
 			panic(createUnsupportedSearchError("MSG_PARAM_UNKNOWN", fmt.Sprintf("Parameter \"%s\" not understood", param)))
 		}
@@ -130,6 +320,86 @@ func (q *Query) Options() *QueryOptions {
 	return options
 }
 
+// sortOptions parses the value of the _sort parameter: a comma-separated list
+// of search parameter names, each optionally prefixed with "-" to indicate a
+// descending sort (e.g., "family,-birthdate").  A text search can also be
+// sorted by relevance using "_score".
+func (q *Query) sortOptions(value string) []SortOption {
+	var sorts []SortOption
+	for _, name := range strings.Split(value, ",") {
+		sort := SortOption{}
+		if strings.HasPrefix(name, "-") {
+			sort.Descending = true
+			name = name[1:]
+		}
+
+		// Text searches can be sorted by relevance, which is always highest first
+		if name == ScoreParam {
+			if !q.hasTextSearch() {
+				panic(createInvalidSearchError("MSG_SORT_UNKNOWN", fmt.Sprintf("Sort parameter \"%s\" is only valid for %s and %s searches", ScoreParam, TextParam, ContentParam)))
+			}
+			if sort.Descending {
+				panic(createUnsupportedSearchError("MSG_SORT_UNKNOWN", fmt.Sprintf("Sort parameter \"-%s\" is not supported", ScoreParam)))
+			}
+			sort.Parameter = SearchParamInfo{Name: ScoreParam}
+			sorts = append(sorts, sort)
+			continue
+		}
+
+		info, ok := lookupSearchParamInfo(q.Resource, name)
+		if !ok || len(info.Paths) == 0 {
+			panic(createInvalidSearchError("MSG_SORT_UNKNOWN", fmt.Sprintf("Unknown sort parameter \"%s\"", name)))
+		}
+		sort.Parameter = info
+		sorts = append(sorts, sort)
+	}
+	return sorts
+}
+
+// hasTextSearch returns true if the query searches the text of the resources
+// (i.e., it has a _text or _content parameter).
+func (q *Query) hasTextSearch() bool {
+	queryMap, _ := url.ParseQuery(q.Query)
+	_, text := queryMap[TextParam]
+	_, content := queryMap[ContentParam]
+	return text || content
+}
+
+// includeOption parses the value of an _include or _revinclude parameter,
+// which takes the form "Type:param[:target]" (e.g., "Condition:patient").  The
+// parameter must be a reference parameter on Type.  Unless the include is
+// iterated, an _include must be on the searched resource type and a
+// _revinclude must be on a parameter that can refer to it.
+func (q *Query) includeOption(param, value string, iterate bool) IncludeOption {
+	invalid := createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", param))
+
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		panic(invalid)
+	}
+	info, ok := SearchParameterDictionary[parts[0]][parts[1]]
+	if !ok || info.Type != "reference" {
+		panic(invalid)
+	}
+	include := IncludeOption{Resource: parts[0], Parameter: info, Iterate: iterate}
+	if len(parts) == 3 {
+		if !info.targets(parts[2]) {
+			panic(invalid)
+		}
+		include.Target = parts[2]
+	}
+
+	if !iterate {
+		if param == IncludeParam && include.Resource != q.Resource {
+			panic(invalid)
+		}
+		if param == RevIncludeParam && (!info.targets(q.Resource) || (include.Target != "" && include.Target != q.Resource)) {
+			panic(invalid)
+		}
+	}
+	return include
+}
+
 // NormalizedQueryValues reconstructs the URL-encoded query based on parsed
 // parameters.  This ensures better uniformity/consistency and also removes any
 // garbage parameters or bad formatting in the passed in parameters.  If
@@ -145,17 +415,65 @@ func (q *Query) NormalizedQueryValues(withOptions bool) url.Values {
 	if withOptions {
 		oValues := q.Options().QueryValues()
 		for k, v := range oValues {
-			values.Set(k, v[0])
+			values[k] = v
 		}
 	}
 
 	return values
 }
 
-// QueryOptions contains option values such as count and offset.
+// QueryOptions contains option values such as count, offset, sort order, the
+// resources to include and the elements to return.  TextSearch is set when the
+// query has a _text or _content parameter, so the results are scored by their
+// relevance (see TextScoreField).
 type QueryOptions struct {
-	Count  int
-	Offset int
+	Count      int
+	Offset     int
+	Sort       []SortOption
+	Include    []IncludeOption
+	RevInclude []IncludeOption
+	Summary    string
+	Elements   []string
+	TextSearch bool
+}
+
+// IsSubsetted returns true if the options restrict the elements that are
+// returned (i.e., the results are not complete resources).
+func (o *QueryOptions) IsSubsetted() bool {
+	switch o.Summary {
+	case "true", "text", "data":
+		return true
+	}
+	return len(o.Elements) > 0
+}
+
+// SortOption indicates a search parameter that results should be sorted by,
+// and whether the sort should be descending.
+type SortOption struct {
+	Descending bool
+	Parameter  SearchParamInfo
+}
+
+// IncludeOption indicates a reference search parameter whose referenced
+// resources (for _include) or referencing resources (for _revinclude) should
+// be returned along with the search results.  Target, if set, restricts the
+// include to references to that resource type.  Iterated includes are also
+// applied to the resources that were included.
+type IncludeOption struct {
+	Resource  string
+	Parameter SearchParamInfo
+	Target    string
+	Iterate   bool
+}
+
+// String returns the include in the form used in the query string (e.g.,
+// "Condition:patient:Patient").
+func (i IncludeOption) String() string {
+	s := i.Resource + ":" + i.Parameter.Name
+	if i.Target != "" {
+		s += ":" + i.Target
+	}
+	return s
 }
 
 func NewQueryOptions() *QueryOptions {
@@ -167,6 +485,33 @@ func (o *QueryOptions) QueryValues() url.Values {
 	values := url.Values{}
 	values.Set(CountParam, strconv.Itoa(o.Count))
 	values.Set(OffsetParam, strconv.Itoa(o.Offset))
+	if len(o.Sort) > 0 {
+		names := make([]string, len(o.Sort))
+		for i, sort := range o.Sort {
+			names[i] = sort.Parameter.Name
+			if sort.Descending {
+				names[i] = "-" + names[i]
+			}
+		}
+		values.Set(SortParam, strings.Join(names, ","))
+	}
+	addIncludes := func(param string, includes []IncludeOption) {
+		for _, include := range includes {
+			key := param
+			if include.Iterate {
+				key += ":iterate"
+			}
+			values.Add(key, include.String())
+		}
+	}
+	addIncludes(IncludeParam, o.Include)
+	addIncludes(RevIncludeParam, o.RevInclude)
+	if o.Summary != "" {
+		values.Set(SummaryParam, o.Summary)
+	}
+	if len(o.Elements) > 0 {
+		values.Set(ElementsParam, strings.Join(o.Elements, ","))
+	}
 
 	return values
 }
@@ -191,13 +536,47 @@ type SearchParamInfo struct {
 	Modifier   string
 }
 
+// targets returns true if the (reference) search parameter can refer to the
+// given resource type.
+func (s SearchParamInfo) targets(resourceType string) bool {
+	for _, target := range s.Targets {
+		if target == resourceType || target == "Any" {
+			return true
+		}
+	}
+	return false
+}
+
+// chainedTargets returns the types that the (reference) search parameter can
+// refer to that have the first parameter of its chain (e.g., the types with an
+// "organization" parameter for "subject.organization.name").
+func (s SearchParamInfo) chainedTargets() []string {
+	name, _, _ := ParseParamNameModifierAndPostFix(s.Postfix)
+	var targets []string
+	for resourceType := range SearchParameterDictionary {
+		if _, ok := lookupSearchParamInfo(resourceType, name); ok && s.targets(resourceType) {
+			targets = append(targets, resourceType)
+		}
+	}
+	sort.Strings(targets)
+	return targets
+}
+
 // CreateSearchParam converts a singular string query value (e.g. "2012") into
 // a SearchParam object corresponding to the SearchParamInfo.
 func (s SearchParamInfo) CreateSearchParam(paramStr string) SearchParam {
+	if s.Name == TextParam || s.Name == ContentParam {
+		return ParseTextSearchParam(paramStr, s)
+	}
+
 	if ors := escapeFriendlySplit(paramStr, ','); len(ors) > 1 {
 		return ParseOrParam(ors, s)
 	}
 
+	if s.Modifier == "missing" {
+		return ParseMissingParam(paramStr, s)
+	}
+
 	switch s.Type {
 	case "composite":
 		return ParseCompositeParam(paramStr, s)
@@ -316,6 +695,23 @@ func (d *Date) RangeLowIncl() time.Time {
 	return d.Value
 }
 
+// ApproxDateWindow is how far either side of the range implied by a date's
+// precision the "ap" (approximately) prefix matches.  For example, with the
+// default window "ap2012-03-01" matches 2012-02-23 through 2012-03-08.
+var ApproxDateWindow = 7 * 24 * time.Hour
+
+// ApproxRangeLowIncl represents the low end of the range to match against for
+// the "ap" (approximately) prefix.  The low end of the range is inclusive.
+func (d *Date) ApproxRangeLowIncl() time.Time {
+	return d.RangeLowIncl().Add(-ApproxDateWindow)
+}
+
+// ApproxRangeHighExcl represents the high end of the range to match against for
+// the "ap" (approximately) prefix.  The high end of the range is exclusive.
+func (d *Date) ApproxRangeHighExcl() time.Time {
+	return d.RangeHighExcl().Add(ApproxDateWindow)
+}
+
 // RangeHighExcl represents the high end of a date range to match against.  As
 // the name suggests, the high end of the range is exclusive.
 func (d *Date) RangeHighExcl() time.Time {
@@ -343,7 +739,7 @@ func ParseDate(dateStr string) *Date {
 	dt := &Date{}
 
 	dateStr = strings.TrimSpace(dateStr)
-	dtRegex := regexp.MustCompile("([0-9]{4})(-(0[1-9]|1[0-2])(-(0[0-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):([0-5][0-9])(:([0-5][0-9])(\\.([0-9]+))?)?((Z)|(\\+|-)((0[0-9]|1[0-3]):([0-5][0-9])|(14):(00)))?)?)?)?")
+	dtRegex := regexp.MustCompile(datePattern)
 	if m := dtRegex.FindStringSubmatch(dateStr); m != nil {
 		y, mo, d, h, mi, s, ms, tzZu, tzOp, tzh, tzm := m[1], m[3], m[5], m[7], m[8], m[10], m[12], m[14], m[15], m[17], m[18]
 
@@ -418,6 +814,15 @@ func ParseDate(dateStr string) *Date {
 	return dt
 }
 
+// datePattern matches the dates (and date times) that ParseDate understands.
+const datePattern = "([0-9]{4})(-(0[1-9]|1[0-2])(-(0[0-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):([0-5][0-9])(:([0-5][0-9])(\\.([0-9]+))?)?((Z)|(\\+|-)((0[0-9]|1[0-3]):([0-5][0-9])|(14):(00)))?)?)?)?"
+
+// IsValidDate returns true if the string is a date (or date time) that
+// ParseDate can parse, rather than one it would treat as the current time.
+func IsValidDate(dateStr string) bool {
+	return regexp.MustCompile("^" + datePattern + "$").MatchString(strings.TrimSpace(dateStr))
+}
+
 // DatePrecision is an enum representing the precision of a date.
 type DatePrecision int
 
@@ -507,6 +912,30 @@ func (n *Number) RangeHighExcl() *big.Rat {
 	return new(big.Rat).Add(n.Value, n.rangeDelta())
 }
 
+// ApproxRangeLowIncl represents the low end of the range to match against for
+// the "ap" (approximately) prefix.  The low end of the range is inclusive.
+func (n *Number) ApproxRangeLowIncl() *big.Rat {
+	return new(big.Rat).Sub(n.Value, n.approxDelta())
+}
+
+// ApproxRangeHighIncl represents the high end of the range to match against for
+// the "ap" (approximately) prefix.  The high end of the range is inclusive.
+func (n *Number) ApproxRangeHighIncl() *big.Rat {
+	return new(big.Rat).Add(n.Value, n.approxDelta())
+}
+
+// The FHIR spec recommends that "ap" matches values within 10% of the search
+// value.  The range is never narrower than the one implied by the precision,
+// so that approximately 0 (or 1) still matches something.
+func (n *Number) approxDelta() *big.Rat {
+	delta := new(big.Rat).Abs(n.Value)
+	delta.Mul(delta, big.NewRat(1, 10))
+	if rangeDelta := n.rangeDelta(); delta.Cmp(rangeDelta) < 0 {
+		return rangeDelta
+	}
+	return delta
+}
+
 // The FHIR spec defines equality for 100 to be the range [99.5, 100.5) so we
 // must support min/max using rounding semantics. The basic algorithm for
 // determining low/high is:
@@ -600,34 +1029,66 @@ func (r *ReferenceParam) getQueryParamAndValue() (string, string) {
 	case ChainedQueryReference:
 		// This is a weird one, so don't use the general encodedQueryParam function
 		// First get the chained query param (e.g., "gender=male")
-		chainedParams := t.ChainedQuery.Params()
+		chainedQuery := t.ChainedQuery
+		if t.Type == "" {
+			// An untyped chain can be parsed on any of its types
+			chainedQuery.Resource = r.chainedTargets()[0]
+		}
+		chainedParams := chainedQuery.Params()
 		if len(chainedParams) != 1 {
 			panic(createInternalServerError("MSG_PARAM_CHAINED", "Unknown chained parameter name \"\""))
 		}
 		cqParam, cqValue := chainedParams[0].getQueryParamAndValue()
 		// Then get the LHS representing the reference (e.g., "subject:Patient")
-		referenceParam := fmt.Sprintf("%s:%s", r.Name, t.Type)
+		referenceParam := r.Name
+		if t.Type != "" {
+			referenceParam = fmt.Sprintf("%s:%s", r.Name, t.Type)
+		}
 		// Then put them together to get the full param / value (e.g., "subject:Patient.gender", "male")
 		return fmt.Sprintf("%s.%s", referenceParam, cqParam), cqValue
 	case ExternalReference:
 		return r.Name, escape(t.URL)
 	case LocalReference:
 		return r.Name, fmt.Sprintf("%s/%s", t.Type, escape(t.ID))
+	case IdentifierReference:
+		return queryParamAndValue(r.SearchParamInfo, t.tokenValue())
 	}
 	panic(createInternalServerError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", r.Name)))
 }
 
 // ParseReferenceParam parses a reference-based query string and returns a
 // pointer to a ReferenceParam based on the query and the parameter definition.
+// A versioned reference (e.g., "Patient/123/_history/2") refers to the resource
+// regardless of its version.  A chained query on a parameter that can refer to
+// more than one type (e.g., "subject.name") is on every type that has the
+// chained parameter unless the type is given (e.g., "subject:Patient.name").
 func ParseReferenceParam(paramStr string, info SearchParamInfo) *ReferenceParam {
-	ref := unescape(paramStr)
+	if info.Postfix != "" && info.Modifier != "identifier" {
+		typ := ""
+		if info.Modifier != "" || len(info.Targets) == 1 {
+			typ = findReferencedType("", info)
+		} else if targets := info.chainedTargets(); len(targets) == 1 {
+			typ = targets[0]
+		} else if len(targets) == 0 {
+			panic(createInvalidSearchError("MSG_PARAM_CHAINED", fmt.Sprintf("Unknown chained parameter name \"%s\"", info.Postfix)))
+		}
+		q := Query{Resource: typ, Query: info.Postfix + "=" + url.QueryEscape(paramStr)}
+		return &ReferenceParam{info, ChainedQueryReference{Type: typ, ChainedQuery: q}}
+	}
+
+	if info.Modifier == "identifier" {
+		if info.Postfix != "" {
+			panic(createInvalidSearchError("MSG_PARAM_MODIFIER_INVALID", fmt.Sprintf("Parameter \"%s\" modifier is invalid", info.Name)))
+		}
+		t := ParseTokenParam(paramStr, info)
+		return &ReferenceParam{info, IdentifierReference{System: t.System, Value: t.Code, AnySystem: t.AnySystem}}
+	}
+
+	ref := regexp.MustCompile("\\/_history\\/[^\\/]+$").ReplaceAllString(unescape(paramStr), "")
 	re := regexp.MustCompile("\\/?(([^\\/]+)\\/)?([^\\/]+)$")
 	if m := re.FindStringSubmatch(ref); m != nil {
 		typ := findReferencedType(m[2], info)
-		if info.Postfix != "" {
-			q := Query{Resource: typ, Query: info.Postfix + "=" + paramStr}
-			return &ReferenceParam{info, ChainedQueryReference{Type: typ, ChainedQuery: q}}
-		} else if u, e := url.Parse(ref); e == nil && u.IsAbs() {
+		if u, e := url.Parse(ref); e == nil && u.IsAbs() {
 			return &ReferenceParam{info, ExternalReference{Type: typ, URL: ref}}
 		} else {
 			return &ReferenceParam{info, LocalReference{Type: typ, ID: m[3]}}
@@ -686,12 +1147,29 @@ type ExternalReference struct {
 	URL  string
 }
 
-// ChainedQueryReference represents a chained query
+// ChainedQueryReference represents a chained query (on every type that has the
+// chained parameter when Type is empty)
 type ChainedQueryReference struct {
 	Type         string
 	ChainedQuery Query
 }
 
+// IdentifierReference represents a reference by the identifier of the
+// referenced resource (e.g., "subject:identifier=http://hospital|MRN123")
+type IdentifierReference struct {
+	System    string
+	Value     string
+	AnySystem bool
+}
+
+// tokenValue returns the identifier as the value of a token parameter
+func (i IdentifierReference) tokenValue() string {
+	if i.AnySystem {
+		return escape(i.Value)
+	}
+	return fmt.Sprintf("%s|%s", escape(i.System), escape(i.Value))
+}
+
 // StringParam represents a string-flavored search parameter.  The
 // following description is from the FHIR DSTU2 specification:
 //
@@ -750,10 +1228,15 @@ func (t *TokenParam) getQueryParamAndValue() (string, string) {
 func ParseTokenParam(paramString string, info SearchParamInfo) *TokenParam {
 	t := &TokenParam{SearchParamInfo: info}
 	splitCode := escapeFriendlySplit(paramString, '|')
-	if len(splitCode) > 1 {
+	switch {
+	case info.Modifier == "text" || info.Modifier == "in" || info.Modifier == "not-in":
+		// The value is text or a value set URI rather than a code
+		t.AnySystem = true
+		t.Code = unescape(paramString)
+	case len(splitCode) > 1:
 		t.System = unescape(splitCode[0])
 		t.Code = unescape(splitCode[1])
-	} else {
+	default:
 		t.AnySystem = true
 		t.Code = unescape(splitCode[0])
 	}
@@ -785,6 +1268,121 @@ func ParseURIParam(paramStr string, info SearchParamInfo) *URIParam {
 	return &URIParam{info, unescape(paramStr)}
 }
 
+// TextSearchParam represents the _text and _content global search parameters.
+// The following description is from the FHIR DSTU2 specification:
+//
+// _content: Search on the entire content of the resource.  _text: Search on
+// the narrative of the resource.
+//
+// The text is searched using the database's text index, so it has the syntax
+// of a Mongo text search: it matches any of its words (or their stems), words
+// prefixed with "-" must not appear, and quoted phrases must appear.  Since a
+// text search is never a list of alternatives, commas are not treated as ORs.
+type TextSearchParam struct {
+	SearchParamInfo
+	Text string
+}
+
+func (t *TextSearchParam) getInfo() SearchParamInfo {
+	return t.SearchParamInfo
+}
+
+func (t *TextSearchParam) getQueryParamAndValue() (string, string) {
+	return queryParamAndValue(t.SearchParamInfo, escape(t.Text))
+}
+
+// ParseTextSearchParam parses a _text or _content query string and returns a
+// pointer to a TextSearchParam based on the query and the parameter definition.
+func ParseTextSearchParam(paramStr string, info SearchParamInfo) *TextSearchParam {
+	return &TextSearchParam{info, unescape(paramStr)}
+}
+
+// MissingParam represents a search parameter with the "missing" modifier,
+// which can be used with parameters of any type.  The following description
+// is from the FHIR DSTU2 specification:
+//
+// For all parameters (except combination), the modifier ":missing" is
+// available. Searching for "gender:missing=true" will return all the resources
+// that don't have a value for the gender parameter (which usually equates to
+// not having the relevant element in the resource). Searching for
+// "gender:missing=false" will return all the resources that have a value for
+// the "gender" parameter.
+type MissingParam struct {
+	SearchParamInfo
+	Missing bool
+}
+
+func (m *MissingParam) getInfo() SearchParamInfo {
+	return m.SearchParamInfo
+}
+
+func (m *MissingParam) getQueryParamAndValue() (string, string) {
+	return queryParamAndValue(m.SearchParamInfo, strconv.FormatBool(m.Missing))
+}
+
+// ParseMissingParam parses a "missing" query string (i.e., "true" or "false")
+// and returns a pointer to a MissingParam based on the query and the parameter
+// definition.
+func ParseMissingParam(paramStr string, info SearchParamInfo) *MissingParam {
+	switch paramStr {
+	case "true":
+		return &MissingParam{info, true}
+	case "false":
+		return &MissingParam{info, false}
+	}
+	panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", info.Name)))
+}
+
+// ReverseChainParam represents a reverse chained ("_has") search parameter.
+// The following description is from the FHIR STU3 specification:
+//
+// The _has parameter provides limited support for reverse chaining - that is,
+// selecting resources based on the properties of resources that refer to them
+// (instead of chaining where resources can be selected based on the properties
+// of resources that they refer to). For example, "/Patient?_has:Observation:
+// patient:code=1234-5" finds the patients referred to by the patient parameter
+// of Observations with the code 1234-5.
+type ReverseChainParam struct {
+	SearchParamInfo
+	Type         string
+	Reference    SearchParamInfo
+	ChainedQuery Query
+}
+
+func (r *ReverseChainParam) getInfo() SearchParamInfo {
+	return r.SearchParamInfo
+}
+
+func (r *ReverseChainParam) getQueryParamAndValue() (string, string) {
+	chainedParams := r.ChainedQuery.Params()
+	if len(chainedParams) != 1 {
+		panic(createInternalServerError("MSG_PARAM_CHAINED", "Unknown chained parameter name \"\""))
+	}
+	cqParam, cqValue := chainedParams[0].getQueryParamAndValue()
+	return fmt.Sprintf("%s:%s:%s:%s", HasParam, r.Type, r.Reference.Name, cqParam), cqValue
+}
+
+// ParseReverseChainParam parses the chain (e.g., "Observation:patient:code")
+// and query string of a "_has" parameter on the resource type and returns a
+// pointer to a ReverseChainParam.  The chain's reference parameter must be able
+// to refer to the resource type.
+func ParseReverseChainParam(chain, paramStr, resource string) *ReverseChainParam {
+	split := strings.SplitN(chain, ":", 3)
+	if len(split) != 3 {
+		panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", HasParam)))
+	}
+	reference, ok := SearchParameterDictionary[split[0]][split[1]]
+	if !ok || reference.Type != "reference" || !reference.targets(resource) {
+		panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" content is invalid", HasParam)))
+	}
+	return &ReverseChainParam{
+		SearchParamInfo: SearchParamInfo{Name: HasParam, Type: "reference"},
+		Type:            split[0],
+		Reference:       reference,
+		ChainedQuery:    Query{Resource: split[0], Query: url.QueryEscape(split[2]) + "=" + url.QueryEscape(paramStr)},
+	}
+}
+
 // OrParam represents a search parameter that has multiple OR values.  The
 // following description is from the FHIR DSTU2 specification:
 //
@@ -829,13 +1427,17 @@ func ParseOrParam(paramStr []string, info SearchParamInfo) *OrParam {
 // modifier, and postfix components.  For example, "foo:bar.baz" would return ["foo","bar","baz"].
 func ParseParamNameModifierAndPostFix(fullParam string) (param string, modifier string, postfix string) {
 	param = fullParam
-	if strings.Contains(fullParam, ".") {
-		split := strings.SplitN(fullParam, ".", 2)
+	if strings.HasPrefix(fullParam, HasParam+":") {
+		// The rest of a reverse chain (e.g., "_has:Observation:patient:code") is its modifier
+		return HasParam, strings.TrimPrefix(fullParam, HasParam+":"), ""
+	}
+	if strings.Contains(param, ".") {
+		split := strings.SplitN(param, ".", 2)
 		param = split[0]
 		postfix = split[1]
 	}
-	if strings.Contains(fullParam, ":") {
-		split := strings.SplitN(fullParam, ":", 2)
+	if strings.Contains(param, ":") {
+		split := strings.SplitN(param, ":", 2)
 		param = split[0]
 		modifier = split[1]
 	}
@@ -857,6 +1459,8 @@ const (
 	LT Prefix = "lt"
 	GE Prefix = "ge"
 	LE Prefix = "le"
+	SA Prefix = "sa"
+	EB Prefix = "eb"
 	AP Prefix = "ap"
 )
 
@@ -869,7 +1473,7 @@ func (p Prefix) String() string {
 // prefix and value.
 func ExtractPrefixAndValue(s string) (Prefix, string) {
 	prefix := EQ
-	for _, p := range []Prefix{EQ, NE, GT, LT, GE, LE, AP} {
+	for _, p := range []Prefix{EQ, NE, GT, LT, GE, LE, SA, EB, AP} {
 		if strings.HasPrefix(s, p.String()) {
 			prefix = p
 			break
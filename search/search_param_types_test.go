@@ -1118,6 +1118,105 @@ func (s *SearchPTSuite) TestReferenceChainedQueryReconstitution(c *C) {
 	c.Assert(v, Equals, "Peter\\$on")
 }
 
+func (s *SearchPTSuite) TestReferenceVersionedTypeAndID(c *C) {
+	r := ParseReferenceParam("Patient/23/_history/2", referenceParamInfo)
+
+	c.Assert(r.Reference, FitsTypeOf, LocalReference{})
+	lRef := r.Reference.(LocalReference)
+	c.Assert(lRef.ID, Equals, "23")
+	c.Assert(lRef.Type, Equals, "Patient")
+
+	r = ParseReferenceParam("http://acme.org/fhir/Patient/23/_history/2", referenceParamInfo)
+
+	c.Assert(r.Reference, FitsTypeOf, ExternalReference{})
+	eRef := r.Reference.(ExternalReference)
+	c.Assert(eRef.URL, Equals, "http://acme.org/fhir/Patient/23")
+	c.Assert(eRef.Type, Equals, "Patient")
+}
+
+func (s *SearchPTSuite) TestReferenceIdentifier(c *C) {
+	modInfo := referenceParamInfo
+	modInfo.Modifier = "identifier"
+	r := ParseReferenceParam("http://hospital|MRN123", modInfo)
+
+	c.Assert(r.Name, Equals, "foo")
+	c.Assert(r.Type, Equals, "reference")
+	c.Assert(r.Modifier, Equals, "identifier")
+
+	c.Assert(r.Reference, DeepEquals, IdentifierReference{System: "http://hospital", Value: "MRN123"})
+
+	r = ParseReferenceParam("MRN123", modInfo)
+	c.Assert(r.Reference, DeepEquals, IdentifierReference{Value: "MRN123", AnySystem: true})
+}
+
+func (s *SearchPTSuite) TestReferenceIdentifierWithChainPanics(c *C) {
+	modInfo := referenceParamInfo
+	modInfo.Modifier = "identifier"
+	modInfo.Postfix = "name"
+	c.Assert(func() { ParseReferenceParam("Peter", modInfo) }, Panics, createInvalidSearchError("MSG_PARAM_MODIFIER_INVALID", "Parameter \"foo\" modifier is invalid"))
+}
+
+func (s *SearchPTSuite) TestReferenceIdentifierReconstitution(c *C) {
+	modInfo := referenceParamInfo
+	modInfo.Modifier = "identifier"
+	r := ParseReferenceParam("http://hospital|MRN123", modInfo)
+	p, v := r.getQueryParamAndValue()
+	c.Assert(p, Equals, "foo:identifier")
+	c.Assert(v, Equals, "http://hospital|MRN123")
+
+	// Test with no system
+	r = ParseReferenceParam("MRN123", modInfo)
+	p, v = r.getQueryParamAndValue()
+	c.Assert(p, Equals, "foo:identifier")
+	c.Assert(v, Equals, "MRN123")
+
+	// Test with Escape
+	r = ParseReferenceParam("http://hospital|MRN\\|123", modInfo)
+	p, v = r.getQueryParamAndValue()
+	c.Assert(p, Equals, "foo:identifier")
+	c.Assert(v, Equals, "http://hospital|MRN\\|123")
+}
+
+func (s *SearchPTSuite) TestReferenceChainedQueryOnMultipleTargets(c *C) {
+	modInfo := referenceParamInfo
+	modInfo.Targets = []string{"Device", "Group", "Location", "Patient"}
+
+	// Device, Location and Patient have an organization
+	modInfo.Postfix = "organization.name"
+	r := ParseReferenceParam("Acme", modInfo)
+	c.Assert(r.Reference, DeepEquals, ChainedQueryReference{Type: "", ChainedQuery: Query{Query: "organization.name=Acme"}})
+	p, v := r.getQueryParamAndValue()
+	c.Assert(p, Equals, "foo.organization:Organization.name")
+	c.Assert(v, Equals, "Acme")
+
+	// Only Patient has a gender
+	modInfo.Postfix = "gender"
+	r = ParseReferenceParam("male", modInfo)
+	c.Assert(r.Reference, DeepEquals, ChainedQueryReference{Type: "Patient", ChainedQuery: Query{Resource: "Patient", Query: "gender=male"}})
+
+	modInfo.Postfix = "abatement"
+	c.Assert(func() { ParseReferenceParam("2012", modInfo) }, Panics, createInvalidSearchError("MSG_PARAM_CHAINED", "Unknown chained parameter name \"abatement\""))
+}
+
+func (s *SearchPTSuite) TestMultiLevelChainedQuery(c *C) {
+	q := Query{Resource: "Observation", Query: "encounter:Encounter.patient:Patient.gender=male"}
+	params := q.Params()
+	c.Assert(params, HasLen, 1)
+	r, ok := params[0].(*ReferenceParam)
+	c.Assert(ok, Equals, true)
+	c.Assert(r.Name, Equals, "encounter")
+	c.Assert(r.Modifier, Equals, "Encounter")
+	c.Assert(r.Postfix, Equals, "patient:Patient.gender")
+	c.Assert(r.Reference, DeepEquals, ChainedQueryReference{
+		Type:         "Encounter",
+		ChainedQuery: Query{Resource: "Encounter", Query: "patient:Patient.gender=male"},
+	})
+
+	p, v := r.getQueryParamAndValue()
+	c.Assert(p, Equals, "encounter:Encounter.patient:Patient.gender")
+	c.Assert(v, Equals, "male")
+}
+
 /******************************************************************************
  * STRING
  ******************************************************************************/
@@ -1227,6 +1326,33 @@ func (s *SearchPTSuite) TestTokenParamsWithEscapedPipesAndSlashes(c *C) {
 	c.Assert(t.System, Equals, "foo|bar")
 }
 
+func (s *SearchPTSuite) TestTokenParamWithValueSetOrTextModifier(c *C) {
+	modInfo := tokenParamInfo
+	modInfo.Modifier = "in"
+	t := ParseTokenParam("http://example.org/fhir/ValueSet/a|b", modInfo)
+
+	c.Assert(t.Modifier, Equals, "in")
+	c.Assert(t.AnySystem, Equals, true)
+	c.Assert(t.Code, Equals, "http://example.org/fhir/ValueSet/a|b")
+	c.Assert(t.System, Equals, "")
+
+	modInfo.Modifier = "text"
+	t = ParseTokenParam("heart failure", modInfo)
+
+	c.Assert(t.Modifier, Equals, "text")
+	c.Assert(t.AnySystem, Equals, true)
+	c.Assert(t.Code, Equals, "heart failure")
+	c.Assert(t.System, Equals, "")
+
+	modInfo.Modifier = "below"
+	t = ParseTokenParam("http://snomed.info/sct|56265001", modInfo)
+
+	c.Assert(t.Modifier, Equals, "below")
+	c.Assert(t.AnySystem, Equals, false)
+	c.Assert(t.Code, Equals, "56265001")
+	c.Assert(t.System, Equals, "http://snomed.info/sct")
+}
+
 func (s *SearchPTSuite) TestTokenParamReconstitution(c *C) {
 	t := ParseTokenParam("http://hl7.org/fhir/v2/0001|M", tokenParamInfo)
 	p, v := t.getQueryParamAndValue()
@@ -1301,6 +1427,53 @@ func (s *SearchPTSuite) TestURIReconstitution(c *C) {
 	c.Assert(v, Equals, "http://acme.org/fhir/ValueSet/123\\$45")
 }
 
+/******************************************************************************
+ * REVERSE CHAIN
+ ******************************************************************************/
+
+func (s *SearchPTSuite) TestReverseChainParam(c *C) {
+	r := ParseReverseChainParam("Observation:patient:code", "http://loinc.org|1234-5", "Patient")
+
+	c.Assert(r.Name, Equals, "_has")
+	c.Assert(r.Type, Equals, "Observation")
+	c.Assert(r.Reference, DeepEquals, SearchParameterDictionary["Observation"]["patient"])
+	c.Assert(r.ChainedQuery, DeepEquals, Query{Resource: "Observation", Query: "code=http%3A%2F%2Floinc.org%7C1234-5"})
+
+	chained := r.ChainedQuery.Params()
+	c.Assert(chained, HasLen, 1)
+	c.Assert(chained[0], DeepEquals, ParseTokenParam("http://loinc.org|1234-5", SearchParameterDictionary["Observation"]["code"]))
+
+	// Reverse chains may be chained, too
+	r = ParseReverseChainParam("Encounter:patient:_has:Observation:encounter:code:text", "glucose", "Patient")
+	c.Assert(r.ChainedQuery, DeepEquals, Query{Resource: "Encounter", Query: "_has%3AObservation%3Aencounter%3Acode%3Atext=glucose"})
+	chained = r.ChainedQuery.Params()
+	c.Assert(chained, HasLen, 1)
+	c.Assert(chained[0], FitsTypeOf, &ReverseChainParam{})
+	c.Assert(chained[0].(*ReverseChainParam).ChainedQuery, DeepEquals, Query{Resource: "Observation", Query: "code%3Atext=glucose"})
+}
+
+func (s *SearchPTSuite) TestInvalidReverseChainParamPanics(c *C) {
+	invalid := createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"_has\" content is invalid")
+	for _, chain := range []string{"", "Observation", "Observation:patient", "Foo:patient:code", "Observation:foo:code", "Observation:code:code"} {
+		c.Assert(func() { ParseReverseChainParam(chain, "1234-5", "Patient") }, Panics, invalid, Commentf("chain: %s", chain))
+	}
+
+	// Observation's patient parameter can't refer to an encounter
+	c.Assert(func() { ParseReverseChainParam("Observation:patient:code", "1234-5", "Encounter") }, Panics, invalid)
+}
+
+func (s *SearchPTSuite) TestReverseChainParamReconstitution(c *C) {
+	r := ParseReverseChainParam("Observation:patient:code", "http://loinc.org|1234-5", "Patient")
+	p, v := r.getQueryParamAndValue()
+	c.Assert(p, Equals, "_has:Observation:patient:code")
+	c.Assert(v, Equals, "http://loinc.org|1234-5")
+
+	r = ParseReverseChainParam("Encounter:patient:_has:Observation:encounter:code:text", "glucose", "Patient")
+	p, v = r.getQueryParamAndValue()
+	c.Assert(p, Equals, "_has:Encounter:patient:_has:Observation:encounter:code:text")
+	c.Assert(v, Equals, "glucose")
+}
+
 /******************************************************************************
  * OR
  ******************************************************************************/
@@ -1333,6 +1506,65 @@ func (s *SearchPTSuite) TestOrDateParams(c *C) {
 	}
 }
 
+func (s *SearchPTSuite) TestChoiceCompositeQueryIsParsedCorrectly(c *C) {
+	q := Query{"Observation", "component-code-value-quantity=http://loinc.org|8480-6$gt140"}
+	p := q.Params()
+
+	c.Assert(p, HasLen, 1)
+	c.Assert(p[0], FitsTypeOf, &CompositeParam{})
+	composite := p[0].(*CompositeParam)
+	c.Assert(composite.Name, Equals, "component-code-value-quantity")
+	c.Assert(composite.Composites, DeepEquals, []string{"component-code", "component-value-quantity"})
+	c.Assert(composite.CompositeValues, DeepEquals, []string{"http://loinc.org|8480-6", "gt140"})
+
+	// The dictionary entry is not changed
+	c.Assert(SearchParameterDictionary["Observation"]["component-code-value-[x]"].Composites, DeepEquals, []string{"component-code", "component-value[x]"})
+
+	q = Query{"Observation", "component-code-value-foo=bar$baz"}
+	c.Assert(func() { q.Params() }, Panics, createInvalidSearchError("SEARCH_NONE", "Error: no processable search found for Observation search parameters \"component-code-value-foo\""))
+}
+
+func (s *SearchPTSuite) TestSearchableParams(c *C) {
+	params := SearchableParams("Observation")
+	c.Assert(params["code-value-quantity"].Composites, DeepEquals, []string{"code", "value-quantity"})
+	c.Assert(params["component-code-value-concept"].Composites, DeepEquals, []string{"component-code", "component-value-concept"})
+	c.Assert(params["related"].Composites, DeepEquals, []string{"related-target", "related-type"})
+	c.Assert(params["code"].Type, Equals, "token")
+	_, found := params["code-value-[x]"]
+	c.Assert(found, Equals, false)
+
+	c.Assert(SearchableParams("Patient"), HasLen, len(SearchParameterDictionary["Patient"]))
+}
+
+func (s *SearchPTSuite) TestMissingQueryIsParsedCorrectly(c *C) {
+	q := Query{"Patient", "birthdate:missing=true&name:missing=false"}
+	p := q.Params()
+
+	c.Assert(p, HasLen, 2)
+	for _, param := range p {
+		c.Assert(param, FitsTypeOf, &MissingParam{})
+		missing := param.(*MissingParam)
+		c.Assert(missing.Modifier, Equals, "missing")
+		switch missing.Name {
+		case "birthdate":
+			c.Assert(missing.Type, Equals, "date")
+			c.Assert(missing.Missing, Equals, true)
+		case "name":
+			c.Assert(missing.Type, Equals, "string")
+			c.Assert(missing.Missing, Equals, false)
+		default:
+			c.Fatalf("unexpected parameter %s", missing.Name)
+		}
+	}
+
+	param, value := p[0].getQueryParamAndValue()
+	c.Assert(param, Matches, "(birthdate|name):missing")
+	c.Assert(value, Matches, "true|false")
+
+	q = Query{"Patient", "birthdate:missing=maybe"}
+	c.Assert(func() { q.Params() }, Panics, createInvalidSearchError("MSG_PARAM_INVALID", "Parameter \"birthdate\" content is invalid"))
+}
+
 func (s *SearchPTSuite) TestOrQueryIsParsedCorrectly(c *C) {
 	q := Query{"Condition", "onset=2013-01-02T12:13:14.999-07:00,2013-01-02T12:13:14.999Z,2013-01-02T12:13:14.999&code=foo|bar"}
 	p := q.Params()
@@ -1507,6 +1739,117 @@ func (s *SearchPTSuite) TestNormalizedQueryValue(c *C) {
 	c.Assert(v.Get("gender"), Equals, "M")
 }
 
+func (s *SearchPTSuite) TestParseParamNameModifierAndPostFix(c *C) {
+	for fullParam, expected := range map[string][3]string{
+		"name":                          {"name", "", ""},
+		"name:exact":                    {"name", "exact", ""},
+		"subject.name":                  {"subject", "", "name"},
+		"subject:Patient.name":          {"subject", "Patient", "name"},
+		"subject:Patient.name:exact":    {"subject", "Patient", "name:exact"},
+		"subject.organization.name":     {"subject", "", "organization.name"},
+		"_has:Observation:patient:code": {"_has", "Observation:patient:code", ""},
+	} {
+		param, modifier, postfix := ParseParamNameModifierAndPostFix(fullParam)
+		c.Assert([3]string{param, modifier, postfix}, Equals, expected, Commentf("param: %s", fullParam))
+	}
+}
+
+func (s *SearchPTSuite) TestNormalizedQueryValueWithChains(c *C) {
+	q := Query{Resource: "Patient", Query: "_has%3AObservation%3Apatient%3Acode=1234-5&organization.name=Acme"}
+	v := q.NormalizedQueryValues(false)
+	c.Assert(v, HasLen, 2)
+	c.Assert(v.Get("_has:Observation:patient:code"), Equals, "1234-5")
+	c.Assert(v.Get("organization:Organization.name"), Equals, "Acme")
+}
+
+func (s *SearchPTSuite) TestGlobalParamsAreParsed(c *C) {
+	q := Query{Resource: "Encounter", Query: "_lastUpdated=gt2016-01-01&_tag=http://acme.com/tags|vip&_profile=http://acme.com/profiles/encounter"}
+	params := q.Params()
+	c.Assert(params, HasLen, 3)
+	for _, p := range params {
+		switch p := p.(type) {
+		case *DateParam:
+			c.Assert(p.SearchParamInfo, DeepEquals, SearchParamInfo{
+				Name:   "_lastUpdated",
+				Type:   "date",
+				Paths:  []SearchParamPath{SearchParamPath{Path: "meta.lastUpdated", Type: "instant"}},
+				Prefix: GT,
+			})
+		case *TokenParam:
+			c.Assert(p.Name, Equals, "_tag")
+			c.Assert(p.Paths, DeepEquals, []SearchParamPath{SearchParamPath{Path: "meta.[]tag", Type: "Coding"}})
+			c.Assert(p.System, Equals, "http://acme.com/tags")
+			c.Assert(p.Code, Equals, "vip")
+		case *URIParam:
+			c.Assert(p.Name, Equals, "_profile")
+			c.Assert(p.URI, Equals, "http://acme.com/profiles/encounter")
+		default:
+			c.Errorf("unexpected parameter %#v", p)
+		}
+	}
+
+	// Global parameters are only on known resource types
+	q = Query{Resource: "Foo", Query: "_lastUpdated=2016"}
+	c.Assert(func() { q.Params() }, Panics, createInvalidSearchError("SEARCH_NONE", "Error: no processable search found for Foo search parameters \"_lastUpdated\""))
+}
+
+func (s *SearchPTSuite) TestSortByLastUpdated(c *C) {
+	q := Query{Resource: "Patient", Query: "_sort=-_lastUpdated"}
+	o := q.Options()
+	c.Assert(o.Sort, HasLen, 1)
+	c.Assert(o.Sort[0].Descending, Equals, true)
+	c.Assert(o.Sort[0].Parameter.Name, Equals, "_lastUpdated")
+	c.Assert(sortField(o.Sort[0]), Equals, "meta.lastUpdated.time")
+}
+
+func (s *SearchPTSuite) TestTextSearchParamsAreParsed(c *C) {
+	q := Query{Resource: "MedicationOrder", Query: "_text=metformin,insulin&_content=%22type+2%22"}
+	params := q.Params()
+	c.Assert(params, HasLen, 2)
+	for _, p := range params {
+		t, ok := p.(*TextSearchParam)
+		c.Assert(ok, Equals, true, Commentf("unexpected parameter %#v", p))
+		switch t.Name {
+		case "_text":
+			// Commas don't separate alternatives in a text search
+			c.Assert(t.Text, Equals, "metformin,insulin")
+		case "_content":
+			c.Assert(t.Text, Equals, "\"type 2\"")
+		default:
+			c.Errorf("unexpected parameter %#v", t)
+		}
+	}
+
+	v := q.NormalizedQueryValues(false)
+	c.Assert(v.Get("_text"), Equals, "metformin\\,insulin")
+	reparsed := Query{Resource: "MedicationOrder", Query: v.Encode()}
+	c.Assert(reparsed.Params(), HasLen, 2)
+	for _, p := range reparsed.Params() {
+		if t := p.(*TextSearchParam); t.Name == "_text" {
+			c.Assert(t.Text, Equals, "metformin,insulin")
+		}
+	}
+}
+
+func (s *SearchPTSuite) TestSortByScore(c *C) {
+	q := Query{Resource: "Condition", Query: "_content=metformin&_sort=_score,-onset"}
+	o := q.Options()
+	c.Assert(o.TextSearch, Equals, true)
+	c.Assert(o.Sort, HasLen, 2)
+	c.Assert(o.Sort[0].Parameter.Name, Equals, "_score")
+	c.Assert(o.Sort[1].Parameter.Name, Equals, "onset")
+	c.Assert(o.QueryValues().Get(SortParam), Equals, "_score,-onset")
+
+	q = Query{Resource: "Condition", Query: "onset=2012"}
+	c.Assert(q.Options().TextSearch, Equals, false)
+
+	q = Query{Resource: "Condition", Query: "_sort=_score"}
+	c.Assert(func() { q.Options() }, Panics, createInvalidSearchError("MSG_SORT_UNKNOWN", "Sort parameter \"_score\" is only valid for _text and _content searches"))
+
+	q = Query{Resource: "Condition", Query: "_text=metformin&_sort=-_score"}
+	c.Assert(func() { q.Options() }, Panics, createUnsupportedSearchError("MSG_SORT_UNKNOWN", "Sort parameter \"-_score\" is not supported"))
+}
+
 func (s *SearchPTSuite) TestReconstructQueryWithDefaultOptions(c *C) {
 	q := Query{Resource: "Patient", Query: "name%3Aexact=Robert+Smith&gender=M"}
 	v := q.NormalizedQueryValues(true)
@@ -1534,3 +1877,118 @@ func (s *SearchPTSuite) TestQueryOptionsQueryValues(c *C) {
 	c.Assert(v.Get(CountParam), Equals, "123")
 	c.Assert(v.Get(OffsetParam), Equals, "456")
 }
+
+func (s *SearchPTSuite) TestSortOptions(c *C) {
+	q := Query{Resource: "Patient", Query: "_sort=family,-birthdate"}
+	o := q.Options()
+	c.Assert(o.Sort, HasLen, 2)
+	c.Assert(o.Sort[0].Descending, Equals, false)
+	c.Assert(o.Sort[0].Parameter.Name, Equals, "family")
+	c.Assert(o.Sort[1].Descending, Equals, true)
+	c.Assert(o.Sort[1].Parameter.Name, Equals, "birthdate")
+	c.Assert(o.QueryValues().Get(SortParam), Equals, "family,-birthdate")
+}
+
+func (s *SearchPTSuite) TestReconstructQueryWithSort(c *C) {
+	q := Query{Resource: "Patient", Query: "gender=M&_sort=-name"}
+	v := q.NormalizedQueryValues(true)
+	c.Assert(v, HasLen, 4)
+	c.Assert(v.Get(SortParam), Equals, "-name")
+}
+
+func (s *SearchPTSuite) TestInvalidSortOption(c *C) {
+	for _, query := range []string{"_sort=foo", "_sort=", "_sort=name,"} {
+		q := Query{Resource: "Patient", Query: query}
+		c.Assert(func() { q.Options() }, PanicMatches, "Unknown sort parameter.*", Commentf("query: %s", query))
+	}
+}
+
+func (s *SearchPTSuite) TestIncludeOptions(c *C) {
+	q := Query{Resource: "Encounter", Query: "_include=Encounter:patient&_revinclude=Condition:encounter&_include:iterate=Patient:organization:Organization"}
+	o := q.Options()
+	c.Assert(o.Include, HasLen, 2)
+	c.Assert(o.RevInclude, HasLen, 1)
+	c.Assert(o.RevInclude[0].Resource, Equals, "Condition")
+	c.Assert(o.RevInclude[0].Parameter.Name, Equals, "encounter")
+
+	v := o.QueryValues()
+	c.Assert(v.Get(IncludeParam), Equals, "Encounter:patient")
+	c.Assert(v.Get(IncludeParam+":iterate"), Equals, "Patient:organization:Organization")
+	c.Assert(v.Get(RevIncludeParam), Equals, "Condition:encounter")
+
+	q = Query{Resource: "Encounter", Query: "_include=Encounter:patient&_include=Encounter:location"}
+	c.Assert(q.NormalizedQueryValues(true)[IncludeParam], DeepEquals, []string{"Encounter:patient", "Encounter:location"})
+}
+
+func (s *SearchPTSuite) TestInvalidIncludeOptions(c *C) {
+	for _, query := range []string{
+		"_include=Encounter",
+		"_include=Encounter:foo",
+		"_include=Encounter:status",
+		"_include=Encounter:patient:Group",
+		"_include=Condition:patient",
+		"_revinclude=Condition:asserter",
+		"_include:recurse=Encounter:patient",
+	} {
+		q := Query{Resource: "Encounter", Query: query}
+		c.Assert(func() { q.Options() }, PanicMatches, ".*Parameter \"_(rev)?include\".*", Commentf("query: %s", query))
+	}
+}
+
+func (s *SearchPTSuite) TestSummaryAndElementsOptions(c *C) {
+	q := Query{Resource: "Patient", Query: "_summary=text&_elements=name,+gender"}
+	o := q.Options()
+	c.Assert(o.Summary, Equals, "text")
+	c.Assert(o.Elements, DeepEquals, []string{"name", "gender"})
+	c.Assert(o.IsSubsetted(), Equals, true)
+
+	v := o.QueryValues()
+	c.Assert(v.Get(SummaryParam), Equals, "text")
+	c.Assert(v.Get(ElementsParam), Equals, "name,gender")
+
+	for _, summary := range []string{"count", "false"} {
+		q = Query{Resource: "Patient", Query: "_summary=" + summary}
+		c.Assert(q.Options().IsSubsetted(), Equals, false)
+	}
+}
+
+func (s *SearchPTSuite) TestInvalidSummaryAndElementsOptions(c *C) {
+	for _, query := range []string{"_summary=foo", "_elements=", "_elements=name,,gender", "_elements=foo",
+		"_elements=$where", "_elements=name..family", "_elements=name.family", "_elements=-"} {
+		q := Query{Resource: "Patient", Query: query}
+		c.Assert(func() { q.Options() }, PanicMatches, ".*content is invalid.*", Commentf("query: %s", query))
+	}
+}
+
+func (s *SearchPTSuite) TestIsValidDate(c *C) {
+	for _, date := range []string{"2013", "2013-01", "2013-01-02", "2013-01-02T12:13", "2013-01-02T12:13:14.567Z", " 2013-01-02T12:13:14-05:00 "} {
+		c.Assert(IsValidDate(date), Equals, true, Commentf("date: %s", date))
+	}
+	for _, date := range []string{"", "yesterday", "13-01-02", "2013-13", "2013-01-02foo", "2013-01-02T12"} {
+		c.Assert(IsValidDate(date), Equals, false, Commentf("date: %s", date))
+	}
+}
+
+func (s *SearchPTSuite) TestStartsAfterAndEndsBeforePrefixes(c *C) {
+	x, y := ExtractPrefixAndValue("sa10")
+	c.Assert(x, Equals, SA)
+	c.Assert(y, Equals, "10")
+
+	x, y = ExtractPrefixAndValue("eb10")
+	c.Assert(x, Equals, EB)
+	c.Assert(y, Equals, "10")
+}
+
+func (s *SearchPTSuite) TestNumberApproxRange(c *C) {
+	n := ParseNumber("100")
+	c.Assert(n.ApproxRangeLowIncl().FloatString(1), Equals, "90.0")
+	c.Assert(n.ApproxRangeHighIncl().FloatString(1), Equals, "110.0")
+
+	// The range is never narrower than the precision of the number
+	n = ParseNumber("-1.0")
+	c.Assert(n.ApproxRangeLowIncl().FloatString(2), Equals, "-1.10")
+	c.Assert(n.ApproxRangeHighIncl().FloatString(2), Equals, "-0.90")
+	n = ParseNumber("0")
+	c.Assert(n.ApproxRangeLowIncl().FloatString(1), Equals, "-0.5")
+	c.Assert(n.ApproxRangeHighIncl().FloatString(1), Equals, "0.5")
+}
@@ -0,0 +1,102 @@
+package search
+
+// summaryElements lists the top-level elements of each resource type that are
+// summary elements (isSummary) in the FHIR DSTU2 resource definitions, not
+// counting the id, meta and implicitRules elements that every summary
+// contains.  Choice elements are listed under each of their typed names, e.g.
+// deceasedBoolean and deceasedDateTime for Patient.deceased[x].
+var summaryElements = map[string][]string{
+	"Account":                    []string{"identifier", "name", "type", "status", "activePeriod", "currency", "balance", "coveragePeriod", "subject", "owner", "description"},
+	"AllergyIntolerance":         []string{"identifier", "onset", "recordedDate", "recorder", "patient", "reporter", "substance", "status", "criticality", "type", "category", "lastOccurence"},
+	"Appointment":                []string{"identifier", "status", "type", "reason", "start", "end", "participant"},
+	"AppointmentResponse":        []string{"identifier", "appointment", "start", "end", "participantType", "actor", "participantStatus"},
+	"AuditEvent":                 []string{"event", "participant", "source", "object"},
+	"Basic":                      []string{"identifier", "code", "subject", "author", "created"},
+	"Binary":                     []string{"contentType"},
+	"BodySite":                   []string{"patient", "identifier", "code"},
+	"Bundle":                     []string{"type", "total", "link", "entry", "signature"},
+	"CarePlan":                   []string{"identifier", "subject", "status", "context", "period", "author", "modified", "category", "description", "addresses"},
+	"Claim":                      []string{"type", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "use", "priority", "fundsReserve", "enterer", "facility", "prescription", "originalPrescription", "payee", "referral", "diagnosis", "condition", "patient", "coverage", "exception", "school", "accident", "accidentType", "interventionException", "item", "additionalMaterials", "missingTeeth"},
+	"ClaimResponse":              []string{"identifier", "request", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization", "outcome", "disposition", "payeeType", "item", "addItem", "error", "totalCost", "unallocDeductable", "totalBenefit", "paymentAdjustment", "paymentAdjustmentReason", "paymentDate", "paymentAmount", "paymentRef", "reserved", "form", "note", "coverage"},
+	"ClinicalImpression":         []string{"patient", "assessor", "status", "date", "description", "previous", "problem", "triggerCodeableConcept", "triggerReference"},
+	"Communication":              []string{"identifier", "category", "sender", "recipient", "medium", "status", "encounter", "sent", "received", "reason", "subject", "requestDetail"},
+	"CommunicationRequest":       []string{"identifier", "category", "sender", "recipient", "medium", "requester", "status", "encounter", "scheduledDateTime", "scheduledPeriod", "reason", "requestedOn", "subject", "priority"},
+	"Composition":                []string{"identifier", "date", "type", "class", "title", "status", "confidentiality", "subject", "author", "attester", "custodian", "event", "encounter"},
+	"ConceptMap":                 []string{"url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "sourceUri", "sourceReference", "targetUri", "targetReference"},
+	"Condition":                  []string{"identifier", "patient", "encounter", "asserter", "dateRecorded", "code", "category", "clinicalStatus", "verificationStatus", "severity", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "abatementDateTime", "abatementAge", "abatementBoolean", "abatementPeriod", "abatementRange", "abatementString", "stage", "evidence", "bodySite"},
+	"Conformance":                []string{"url", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "kind", "software", "implementation", "fhirVersion", "acceptUnknown", "format", "profile", "rest", "messaging", "document"},
+	"Contract":                   []string{"identifier", "issued", "applies", "subject", "authority", "domain", "type", "subType", "action", "actionReason", "actor", "signer"},
+	"Coverage":                   []string{"issuer", "bin", "period", "type", "subscriberId", "identifier", "group", "plan", "subPlan", "dependent", "sequence", "subscriber", "network", "contract"},
+	"DataElement":                []string{"url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "useContext", "copyright", "stringency", "element"},
+	"DetectedIssue":              []string{"patient", "category", "severity", "implicated", "date", "author", "identifier"},
+	"Device":                     []string{"identifier", "type", "status", "manufacturer", "model", "version", "manufactureDate", "expiry", "udi", "lotNumber", "owner", "location", "patient", "contact", "url"},
+	"DeviceComponent":            []string{"type", "identifier", "lastSystemChange", "source", "parent", "operationalStatus", "parameterGroup", "measurementPrinciple", "productionSpecification", "languageCode"},
+	"DeviceMetric":               []string{"type", "identifier", "unit", "source", "parent", "operationalStatus", "color", "category", "measurementPeriod", "calibration"},
+	"DeviceUseRequest":           []string{"bodySiteCodeableConcept", "bodySiteReference", "status", "device", "encounter", "identifier", "indication", "prnReason", "orderedOn", "recordedOn", "subject", "timingTiming", "timingPeriod", "timingDateTime", "priority"},
+	"DeviceUseStatement":         []string{"bodySiteCodeableConcept", "bodySiteReference", "whenUsed", "device", "identifier", "indication", "recordedOn", "subject", "timingTiming", "timingPeriod", "timingDateTime"},
+	"DiagnosticOrder":            []string{"subject", "orderer", "identifier", "encounter", "reason", "status", "priority", "event", "item"},
+	"DiagnosticReport":           []string{"identifier", "status", "category", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "image"},
+	"DocumentManifest":           []string{"masterIdentifier", "identifier", "subject", "recipient", "type", "author", "created", "source", "status", "description", "content", "related"},
+	"DocumentReference":          []string{"masterIdentifier", "identifier", "subject", "type", "class", "author", "custodian", "authenticator", "created", "indexed", "status", "docStatus", "relatesTo", "description", "securityLabel", "content", "context"},
+	"EligibilityRequest":         []string{"identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization"},
+	"EligibilityResponse":        []string{"identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	"Encounter":                  []string{"identifier", "status", "class", "type", "patient", "episodeOfCare", "incomingReferral", "participant", "appointment", "period", "reason", "indication"},
+	"EnrollmentRequest":          []string{"identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "subject", "coverage", "relationship"},
+	"EnrollmentResponse":         []string{"identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	"EpisodeOfCare":              []string{"identifier", "status", "type", "condition", "patient", "managingOrganization", "period", "referralRequest", "careManager", "careTeam"},
+	"ExplanationOfBenefit":       []string{"identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	"FamilyMemberHistory":        []string{"identifier", "patient", "date", "status", "name", "relationship", "gender", "bornPeriod", "bornDate", "bornString", "ageAge", "ageRange", "ageString", "deceasedBoolean", "deceasedAge", "deceasedRange", "deceasedDate", "deceasedString"},
+	"Flag":                       []string{"identifier", "category", "status", "period", "subject", "encounter", "author", "code"},
+	"Goal":                       []string{"identifier", "subject", "startDate", "startCodeableConcept", "targetDate", "targetDuration", "category", "description", "status", "statusDate", "statusReason", "author", "priority"},
+	"Group":                      []string{"identifier", "type", "actual", "code", "name", "quantity"},
+	"HealthcareService":          []string{"identifier", "providedBy", "serviceCategory", "serviceType", "location", "serviceName", "comment"},
+	"ImagingObjectSelection":     []string{"uid", "patient", "title", "description", "author", "authoringTime"},
+	"ImagingStudy":               []string{"started", "patient", "uid", "accession", "identifier", "order", "modalityList", "referrer", "availability", "url", "numberOfSeries", "numberOfInstances", "procedure", "interpreter", "description"},
+	"Immunization":               []string{"identifier", "status", "date", "vaccineCode", "patient", "wasNotGiven", "reported"},
+	"ImmunizationRecommendation": []string{"identifier", "patient", "recommendation"},
+	"ImplementationGuide":        []string{"url", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "copyright", "fhirVersion", "dependency", "package", "global", "page"},
+	"List":                       []string{"identifier", "title", "code", "subject", "source", "encounter", "status", "date", "mode"},
+	"Location":                   []string{"identifier", "status", "name", "description", "mode", "type", "telecom", "address", "physicalType", "position", "managingOrganization", "partOf"},
+	"Media":                      []string{"type", "subtype", "identifier", "subject", "operator", "view", "deviceName", "height", "width", "frames", "duration"},
+	"Medication":                 []string{"code", "isBrand", "manufacturer"},
+	"MedicationAdministration":   []string{"identifier", "status", "patient", "practitioner", "encounter", "prescription", "wasNotGiven", "effectiveTimeDateTime", "effectiveTimePeriod", "medicationCodeableConcept", "medicationReference", "device"},
+	"MedicationDispense":         []string{"identifier", "status", "patient", "dispenser", "authorizingPrescription", "type", "quantity", "daysSupply", "medicationCodeableConcept", "medicationReference", "whenPrepared", "whenHandedOver", "destination", "receiver"},
+	"MedicationOrder":            []string{"identifier", "dateWritten", "status", "dateEnded", "reasonEnded", "patient", "prescriber", "encounter", "reasonCodeableConcept", "reasonReference", "medicationCodeableConcept", "medicationReference"},
+	"MedicationStatement":        []string{"identifier", "patient", "informationSource", "dateAsserted", "status", "wasNotTaken", "reasonNotTaken", "reasonForUseCodeableConcept", "reasonForUseReference", "effectiveDateTime", "effectivePeriod", "medicationCodeableConcept", "medicationReference"},
+	"MessageHeader":              []string{"timestamp", "event", "response", "source", "destination", "enterer", "author", "receiver", "responsible", "reason", "data"},
+	"NamingSystem":               []string{"name", "status", "kind", "publisher", "contact", "responsible", "date", "type", "description", "useContext", "uniqueId", "replacedBy"},
+	"NutritionOrder":             []string{"patient", "orderer", "identifier", "encounter", "dateTime", "status", "allergyIntolerance", "foodPreferenceModifier", "excludeFoodModifier", "oralDiet", "supplement", "enteralFormula"},
+	"Observation":                []string{"identifier", "status", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "valueQuantity", "valueCodeableConcept", "valueString", "valueRange", "valueRatio", "valueSampledData", "valueAttachment", "valueTime", "valueDateTime", "valuePeriod", "component"},
+	"OperationDefinition":        []string{"url", "version", "name", "status", "kind", "experimental", "publisher", "contact", "date", "idempotent", "code", "base", "system", "type", "instance"},
+	"OperationOutcome":           []string{"issue"},
+	"Order":                      []string{"identifier", "date", "subject", "source", "target", "reasonCodeableConcept", "reasonReference", "when", "detail"},
+	"OrderResponse":              []string{"identifier", "request", "date", "who", "orderStatus", "description", "fulfillment"},
+	"Organization":               []string{"identifier", "active", "type", "name", "telecom", "address", "partOf"},
+	"Patient":                    []string{"identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "animal", "managingOrganization", "link"},
+	"PaymentNotice":              []string{"identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "request", "response", "paymentStatus"},
+	"PaymentReconciliation":      []string{"identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "period", "organization", "requestProvider", "requestOrganization", "detail", "form", "total", "note"},
+	"Person":                     []string{"identifier", "name", "telecom", "gender", "birthDate", "address", "managingOrganization", "active", "link"},
+	"Practitioner":               []string{"identifier", "active", "name", "telecom", "address", "gender", "birthDate"},
+	"Procedure":                  []string{"identifier", "subject", "status", "category", "code", "notPerformed", "reasonNotPerformed", "bodySite", "reasonCodeableConcept", "reasonReference", "performer", "performedDateTime", "performedPeriod", "encounter", "location", "outcome", "report"},
+	"ProcedureRequest":           []string{"identifier", "subject", "code", "bodySite", "reasonCodeableConcept", "reasonReference", "scheduledDateTime", "scheduledPeriod", "scheduledTiming", "encounter", "performer", "status", "asNeededBoolean", "asNeededCodeableConcept", "orderedOn", "orderer", "priority"},
+	"ProcessRequest":             []string{"action", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "request", "response", "nullify", "reference", "item", "include", "exclude", "period"},
+	"ProcessResponse":            []string{"identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization", "form", "notes", "error"},
+	"Provenance":                 []string{"target", "period", "recorded", "reason", "activity", "location", "policy"},
+	"Questionnaire":              []string{"identifier", "version", "status", "date", "publisher", "telecom", "subjectType"},
+	"QuestionnaireResponse":      []string{"identifier", "questionnaire", "status", "subject", "author", "authored", "source", "encounter"},
+	"ReferralRequest":            []string{"status", "identifier", "date", "type", "specialty", "priority", "patient", "requester", "recipient", "encounter", "dateSent", "reason", "serviceRequested", "fulfillmentTime"},
+	"RelatedPerson":              []string{"identifier", "patient", "relationship", "name", "telecom", "gender", "birthDate", "address", "period"},
+	"RiskAssessment":             []string{"subject", "date", "condition", "encounter", "performer", "identifier", "method", "basis"},
+	"Schedule":                   []string{"identifier", "type", "actor", "planningHorizon", "comment"},
+	"SearchParameter":            []string{"url", "name", "status", "experimental", "publisher", "contact", "date", "code", "base", "type", "description", "target"},
+	"Slot":                       []string{"identifier", "type", "schedule", "freeBusyType", "start", "end", "overbooked", "comment"},
+	"Specimen":                   []string{"identifier", "status", "type", "parent", "subject", "accessionIdentifier", "receivedTime"},
+	"StructureDefinition":        []string{"url", "identifier", "version", "name", "display", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "code", "fhirVersion", "kind", "constrainedType", "abstract", "contextType", "context", "base"},
+	"Subscription":               []string{"criteria", "contact", "reason", "status", "error", "channel", "end", "tag"},
+	"Substance":                  []string{"identifier", "category", "code", "description"},
+	"SupplyDelivery":             []string{"identifier", "status", "patient", "type", "quantity", "suppliedItem", "supplier", "whenPrepared", "time", "destination", "receiver"},
+	"SupplyRequest":              []string{"patient", "source", "date", "identifier", "status", "kind", "orderedItem", "supplier", "reasonCodeableConcept", "reasonReference", "when"},
+	"TestScript":                 []string{"url", "version", "name", "status", "identifier", "experimental", "publisher", "contact", "date", "description", "useContext", "multiserver"},
+	"ValueSet":                   []string{"url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "lockedDate", "description", "useContext", "immutable", "extensible"},
+	"VisionPrescription":         []string{"identifier", "dateWritten", "patient", "prescriber", "encounter", "reasonCodeableConcept", "reasonReference"},
+}
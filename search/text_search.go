@@ -0,0 +1,147 @@
+package search
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// The _text and _content parameters search the text index of each resource
+// collection, which covers all of the string content of the resources.  Since
+// a collection can only have one text index, _text searches the same index and
+// then requires the narrative to contain one of the searched words or phrases.
+
+// TextScoreField is the field that the relevance of each result of a text
+// search is selected into (see WithTextScore).
+const TextScoreField = "_score"
+
+// narrativePath is the path to the XHTML of a resource's narrative.
+const narrativePath = "text.div"
+
+// textIndexLanguageField is the field Mongo reads the language of a document's
+// text from.  Resources don't have it: Mongo's default ("language") is an
+// element of many resources, and Mongo can't index documents whose language is
+// a code it doesn't support (e.g., "en-US") or isn't a string.
+const textIndexLanguageField = "textIndexLanguage"
+
+// EnsureTextIndexes creates the text index searched by _text and _content on
+// each resource collection.
+func EnsureTextIndexes(db *mgo.Database) error {
+	for resourceType := range SearchParameterDictionary {
+		index := mgo.Index{
+			Key:              []string{"$text:$**"},
+			Name:             "text",
+			LanguageOverride: textIndexLanguageField,
+		}
+		if err := db.C(models.PluralizeLowerResourceName(resourceType)).EnsureIndex(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A _content search matches the resources whose text index has any of the
+// words, and a _text search also requires their narrative to contain one of
+// the words or phrases.  Since the narrative is XHTML, words are matched
+// anywhere in it rather than by their stems.
+func (m *MongoSearcher) createTextSearchQueryObject(t *TextSearchParam) bson.M {
+	criteria := bson.M{"$text": bson.M{"$search": t.Text}}
+	if t.Name == TextParam {
+		if terms := textSearchTerms(t.Text); len(terms) > 0 {
+			patterns := make([]string, len(terms))
+			for i, term := range terms {
+				patterns[i] = accentInsensitive(term)
+			}
+			criteria[narrativePath] = bson.RegEx{Pattern: strings.Join(patterns, "|"), Options: "i"}
+		}
+	}
+	return criteria
+}
+
+// textSearchTerms returns the words and quoted phrases of a text search that
+// should be found (i.e., not those prefixed with "-").
+func textSearchTerms(text string) []string {
+	var terms []string
+	for i, part := range strings.Split(text, "\"") {
+		// The odd parts are within quotes
+		if i%2 == 1 {
+			if phrase := strings.TrimSpace(part); phrase != "" {
+				terms = append(terms, phrase)
+			}
+			continue
+		}
+		for _, word := range strings.Fields(part) {
+			if !strings.HasPrefix(word, "-") {
+				terms = append(terms, word)
+			}
+		}
+	}
+	return terms
+}
+
+// Mongo only allows one text search in a query, so _text and _content can
+// only be searched on once.
+func panicOnRepeatedTextSearches(params []SearchParam) {
+	found := false
+	for _, p := range params {
+		if _, ok := p.(*TextSearchParam); ok {
+			if found {
+				panic(createUnsupportedSearchError("MSG_PARAM_NO_REPEAT", fmt.Sprintf("Parameters \"%s\" and \"%s\" can only be searched on once", TextParam, ContentParam)))
+			}
+			found = true
+		}
+	}
+}
+
+// WithTextScore returns a copy of the projection that also selects the
+// relevance of each result of a text search into the TextScoreField.  A nil
+// projection (i.e., selecting the whole resource) selects the whole resource
+// and its relevance.
+func WithTextScore(projection bson.M) bson.M {
+	result := bson.M{TextScoreField: bson.M{"$meta": "textScore"}}
+	for field, value := range projection {
+		result[field] = value
+	}
+	return result
+}
+
+// TextScore is the relevance of a result of a text search, as selected by
+// WithTextScore.
+type TextScore struct {
+	ID    string  `bson:"_id"`
+	Score float64 `bson:"_score"`
+}
+
+// AllWithTextScores runs a text search query (whose projection includes the
+// relevance, see WithTextScore), unmarshalling the resources into result, a
+// pointer to a slice of the resource type.  It returns the relevance of each
+// resource, by id.
+func AllWithTextScores(query *mgo.Query, result interface{}) (map[string]float64, error) {
+	resultVal := reflect.ValueOf(result).Elem()
+	scores := make(map[string]float64)
+
+	var raw bson.Raw
+	iter := query.Iter()
+	for iter.Next(&raw) {
+		resource := reflect.New(resultVal.Type().Elem())
+		if err := raw.Unmarshal(resource.Interface()); err != nil {
+			iter.Close()
+			return nil, err
+		}
+		var score TextScore
+		if err := raw.Unmarshal(&score); err != nil {
+			iter.Close()
+			return nil, err
+		}
+		resultVal.Set(reflect.Append(resultVal, resource.Elem()))
+		scores[score.ID] = score.Score
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
@@ -0,0 +1,228 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// The token modifiers "in" and "not-in" match the codes in a stored ValueSet,
+// and "above" and "below" match the codes above or below a code in the
+// hierarchy of a code system.  Code systems are those defined by the stored
+// ValueSets (in ValueSet.codeSystem).
+
+// codeSet is a set of codes, by system.
+type codeSet map[string]map[string]bool
+
+func (s codeSet) add(system string, codes ...string) {
+	if s[system] == nil {
+		s[system] = make(map[string]bool)
+	}
+	for _, code := range codes {
+		s[system][code] = true
+	}
+}
+
+func (s codeSet) remove(system string, codes ...string) {
+	for _, code := range codes {
+		delete(s[system], code)
+	}
+	if len(s[system]) == 0 {
+		delete(s, system)
+	}
+}
+
+// grouped returns the sorted codes of each system.
+func (s codeSet) grouped() map[string][]string {
+	result := make(map[string][]string, len(s))
+	for system, codes := range s {
+		for code := range codes {
+			result[system] = append(result[system], code)
+		}
+		sort.Strings(result[system])
+	}
+	return result
+}
+
+// valueSetCodes returns the codes in the value set identified by the URI (or by
+// a relative reference, e.g. "ValueSet/123"), grouped by system.  The value
+// set's expansion is used if it has one; otherwise the codes are found from the
+// value set's code system and composition.
+func (m *MongoSearcher) valueSetCodes(name, uri string) map[string][]string {
+	return m.expandValueSet(name, m.findValueSet(name, uri), make(map[string]bool)).grouped()
+}
+
+func (m *MongoSearcher) expandValueSet(name string, vs *models.ValueSet, seen map[string]bool) codeSet {
+	codes := make(codeSet)
+
+	// Guard against value sets that (indirectly) import themselves
+	if seen[vs.Id] {
+		return codes
+	}
+	seen[vs.Id] = true
+	defer delete(seen, vs.Id)
+
+	if vs.Expansion != nil {
+		addExpansionCodes(codes, vs.Expansion.Contains)
+		return codes
+	}
+
+	if vs.CodeSystem != nil {
+		codes.add(vs.CodeSystem.System, conceptCodes(vs.CodeSystem.Concept)...)
+	}
+	if vs.Compose != nil {
+		for _, uri := range vs.Compose.Import {
+			for system, imported := range m.expandValueSet(name, m.findValueSet(name, uri), seen) {
+				for code := range imported {
+					codes.add(system, code)
+				}
+			}
+		}
+		for _, include := range vs.Compose.Include {
+			codes.add(include.System, m.conceptSetCodes(name, include)...)
+		}
+		for _, exclude := range vs.Compose.Exclude {
+			codes.remove(exclude.System, m.conceptSetCodes(name, exclude)...)
+		}
+	}
+	return codes
+}
+
+func addExpansionCodes(codes codeSet, contains []models.ValueSetExpansionContainsComponent) {
+	for _, c := range contains {
+		if c.Code != "" {
+			codes.add(c.System, c.Code)
+		}
+		addExpansionCodes(codes, c.Contains)
+	}
+}
+
+// conceptSetCodes returns the codes included (or excluded) by a part of a value
+// set's composition: either the listed concepts, the concepts of the code
+// system matching all of the filters, or all of the concepts of the code system.
+// The only supported filter is "concept is-a code".
+func (m *MongoSearcher) conceptSetCodes(name string, set models.ValueSetConceptSetComponent) []string {
+	if len(set.Concept) > 0 {
+		codes := make([]string, len(set.Concept))
+		for i := range set.Concept {
+			codes[i] = set.Concept[i].Code
+		}
+		return codes
+	}
+
+	concepts := m.findCodeSystem(name, set.System)
+	if len(set.Filter) == 0 {
+		return conceptCodes(concepts)
+	}
+
+	var codes []string
+	for i, filter := range set.Filter {
+		if filter.Property != "concept" || filter.Op != "is-a" {
+			panic(createUnsupportedSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Value set filter \"%s %s\" is not supported", filter.Property, filter.Op)))
+		}
+		filtered := conceptsBelow(concepts, filter.Value)
+		if i > 0 {
+			filtered = intersect(codes, filtered)
+		}
+		codes = filtered
+	}
+	return codes
+}
+
+// hierarchyCodes returns the code and the codes above (i.e., its ancestors) or
+// below (i.e., its descendants) it in the code system.
+func (m *MongoSearcher) hierarchyCodes(name, system, code string, above bool) map[string][]string {
+	concepts := m.findCodeSystem(name, system)
+
+	codes := make(codeSet)
+	if above {
+		codes.add(system, conceptsAbove(concepts, code)...)
+	} else {
+		codes.add(system, conceptsBelow(concepts, code)...)
+	}
+	return codes.grouped()
+}
+
+// conceptCodes returns the codes of all of the concepts in the tree.
+func conceptCodes(concepts []models.ValueSetConceptDefinitionComponent) []string {
+	var codes []string
+	for _, c := range concepts {
+		codes = append(codes, c.Code)
+		codes = append(codes, conceptCodes(c.Concept)...)
+	}
+	return codes
+}
+
+// conceptsBelow returns the code and the codes of all of its descendants in
+// the tree, or nil if the code is not in the tree.
+func conceptsBelow(concepts []models.ValueSetConceptDefinitionComponent, code string) []string {
+	for _, c := range concepts {
+		if c.Code == code {
+			return append([]string{c.Code}, conceptCodes(c.Concept)...)
+		}
+		if below := conceptsBelow(c.Concept, code); below != nil {
+			return below
+		}
+	}
+	return nil
+}
+
+// conceptsAbove returns the codes of all of the ancestors of the code in the
+// tree and the code itself, or nil if the code is not in the tree.
+func conceptsAbove(concepts []models.ValueSetConceptDefinitionComponent, code string) []string {
+	for _, c := range concepts {
+		if c.Code == code {
+			return []string{c.Code}
+		}
+		if above := conceptsAbove(c.Concept, code); above != nil {
+			return append([]string{c.Code}, above...)
+		}
+	}
+	return nil
+}
+
+func intersect(a, b []string) []string {
+	inA := make(map[string]bool, len(a))
+	for _, s := range a {
+		inA[s] = true
+	}
+	var result []string
+	for _, s := range b {
+		if inA[s] {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func (m *MongoSearcher) findValueSet(name, uri string) *models.ValueSet {
+	c := m.db.C(models.PluralizeLowerResourceName("ValueSet"))
+	vs := &models.ValueSet{}
+	err := c.Find(bson.M{"url": uri}).One(vs)
+	if err == mgo.ErrNotFound && strings.HasPrefix(uri, "ValueSet/") {
+		err = c.FindId(strings.TrimPrefix(uri, "ValueSet/")).One(vs)
+	}
+	if err == mgo.ErrNotFound {
+		panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" refers to an unknown value set \"%s\"", name, uri)))
+	} else if err != nil {
+		panic(createInternalServerError("MSG_DB_ERROR", err.Error()))
+	}
+	return vs
+}
+
+// findCodeSystem returns the concepts of the code system, as defined by a
+// stored value set.
+func (m *MongoSearcher) findCodeSystem(name, system string) []models.ValueSetConceptDefinitionComponent {
+	vs := &models.ValueSet{}
+	err := m.db.C(models.PluralizeLowerResourceName("ValueSet")).Find(bson.M{"codeSystem.system": system}).One(vs)
+	if err == mgo.ErrNotFound {
+		panic(createInvalidSearchError("MSG_PARAM_INVALID", fmt.Sprintf("Parameter \"%s\" refers to an unknown code system \"%s\"", name, system)))
+	} else if err != nil {
+		panic(createInternalServerError("MSG_DB_ERROR", err.Error()))
+	}
+	return vs.CodeSystem.Concept
+}
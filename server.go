@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"net/http"
 
@@ -8,8 +9,16 @@ import (
 )
 
 func main() {
+	migrateDates := flag.Bool("migrate-dates", false, "migrate the dates of stored resources so they can be searched, then exit")
+	flag.Parse()
+
 	s := server.NewServer("localhost")
 
+	if *migrateDates {
+		s.MigrateDateRanges()
+		return
+	}
+
 	s.Run()
 }
 
@@ -20,25 +20,31 @@ func BatchHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc
 	bundle := &models.Bundle{}
 	err := decoder.Decode(&bundle)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, decodeError("Bundle", err))
+		return
 	}
 
 	// TODO: If type is batch, ensure there are no interdendent resources
 
 	entries := make([]*models.BundleEntryComponent, len(bundle.Entry))
 	for i := range bundle.Entry {
+		location := fmt.Sprintf("Bundle.entry[%d]", i)
 		if bundle.Entry[i].Request == nil {
-			// TODO: Use correct response code
-			http.Error(rw, "Entries in a batch operation require a request", http.StatusInternalServerError)
+			sendError(rw, NewError(http.StatusBadRequest, "error", "required",
+				"Entries in a batch operation require a request", location+".request"))
+			return
 		} else if bundle.Entry[i].Request.Method != "POST" {
-			// TODO: Use correct response code
-			http.Error(rw, "Only POST requests are currently supported", http.StatusInternalServerError)
+			sendError(rw, NewError(http.StatusUnprocessableEntity, "error", "not-supported",
+				"Only POST requests are currently supported", location+".request.method"))
+			return
 		} else if strings.Contains(bundle.Entry[i].Request.Url, "/") {
-			// TODO: Use correct response code
-			http.Error(rw, "Updating resources is not currently allowed", http.StatusInternalServerError)
+			sendError(rw, NewError(http.StatusUnprocessableEntity, "error", "not-supported",
+				"Updating resources is not currently allowed", location+".request.url"))
+			return
 		} else if bundle.Entry[i].Resource == nil {
-			// TODO: Use correct response code
-			http.Error(rw, "Batch POST must have a resource body", http.StatusInternalServerError)
+			sendError(rw, NewError(http.StatusBadRequest, "error", "required",
+				"Batch POST must have a resource body", location+".resource"))
+			return
 		}
 		entries[i] = &bundle.Entry[i]
 	}
@@ -49,35 +55,77 @@ func BatchHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc
 	// Create a map containing references that can be looked up by passed in FullURL.  This allows the
 	// existing references to be updated to new references (using newly assigned IDs).
 	refMap := make(map[string]models.Reference)
+	existing := make(map[*models.BundleEntryComponent]bool)
 	for _, entry := range entries {
-		id := bson.NewObjectId()
+		var id string
+		if entry.Request.IfNoneExist != "" {
+			// Conditional create: reuse the matching resource instead of creating a new one
+			matches, err := findMatches(entry.Request.Url, entry.Request.IfNoneExist, 2)
+			if err != nil {
+				sendError(rw, err)
+				return
+			}
+			if len(matches) > 1 {
+				sendError(rw, NewError(http.StatusPreconditionFailed, "error", "duplicate",
+					fmt.Sprintf("Multiple %s resources match the If-None-Exist criteria \"%s\"", entry.Request.Url, entry.Request.IfNoneExist)))
+				return
+			}
+			if len(matches) == 1 {
+				entry.Resource = matches[0]
+				id = reflect.ValueOf(entry.Resource).Elem().FieldByName("Id").String()
+				existing[entry] = true
+			}
+		}
+		if id == "" {
+			id = bson.NewObjectId().Hex()
+		}
 		refMap[entry.FullUrl] = models.Reference{
-			Reference:    fmt.Sprintf("%s/%s", entry.Request.Url, id.Hex()),
+			Reference:    fmt.Sprintf("%s/%s", entry.Request.Url, id),
 			Type:         entry.Request.Url,
-			ReferencedID: id.Hex(),
+			ReferencedID: id,
 			External:     new(bool),
 		}
 		// Update the entry with the new FullURL and Id
-		entry.FullUrl = responseURL(r, entry.Request.Url, id.Hex()).String()
-		reflect.ValueOf(entry.Resource).Elem().FieldByName("Id").SetString(id.Hex())
+		entry.FullUrl = responseURL(r, entry.Request.Url, id).String()
+		reflect.ValueOf(entry.Resource).Elem().FieldByName("Id").SetString(id)
 	}
 	// Update all the references to the entries (to reflect newly assigned IDs)
 	updateAllReferences(entries, refMap)
 
 	// Then store all of the resources in the database and update the entry response
 	for _, entry := range entries {
+		if existing[entry] {
+			entry.Request = nil
+			entry.Response = &models.BundleEntryResponseComponent{
+				Status:   "200",
+				Location: entry.FullUrl,
+			}
+			if version := resourceVersion(entry.Resource); version != "" {
+				entry.Response.Etag = versionETag(version)
+			}
+			continue
+		}
+
 		c := Database.C(models.PluralizeLowerResourceName(entry.Request.Url))
+		id := reflect.ValueOf(entry.Resource).Elem().FieldByName("Id").String()
+		now := time.Now()
+		setVersionMeta(entry.Resource, 1, now)
 		err = c.Insert(entry.Resource)
+		if err == nil {
+			err = saveVersion(entry.Request.Url, id, 1, "POST", entry.Resource, now)
+		}
 		if err != nil {
-			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			sendError(rw, err)
+			return
 		}
 
 		entry.Request = nil
 		entry.Response = &models.BundleEntryResponseComponent{
 			Status:   "201",
 			Location: entry.FullUrl,
+			Etag:     versionETag("1"),
 			LastModified: &models.FHIRDateTime{
-				Time:      time.Now(),
+				Time:      now,
 				Precision: models.Timestamp,
 			},
 		}
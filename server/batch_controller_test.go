@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -147,6 +148,48 @@ func (s *BatchControllerSuite) TestUploadPatientBundle(c *C) {
 	s.checkReference(c, &responseBundle.Entry[12].Resource.(*models.DiagnosticReport).Result[2], obs2Id, "Observation")
 }
 
+func (s *BatchControllerSuite) TestConditionalCreateInBundle(c *C) {
+	Database.C("patients").DropCollection()
+
+	data, err := os.Open("../fixtures/john_peters_bundle.json")
+	defer data.Close()
+	util.CheckErr(err)
+	decoder := json.NewDecoder(data)
+	requestBundle := &models.Bundle{}
+	err = decoder.Decode(requestBundle)
+	util.CheckErr(err)
+	requestBundle.Entry[0].Request.IfNoneExist = "family=Peters&given=John"
+	body, err := json.Marshal(requestBundle)
+	util.CheckErr(err)
+
+	// The first upload should create the patient, the second should reuse it
+	var patientIds []string
+	for i := 0; i < 2; i++ {
+		res, err := http.Post(s.Server.URL+"/", "application/json", bytes.NewReader(body))
+		util.CheckErr(err)
+		c.Assert(res.StatusCode, Equals, 200)
+
+		responseBundle := &models.Bundle{}
+		err = json.NewDecoder(res.Body).Decode(responseBundle)
+		util.CheckErr(err)
+
+		if i == 0 {
+			c.Assert(responseBundle.Entry[0].Response.Status, Equals, "201")
+		} else {
+			c.Assert(responseBundle.Entry[0].Response.Status, Equals, "200")
+		}
+		c.Assert(responseBundle.Entry[0].Response.Etag, Equals, `W/"1"`)
+		patientId := responseBundle.Entry[0].Resource.(*models.Patient).Id
+		s.checkReference(c, responseBundle.Entry[1].Resource.(*models.Encounter).Patient, patientId, "Patient")
+		patientIds = append(patientIds, patientId)
+	}
+	c.Assert(patientIds[1], Equals, patientIds[0])
+
+	count, err := Database.C("patients").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 1)
+}
+
 func (s *BatchControllerSuite) checkReference(c *C, ref *models.Reference, id string, typ string) {
 	c.Assert(ref.ReferencedID, Equals, id)
 	c.Assert(ref.Type, Equals, typ)
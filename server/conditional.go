@@ -0,0 +1,45 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+)
+
+// findMatches runs a FHIR search (e.g., "identifier=http://acme.org|123")
+// against the resource type and returns pointers to the matching resources.
+// At most max resources are returned, which is all that is needed to tell
+// apart the zero, one and multiple match cases of the conditional
+// interactions.  Invalid or unsupported search criteria are returned as a
+// *search.Error.
+func findMatches(resourceType, criteria string, max int) (matches []interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			searchErr, ok := r.(*search.Error)
+			if !ok {
+				panic(r)
+			}
+			err = searchErr
+		}
+	}()
+
+	// Allow the criteria to be expressed as a relative URL (e.g., "Patient?identifier=123")
+	if i := strings.Index(criteria, "?"); i != -1 {
+		criteria = criteria[i+1:]
+	}
+
+	result := models.NewSliceForResourceName(resourceType, 0, 0)
+	searcher := search.NewMongoSearcher(Database)
+	query := search.Query{Resource: resourceType, Query: criteria}
+	if err = searcher.CreateQueryWithoutOptions(query).Limit(max).All(result); err != nil {
+		return nil, err
+	}
+
+	resultVal := reflect.ValueOf(result).Elem()
+	for i := 0; i < resultVal.Len(); i++ {
+		matches = append(matches, resultVal.Index(i).Addr().Interface())
+	}
+	return matches, nil
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/context"
+	"github.com/gorilla/mux"
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+)
+
+// FHIRVersion is the version of the FHIR specification implemented by the server.
+const FHIRVersion = "1.0.2"
+
+// resourceInteractions maps the method and (normalized) path template of a
+// route to the FHIR interaction it provides on a resource type.
+var resourceInteractions = map[string]string{
+	"GET /{type}/{id}":                "read",
+	"GET /{type}/{id}/_history/{vid}": "vread",
+	"PUT /{type}/{id}":                "update",
+	"DELETE /{type}/{id}":             "delete",
+	"GET /{type}/{id}/_history":       "history-instance",
+	"GET /{type}/_history":            "history-type",
+	"POST /{type}":                    "create",
+	"GET /{type}":                     "search-type",
+}
+
+// interactionOrder is the order in which the spec lists the interactions.
+var interactionOrder = []string{"read", "vread", "update", "delete", "history-instance", "validate", "history-type", "create", "search-type"}
+
+// systemInteractions maps the method and path template of a route to the FHIR
+// interaction it provides on the whole system.
+var systemInteractions = map[string]string{
+	"POST /":        "transaction",
+	"GET /_history": "history-system",
+}
+
+// ConformanceController serves the server's Conformance statement, which
+// must be built (using BuildConformance) once all of the routes are registered.
+type ConformanceController struct {
+	Conformance *models.Conformance
+}
+
+// ConformanceHandler serves the Conformance statement (i.e., GET /metadata and
+// OPTIONS /).
+func (cc *ConformanceController) ConformanceHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	conformance := *cc.Conformance
+	conformance.Implementation = &models.ConformanceImplementationComponent{
+		Description: "FHIR server",
+		Url:         strings.TrimSuffix(responseURL(r).String(), "/"),
+	}
+
+	context.Set(r, "Conformance", &conformance)
+	context.Set(r, "Resource", "Conformance")
+	context.Set(r, "Action", "read")
+
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(rw).Encode(&conformance)
+}
+
+// BuildConformance creates a Conformance statement describing the resource
+// types and interactions routed by the router, and the search parameters in
+// search.SearchParameterDictionary and search.GlobalSearchParameterDictionary.
+func BuildConformance(router *mux.Router) *models.Conformance {
+	resources := make(map[string]*models.ConformanceRestResourceComponent)
+	rest := models.ConformanceRestComponent{Mode: "server", Security: &models.ConformanceRestSecurityComponent{Cors: newBool(true)}}
+
+	router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+		resourceType, pattern := normalizePathTemplate(template)
+		for _, method := range methods {
+			key := method + " " + pattern
+			if resourceType == "" {
+				if code, ok := systemInteractions[key]; ok {
+					rest.Interaction = append(rest.Interaction, models.ConformanceSystemInteractionComponent{Code: code})
+				}
+				continue
+			}
+
+			resource, ok := resources[resourceType]
+			if !ok {
+				resource = &models.ConformanceRestResourceComponent{Type: resourceType}
+				resources[resourceType] = resource
+			}
+			switch key {
+			case "PUT /{type}":
+				resource.ConditionalUpdate = newBool(true)
+			case "DELETE /{type}":
+				resource.ConditionalDelete = "single"
+			}
+			if code, ok := resourceInteractions[key]; ok {
+				resource.Interaction = append(resource.Interaction, models.ConformanceResourceInteractionComponent{Code: code})
+				switch code {
+				case "create":
+					resource.ConditionalCreate = newBool(true)
+				case "update":
+					resource.UpdateCreate = newBool(true)
+				case "vread":
+					resource.Versioning = "versioned"
+					resource.ReadHistory = newBool(true)
+				}
+			}
+		}
+		return nil
+	})
+
+	var names []string
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resource := resources[name]
+		sort.Sort(byInteractionOrder(resource.Interaction))
+		if hasInteraction(resource.Interaction, "search-type") {
+			resource.SearchParam = conformanceSearchParams(search.SearchableParams(name))
+			resource.SearchInclude, resource.SearchRevInclude = conformanceIncludes(name)
+		}
+		rest.Resource = append(rest.Resource, *resource)
+	}
+	rest.SearchParam = conformanceSearchParams(search.GlobalSearchParameterDictionary)
+
+	return &models.Conformance{
+		Name:          "FHIR Server Conformance Statement",
+		Status:        "active",
+		Publisher:     "Not provided",
+		Date:          &models.FHIRDateTime{Time: time.Now(), Precision: models.Timestamp},
+		Kind:          "instance",
+		Software:      &models.ConformanceSoftwareComponent{Name: "Intervention Engine FHIR Server"},
+		FhirVersion:   FHIRVersion,
+		AcceptUnknown: "no",
+		Format:        []string{"application/json+fhir", "json"},
+		Rest:          []models.ConformanceRestComponent{rest},
+	}
+}
+
+// normalizePathTemplate splits a route's path template (e.g., "/Patient/{id}")
+// into the resource type and a pattern matching the keys of
+// resourceInteractions (e.g., "/{type}/{id}").  The resource type is empty for
+// system-level routes.
+func normalizePathTemplate(template string) (resourceType string, pattern string) {
+	segments := strings.Split(strings.Trim(template, "/"), "/")
+	if first := segments[0]; first != "" && !strings.HasPrefix(first, "_") && !strings.HasPrefix(first, "{") && first != "metadata" {
+		resourceType = first
+		segments[0] = "{type}"
+	}
+	return resourceType, "/" + strings.Join(segments, "/")
+}
+
+func conformanceSearchParams(dictionary map[string]search.SearchParamInfo) []models.ConformanceRestResourceSearchParamComponent {
+	var names []string
+	for name := range dictionary {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]models.ConformanceRestResourceSearchParamComponent, 0, len(names))
+	for _, name := range names {
+		info := dictionary[name]
+		param := models.ConformanceRestResourceSearchParamComponent{
+			Name:     info.Name,
+			Type:     info.Type,
+			Modifier: search.SupportedModifiers[info.Type],
+		}
+		// Text searches are string parameters, but don't support their modifiers
+		if name == search.TextParam || name == search.ContentParam {
+			param.Modifier = nil
+		}
+		for _, target := range info.Targets {
+			if target != "Any" {
+				param.Target = append(param.Target, target)
+			}
+		}
+		params = append(params, param)
+	}
+	return params
+}
+
+// conformanceIncludes returns the values of _include and _revinclude that are
+// supported when searching on the resource type.
+func conformanceIncludes(resourceType string) (includes []string, revIncludes []string) {
+	for sourceType, params := range search.SearchParameterDictionary {
+		for name, info := range params {
+			if info.Type != "reference" {
+				continue
+			}
+			if sourceType == resourceType {
+				includes = append(includes, sourceType+":"+name)
+			}
+			for _, target := range info.Targets {
+				if target == resourceType || target == "Any" {
+					revIncludes = append(revIncludes, sourceType+":"+name)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(includes)
+	sort.Strings(revIncludes)
+	return includes, revIncludes
+}
+
+func hasInteraction(interactions []models.ConformanceResourceInteractionComponent, code string) bool {
+	for _, interaction := range interactions {
+		if interaction.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func newBool(b bool) *bool {
+	return &b
+}
+
+type byInteractionOrder []models.ConformanceResourceInteractionComponent
+
+func (a byInteractionOrder) Len() int {
+	return len(a)
+}
+func (a byInteractionOrder) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+func (a byInteractionOrder) Less(i, j int) bool {
+	return interactionIndex(a[i].Code) < interactionIndex(a[j].Code)
+}
+
+func interactionIndex(code string) int {
+	for i, c := range interactionOrder {
+		if c == code {
+			return i
+		}
+	}
+	return len(interactionOrder)
+}
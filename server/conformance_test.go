@@ -0,0 +1,148 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/codegangsta/negroni"
+	"github.com/gorilla/mux"
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+	"github.com/pebbe/util"
+	. "gopkg.in/check.v1"
+)
+
+type ConformanceSuite struct {
+	Server *httptest.Server
+}
+
+var _ = Suite(&ConformanceSuite{})
+
+func (s *ConformanceSuite) SetUpSuite(c *C) {
+	router := mux.NewRouter()
+	router.StrictSlash(true)
+	router.KeepContext = true
+	RegisterRoutes(router, make(map[string][]negroni.Handler))
+	s.Server = httptest.NewServer(router)
+}
+
+func (s *ConformanceSuite) TearDownSuite(c *C) {
+	s.Server.Close()
+}
+
+func (s *ConformanceSuite) TestGetMetadata(c *C) {
+	res, err := http.Get(s.Server.URL + "/metadata")
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	conformance := s.decode(c, res)
+
+	c.Assert(conformance.Kind, Equals, "instance")
+	c.Assert(conformance.FhirVersion, Equals, FHIRVersion)
+	c.Assert(conformance.Implementation.Url, Equals, s.Server.URL)
+	c.Assert(conformance.Rest, HasLen, 1)
+
+	rest := conformance.Rest[0]
+	c.Assert(rest.Mode, Equals, "server")
+	var systemInteractions []string
+	for _, interaction := range rest.Interaction {
+		systemInteractions = append(systemInteractions, interaction.Code)
+	}
+	c.Assert(systemInteractions, DeepEquals, []string{"transaction", "history-system"})
+	c.Assert(rest.Resource, HasLen, len(search.SearchParameterDictionary))
+
+	patient := s.findResource(c, rest, "Patient")
+	var interactions []string
+	for _, interaction := range patient.Interaction {
+		interactions = append(interactions, interaction.Code)
+	}
+	c.Assert(interactions, DeepEquals, []string{"read", "vread", "update", "delete", "history-instance", "history-type", "create", "search-type"})
+	c.Assert(patient.Versioning, Equals, "versioned")
+	c.Assert(*patient.ReadHistory, Equals, true)
+	c.Assert(*patient.UpdateCreate, Equals, true)
+	c.Assert(*patient.ConditionalCreate, Equals, true)
+	c.Assert(*patient.ConditionalUpdate, Equals, true)
+	c.Assert(patient.ConditionalDelete, Equals, "single")
+
+	c.Assert(patient.SearchInclude, DeepEquals, []string{"Patient:careprovider", "Patient:link", "Patient:organization"})
+	c.Assert(patient.SearchRevInclude, Not(HasLen), 0)
+	c.Assert(patient.SearchParam, HasLen, len(search.SearchParameterDictionary["Patient"]))
+	for _, param := range patient.SearchParam {
+		switch param.Name {
+		case "gender":
+			c.Assert(param.Type, Equals, "token")
+		case "organization":
+			c.Assert(param.Type, Equals, "reference")
+			c.Assert(param.Target, DeepEquals, []string{"Organization"})
+			c.Assert(param.Modifier, DeepEquals, []string{"missing", "type", "identifier"})
+		}
+	}
+
+	// Composite parameters are advertised too
+	observation := s.findResource(c, rest, "Observation")
+	observationParams := make(map[string]string)
+	for _, param := range observation.SearchParam {
+		observationParams[param.Name] = param.Type
+	}
+	c.Assert(observationParams["code-value-quantity"], Equals, "composite")
+	c.Assert(observationParams["component-code-value-concept"], Equals, "composite")
+	c.Assert(observationParams["related"], Equals, "composite")
+	_, found := observationParams["code-value-[x]"]
+	c.Assert(found, Equals, false)
+
+	// The global search parameters apply to every resource type
+	var globalParams []string
+	for _, param := range rest.SearchParam {
+		globalParams = append(globalParams, param.Name+":"+param.Type)
+		if param.Name == "_text" || param.Name == "_content" {
+			c.Assert(param.Modifier, HasLen, 0)
+		}
+	}
+	c.Assert(globalParams, DeepEquals, []string{"_content:string", "_lastUpdated:date", "_profile:uri", "_security:token", "_tag:token", "_text:string"})
+}
+
+func (s *ConformanceSuite) TestOptionsRoot(c *C) {
+	req, err := http.NewRequest("OPTIONS", s.Server.URL+"/", nil)
+	util.CheckErr(err)
+	res, err := http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	conformance := s.decode(c, res)
+	c.Assert(conformance.Rest[0].Resource, Not(HasLen), 0)
+}
+
+func (s *ConformanceSuite) TestConformanceReflectsRoutes(c *C) {
+	// Only the routes that are registered should be reported
+	router := mux.NewRouter()
+	controller := ResourceController{"Patient"}
+	router.Path("/Patient").Methods("GET").Handler(negroni.New(negroni.HandlerFunc(controller.IndexHandler)))
+	router.Path("/Patient/{id}").Methods("GET").Handler(negroni.New(negroni.HandlerFunc(controller.ShowHandler)))
+
+	conformance := BuildConformance(router)
+	c.Assert(conformance.Rest[0].Interaction, HasLen, 0)
+	c.Assert(conformance.Rest[0].Resource, HasLen, 1)
+	patient := conformance.Rest[0].Resource[0]
+	c.Assert(patient.Interaction, DeepEquals, []models.ConformanceResourceInteractionComponent{
+		models.ConformanceResourceInteractionComponent{Code: "read"},
+		models.ConformanceResourceInteractionComponent{Code: "search-type"},
+	})
+	c.Assert(patient.ConditionalCreate, IsNil)
+	c.Assert(patient.Versioning, Equals, "")
+}
+
+func (s *ConformanceSuite) findResource(c *C, rest models.ConformanceRestComponent, resourceType string) models.ConformanceRestResourceComponent {
+	for _, resource := range rest.Resource {
+		if resource.Type == resourceType {
+			return resource
+		}
+	}
+	c.Fatalf("No %s resource in the conformance statement", resourceType)
+	return models.ConformanceRestResourceComponent{}
+}
+
+func (s *ConformanceSuite) decode(c *C, res *http.Response) *models.Conformance {
+	conformance := &models.Conformance{}
+	err := json.NewDecoder(res.Body).Decode(conformance)
+	util.CheckErr(err)
+	return conformance
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+)
+
+// Error is an error that occurred while handling a request, providing the HTTP
+// status and the OperationOutcome that should be sent to the client.  It plays
+// the same role for the CRUD interactions that search.Error plays for search.
+type Error struct {
+	HTTPStatus       int
+	OperationOutcome *models.OperationOutcome
+}
+
+// Error returns the diagnostics of the first issue in the operation outcome.
+func (e *Error) Error() string {
+	if e.OperationOutcome != nil && len(e.OperationOutcome.Issue) > 0 && e.OperationOutcome.Issue[0].Diagnostics != "" {
+		return e.OperationOutcome.Issue[0].Diagnostics
+	}
+	return http.StatusText(e.HTTPStatus)
+}
+
+// NewError creates an Error whose OperationOutcome has a single issue.  The
+// location, if given, is the path of the element the issue relates to (e.g.,
+// "Patient.id").
+func NewError(status int, severity, code, diagnostics string, location ...string) *Error {
+	return &Error{
+		HTTPStatus: status,
+		OperationOutcome: &models.OperationOutcome{
+			Issue: []models.OperationOutcomeIssueComponent{
+				models.OperationOutcomeIssueComponent{
+					Severity:    severity,
+					Code:        code,
+					Diagnostics: diagnostics,
+					Location:    location,
+				},
+			},
+		},
+	}
+}
+
+func invalidIDError(resourceType, id string) *Error {
+	return NewError(http.StatusBadRequest, "error", "value",
+		fmt.Sprintf("Invalid id: \"%s\"", id), resourceType+".id")
+}
+
+func notFoundError(resourceType, id string) *Error {
+	return NewError(http.StatusNotFound, "error", "not-found",
+		fmt.Sprintf("%s/%s not found", resourceType, id))
+}
+
+func goneError(resourceType, id string) *Error {
+	return NewError(http.StatusGone, "error", "deleted",
+		fmt.Sprintf("%s/%s has been deleted", resourceType, id))
+}
+
+func internalError(err error) *Error {
+	return NewError(http.StatusInternalServerError, "fatal", "exception", err.Error())
+}
+
+// decodeError reports a request body that could not be decoded as a resource.
+// Malformed JSON is a 400 Bad Request, while JSON that does not fit the
+// resource's structure is a 422 Unprocessable Entity.
+func decodeError(resourceType string, err error) *Error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return NewError(http.StatusBadRequest, "error", "structure",
+			fmt.Sprintf("Invalid JSON at offset %d: %s", e.Offset, e.Error()))
+	case *json.UnmarshalTypeError:
+		location := resourceType
+		if e.Field != "" {
+			location = resourceType + "." + e.Field
+		}
+		return NewError(http.StatusUnprocessableEntity, "error", "structure",
+			fmt.Sprintf("Invalid %s: cannot use %s as %s", location, e.Value, e.Type), location)
+	default:
+		return NewError(http.StatusBadRequest, "error", "structure", err.Error())
+	}
+}
+
+// decodeResource decodes the request body as a resource of the given type.
+func decodeResource(r *http.Request, resourceType string) (interface{}, error) {
+	resource := models.NewStructForResourceName(resourceType)
+	if err := json.NewDecoder(r.Body).Decode(resource); err != nil {
+		return nil, decodeError(resourceType, err)
+	}
+	return resource, nil
+}
+
+// sendError writes the error as an OperationOutcome, using the HTTP status of
+// the error.  Errors other than *Error and *search.Error are reported as 500
+// Internal Server Error.
+func sendError(rw http.ResponseWriter, err error) {
+	switch e := err.(type) {
+	case *Error:
+		sendOutcome(rw, e.HTTPStatus, e.OperationOutcome)
+	case *search.Error:
+		sendOutcome(rw, e.HTTPStatus, e.OperationOutcome)
+	default:
+		e2 := internalError(err)
+		sendOutcome(rw, e2.HTTPStatus, e2.OperationOutcome)
+	}
+}
+
+func sendOutcome(rw http.ResponseWriter, status int, outcome *models.OperationOutcome) {
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(outcome)
+}
+
+// MethodNotAllowedHandler responds with 405 Method Not Allowed, listing the
+// methods that are supported on the path in the Allow header.
+func MethodNotAllowedHandler(allowed ...string) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		rw.Header().Set("Allow", strings.Join(allowed, ", "))
+		sendError(rw, NewError(http.StatusMethodNotAllowed, "error", "not-supported",
+			fmt.Sprintf("%s is not supported on %s", r.Method, r.URL.Path)))
+	})
+}
@@ -0,0 +1,342 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/context"
+	"github.com/gorilla/mux"
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// SinceParam is the history parameter used to only return versions created at
+// or after the given instant.
+const SinceParam = "_since"
+
+// The history collection holds an immutable copy of every version of every
+// resource.  The resource collections only hold the current version.
+const historyCollectionName = "history"
+
+// historyEntry is a single version of a resource, as stored in the history
+// collection.  Deletes are recorded as entries without a resource.
+type historyEntry struct {
+	ID           string    `bson:"_id"`
+	ResourceType string    `bson:"resourceType"`
+	ResourceID   string    `bson:"resourceId"`
+	Version      int       `bson:"version"`
+	Method       string    `bson:"method"`
+	LastUpdated  time.Time `bson:"lastUpdated"`
+	Resource     bson.Raw  `bson:"resource,omitempty"`
+}
+
+func historyEntryID(resourceType, id string, version int) string {
+	return fmt.Sprintf("%s/%s/_history/%d", resourceType, id, version)
+}
+
+// EnsureHistoryIndexes creates the indexes needed to efficiently serve the
+// history interactions.
+func EnsureHistoryIndexes(db *mgo.Database) error {
+	c := db.C(historyCollectionName)
+	if err := c.EnsureIndexKey("resourceType", "resourceId", "-version"); err != nil {
+		return err
+	}
+	return c.EnsureIndexKey("-lastUpdated")
+}
+
+// latestVersion returns the most recent history entry of the identified
+// resource, or mgo.ErrNotFound if the resource has no history.
+func latestVersion(resourceType, id string) (*historyEntry, error) {
+	var latest historyEntry
+	err := Database.C(historyCollectionName).
+		Find(bson.M{"resourceType": resourceType, "resourceId": id}).
+		Sort("-version").
+		One(&latest)
+	if err != nil {
+		return nil, err
+	}
+	return &latest, nil
+}
+
+// nextVersion returns the number of the version that follows the given current
+// version of the identified resource.  Resources that have no current version
+// (e.g., those stored before they were versioned, or that were deleted) follow
+// their latest history entry.
+func nextVersion(resourceType, id, current string) (int, error) {
+	if current != "" {
+		version, err := strconv.Atoi(current)
+		return version + 1, err
+	}
+	latest, err := latestVersion(resourceType, id)
+	if err == mgo.ErrNotFound {
+		return 1, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return latest.Version + 1, nil
+}
+
+// setVersionMeta populates Meta.versionId and Meta.lastUpdated on the resource,
+// keeping any other meta information (profiles, tags, etc.) intact.
+func setVersionMeta(resource interface{}, version int, lastUpdated time.Time) {
+	metaVal := reflect.ValueOf(resource).Elem().FieldByName("Meta")
+	meta, _ := metaVal.Interface().(*models.Meta)
+	if meta == nil {
+		meta = &models.Meta{}
+		metaVal.Set(reflect.ValueOf(meta))
+	}
+	meta.VersionId = strconv.Itoa(version)
+	meta.LastUpdated = &models.FHIRDateTime{Time: lastUpdated, Precision: models.Timestamp}
+}
+
+// resourceVersion returns the Meta.versionId of the resource, or an empty
+// string if the resource has no version.
+func resourceVersion(resource interface{}) string {
+	meta, _ := reflect.ValueOf(resource).Elem().FieldByName("Meta").Interface().(*models.Meta)
+	if meta == nil {
+		return ""
+	}
+	return meta.VersionId
+}
+
+// versionETag returns the weak ETag identifying a version (e.g., W/"3").
+func versionETag(version string) string {
+	return fmt.Sprintf("W/\"%s\"", version)
+}
+
+// versionFromETag returns the version id identified by an ETag, accepting both
+// weak (W/"3") and strong ("3") forms.
+func versionFromETag(etag string) string {
+	etag = strings.TrimPrefix(strings.TrimSpace(etag), "W/")
+	return strings.Trim(etag, "\"")
+}
+
+// setETag sets the ETag header to the version of the resource, if it has one.
+func setETag(rw http.ResponseWriter, resource interface{}) {
+	if version := resourceVersion(resource); version != "" {
+		rw.Header().Set("ETag", versionETag(version))
+	}
+}
+
+// saveVersion records a version of a resource in the history collection.  The
+// resource should be nil when recording a delete.  Each version can only be
+// recorded once, so saving a version before changing the resource claims the
+// version: a concurrent change saving the same version fails with a duplicate
+// key error.
+func saveVersion(resourceType, id string, version int, method string, resource interface{}, lastUpdated time.Time) error {
+	entry := historyEntry{
+		ID:           historyEntryID(resourceType, id, version),
+		ResourceType: resourceType,
+		ResourceID:   id,
+		Version:      version,
+		Method:       method,
+		LastUpdated:  lastUpdated,
+	}
+	if resource != nil {
+		data, err := bson.Marshal(resource)
+		if err != nil {
+			return err
+		}
+		entry.Resource = bson.Raw{Kind: 0x03, Data: data}
+	}
+	return Database.C(historyCollectionName).Insert(&entry)
+}
+
+// discardVersion removes a version that was saved for a change that then
+// failed, so that the next change can have the version.
+func discardVersion(resourceType, id string, version int) error {
+	return Database.C(historyCollectionName).RemoveId(historyEntryID(resourceType, id, version))
+}
+
+func (h *historyEntry) resource() (interface{}, error) {
+	resource := models.NewStructForResourceName(h.ResourceType)
+	if err := h.Resource.Unmarshal(resource); err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// TypeHistoryHandler serves the history of all instances of the resource type.
+func (rc *ResourceController) TypeHistoryHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	context.Set(r, "Resource", rc.Name)
+	context.Set(r, "Action", "history")
+	serveHistory(rw, r, bson.M{"resourceType": rc.Name}, responseURL(r, rc.Name, "_history"))
+}
+
+// InstanceHistoryHandler serves the history of a single resource instance.
+func (rc *ResourceController) InstanceHistoryHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		sendError(rw, invalidIDError(rc.Name, id))
+		return
+	}
+
+	// Resources that never existed have no history (unlike deleted resources)
+	_, exists, err := rc.currentVersion(id)
+	if err == nil && !exists {
+		if _, err = latestVersion(rc.Name, id); err == mgo.ErrNotFound {
+			err = notFoundError(rc.Name, id)
+		}
+	}
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	context.Set(r, "Resource", rc.Name)
+	context.Set(r, "Action", "history")
+	serveHistory(rw, r, bson.M{"resourceType": rc.Name, "resourceId": id}, responseURL(r, rc.Name, id, "_history"))
+}
+
+// VersionReadHandler serves a specific version of a resource (vread).
+func (rc *ResourceController) VersionReadHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	vars := mux.Vars(r)
+	if !IsValidID(vars["id"]) {
+		sendError(rw, invalidIDError(rc.Name, vars["id"]))
+		return
+	}
+	notFound := NewError(http.StatusNotFound, "error", "not-found",
+		fmt.Sprintf("%s/%s/_history/%s not found", rc.Name, vars["id"], vars["vid"]))
+	version, err := strconv.Atoi(vars["vid"])
+	if err != nil {
+		sendError(rw, notFound)
+		return
+	}
+
+	var entry historyEntry
+	err = Database.C(historyCollectionName).FindId(historyEntryID(rc.Name, vars["id"], version)).One(&entry)
+	if err == mgo.ErrNotFound {
+		sendError(rw, notFound)
+		return
+	} else if err != nil {
+		sendError(rw, err)
+		return
+	}
+	if entry.Method == "DELETE" {
+		sendError(rw, NewError(http.StatusGone, "error", "deleted",
+			fmt.Sprintf("%s/%s was deleted in version %d", rc.Name, vars["id"], version)))
+		return
+	}
+
+	resource, err := entry.resource()
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	context.Set(r, rc.Name, resource)
+	context.Set(r, "Resource", rc.Name)
+	context.Set(r, "Action", "vread")
+
+	setETag(rw, resource)
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(rw).Encode(resource)
+}
+
+// SystemHistoryHandler serves the history of all resources on the server.
+func SystemHistoryHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	context.Set(r, "Resource", "Bundle")
+	context.Set(r, "Action", "history")
+	serveHistory(rw, r, bson.M{}, responseURL(r, "_history"))
+}
+
+func serveHistory(rw http.ResponseWriter, r *http.Request, selector bson.M, baseURL *url.URL) {
+	values := r.URL.Query()
+	linkValues := url.Values{}
+
+	options := search.NewQueryOptions()
+	if count, err := strconv.Atoi(values.Get(search.CountParam)); err == nil && count >= 0 {
+		options.Count = count
+	}
+	if offset, err := strconv.Atoi(values.Get(search.OffsetParam)); err == nil && offset >= 0 {
+		options.Offset = offset
+	}
+	if since := values.Get(SinceParam); since != "" {
+		if !search.IsValidDate(since) {
+			sendError(rw, NewError(http.StatusBadRequest, "error", "value",
+				fmt.Sprintf("Parameter \"%s\" content is invalid: \"%s\" is not an instant", SinceParam, since), "http."+SinceParam))
+			return
+		}
+		selector["lastUpdated"] = bson.M{"$gte": search.ParseDate(since).RangeLowIncl()}
+		linkValues.Set(SinceParam, since)
+	}
+
+	c := Database.C(historyCollectionName)
+	var entries []historyEntry
+	err := c.Find(selector).Sort("-lastUpdated", "-version").Skip(options.Offset).Limit(options.Count).All(&entries)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	intTotal, err := c.Find(selector).Count()
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	total := uint32(intTotal)
+
+	var bundle models.Bundle
+	bundle.Id = bson.NewObjectId().Hex()
+	bundle.Type = "history"
+	bundle.Total = &total
+	bundle.Link = pagingLinks(options, total, offsetLinkFunc(baseURL, linkValues))
+	for i := range entries {
+		entry, err := historyBundleEntry(r, &entries[i])
+		if err != nil {
+			sendError(rw, err)
+			return
+		}
+		bundle.Entry = append(bundle.Entry, entry)
+	}
+
+	context.Set(r, "Bundle", &bundle)
+
+	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	json.NewEncoder(rw).Encode(&bundle)
+}
+
+func historyBundleEntry(r *http.Request, h *historyEntry) (models.BundleEntryComponent, error) {
+	entry := models.BundleEntryComponent{
+		FullUrl: responseURL(r, h.ResourceType, h.ResourceID).String(),
+		Request: &models.BundleEntryRequestComponent{
+			Method: h.Method,
+			Url:    fmt.Sprintf("%s/%s", h.ResourceType, h.ResourceID),
+		},
+		Response: &models.BundleEntryResponseComponent{
+			Location: responseURL(r, h.ResourceType, h.ResourceID, "_history", strconv.Itoa(h.Version)).String(),
+			LastModified: &models.FHIRDateTime{
+				Time:      h.LastUpdated,
+				Precision: models.Timestamp,
+			},
+		},
+	}
+
+	switch h.Method {
+	case "POST":
+		entry.Request.Url = h.ResourceType
+		entry.Response.Status = "201"
+	case "DELETE":
+		entry.Response.Status = "204"
+	default:
+		entry.Response.Status = "200"
+	}
+
+	if h.Method != "DELETE" {
+		resource, err := h.resource()
+		if err != nil {
+			return entry, err
+		}
+		entry.Resource = resource
+	}
+	return entry, nil
+}
@@ -0,0 +1,246 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONPatchContentType is the media type of RFC 6902 JSON Patch documents.
+const JSONPatchContentType = "application/json-patch+json"
+
+// patchOperation is a single operation in an RFC 6902 JSON Patch document.
+// Value holds the raw JSON of the value member, so a null value ("null") can be
+// told apart from a missing one (empty).
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// patchError indicates that a JSON Patch document is well-formed but could not
+// be applied (e.g., a path does not exist or a test operation failed).
+type patchError struct {
+	msg string
+}
+
+func (e *patchError) Error() string {
+	return e.msg
+}
+
+func newPatchError(format string, args ...interface{}) *patchError {
+	return &patchError{fmt.Sprintf(format, args...)}
+}
+
+// decodePatch parses an RFC 6902 JSON Patch document and checks that each
+// operation has the members it requires.
+func decodePatch(data []byte) ([]patchOperation, error) {
+	var ops []patchOperation
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return nil, err
+	}
+	for i, op := range ops {
+		switch op.Op {
+		case "add", "replace", "test":
+			if len(op.Value) == 0 {
+				return nil, fmt.Errorf("Operation %d (%s) is missing a value", i, op.Op)
+			}
+		case "move", "copy":
+			if _, err := parsePointer(op.From); err != nil {
+				return nil, err
+			}
+		case "remove":
+		default:
+			return nil, fmt.Errorf("Operation %d has an unsupported op: \"%s\"", i, op.Op)
+		}
+		if _, err := parsePointer(op.Path); err != nil {
+			return nil, err
+		}
+	}
+	return ops, nil
+}
+
+// applyPatch applies the operations, in order, to a JSON document that has been
+// decoded into generic maps and slices.  The patched document is returned.
+func applyPatch(doc interface{}, ops []patchOperation) (interface{}, error) {
+	var err error
+	for _, op := range ops {
+		path, _ := parsePointer(op.Path)
+		switch op.Op {
+		case "add":
+			doc, err = patchAdd(doc, path, decodePatchValue(op.Value))
+		case "remove":
+			doc, _, err = patchRemove(doc, path)
+		case "replace":
+			if doc, _, err = patchRemove(doc, path); err == nil {
+				doc, err = patchAdd(doc, path, decodePatchValue(op.Value))
+			}
+		case "move":
+			from, _ := parsePointer(op.From)
+			var value interface{}
+			if doc, value, err = patchRemove(doc, from); err == nil {
+				doc, err = patchAdd(doc, path, value)
+			}
+		case "copy":
+			from, _ := parsePointer(op.From)
+			var value interface{}
+			if value, err = patchGet(doc, from); err == nil {
+				doc, err = patchAdd(doc, path, deepCopyJSON(value))
+			}
+		case "test":
+			var value interface{}
+			if value, err = patchGet(doc, path); err == nil && !reflect.DeepEqual(value, decodePatchValue(op.Value)) {
+				err = newPatchError("Test failed: value at %s does not match", op.Path)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return doc, nil
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its unescaped tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("Invalid JSON pointer: \"%s\"", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(tokens[i], "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+func decodePatchValue(raw json.RawMessage) interface{} {
+	var value interface{}
+	json.Unmarshal(raw, &value)
+	return value
+}
+
+func deepCopyJSON(value interface{}) interface{} {
+	data, _ := json.Marshal(value)
+	var copied interface{}
+	json.Unmarshal(data, &copied)
+	return copied
+}
+
+func patchGet(doc interface{}, path []string) (interface{}, error) {
+	for i, token := range path {
+		switch container := doc.(type) {
+		case map[string]interface{}:
+			value, ok := container[token]
+			if !ok {
+				return nil, newPatchError("Path not found: /%s", strings.Join(path[:i+1], "/"))
+			}
+			doc = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+			doc = container[index]
+		default:
+			return nil, newPatchError("Path not found: /%s", strings.Join(path[:i+1], "/"))
+		}
+	}
+	return doc, nil
+}
+
+// patchAdd adds the value at the path, returning the (possibly new) document.
+// Slices are re-allocated on insert, so the parent of the target is updated.
+func patchAdd(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := patchGet(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch container := parent.(type) {
+	case map[string]interface{}:
+		container[last] = value
+		return doc, nil
+	case []interface{}:
+		index := len(container)
+		if last != "-" {
+			if index, err = arrayIndex(last, len(container)); err != nil {
+				return nil, err
+			}
+		}
+		updated := append(container[:index:index], append([]interface{}{value}, container[index:]...)...)
+		return patchSet(doc, path[:len(path)-1], updated)
+	default:
+		return nil, newPatchError("Cannot add to a non-container at /%s", strings.Join(path, "/"))
+	}
+}
+
+// patchSet replaces the existing value at the path.
+func patchSet(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := patchGet(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	switch container := parent.(type) {
+	case map[string]interface{}:
+		container[path[len(path)-1]] = value
+	case []interface{}:
+		index, err := arrayIndex(path[len(path)-1], len(container)-1)
+		if err != nil {
+			return nil, err
+		}
+		container[index] = value
+	}
+	return doc, nil
+}
+
+// patchRemove removes the value at the path, returning the (possibly new)
+// document and the value that was removed.
+func patchRemove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, doc, nil
+	}
+	parent, err := patchGet(doc, path[:len(path)-1])
+	if err != nil {
+		return nil, nil, err
+	}
+	last := path[len(path)-1]
+	switch container := parent.(type) {
+	case map[string]interface{}:
+		value, ok := container[last]
+		if !ok {
+			return nil, nil, newPatchError("Path not found: /%s", strings.Join(path, "/"))
+		}
+		delete(container, last)
+		return doc, value, nil
+	case []interface{}:
+		index, err := arrayIndex(last, len(container)-1)
+		if err != nil {
+			return nil, nil, err
+		}
+		value := container[index]
+		updated := append(container[:index:index], container[index+1:]...)
+		doc, err = patchSet(doc, path[:len(path)-1], updated)
+		return doc, value, err
+	default:
+		return nil, nil, newPatchError("Path not found: /%s", strings.Join(path, "/"))
+	}
+}
+
+func arrayIndex(token string, max int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > max || (len(token) > 1 && token[0] == '0') {
+		return 0, newPatchError("Invalid array index: %s", token)
+	}
+	return index, nil
+}
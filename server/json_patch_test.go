@@ -0,0 +1,81 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/pebbe/util"
+	. "gopkg.in/check.v1"
+)
+
+type JSONPatchSuite struct {
+}
+
+var _ = Suite(&JSONPatchSuite{})
+
+func (s *JSONPatchSuite) TestApplyPatch(c *C) {
+	doc := s.patch(c, `{"status": "booked", "participant": [{"actor": "a"}, {"actor": "b"}], "a/b": {"~c": 1}}`, `[
+		{"op": "replace", "path": "/status", "value": "cancelled"},
+		{"op": "add", "path": "/participant/1", "value": {"actor": "c"}},
+		{"op": "remove", "path": "/participant/0"},
+		{"op": "copy", "from": "/participant/0", "path": "/participant/-"},
+		{"op": "move", "from": "/a~1b/~0c", "path": "/priority"},
+		{"op": "test", "path": "/priority", "value": 1}
+	]`)
+	expected := s.decode(c, `{"status": "cancelled", "participant": [{"actor": "c"}, {"actor": "b"}, {"actor": "c"}], "a/b": {}, "priority": 1}`)
+	c.Assert(doc, DeepEquals, expected)
+}
+
+func (s *JSONPatchSuite) TestApplyNullValues(c *C) {
+	doc := s.patch(c, `{"status": "booked", "comment": "late"}`, `[
+		{"op": "add", "path": "/priority", "value": null},
+		{"op": "test", "path": "/priority", "value": null},
+		{"op": "replace", "path": "/comment", "value": null}
+	]`)
+	expected := s.decode(c, `{"status": "booked", "priority": null, "comment": null}`)
+	c.Assert(doc, DeepEquals, expected)
+}
+
+func (s *JSONPatchSuite) TestApplyPatchErrors(c *C) {
+	for _, patch := range []string{
+		`[{"op": "test", "path": "/status", "value": "arrived"}]`,
+		`[{"op": "remove", "path": "/missing"}]`,
+		`[{"op": "replace", "path": "/participant/5", "value": {}}]`,
+		`[{"op": "add", "path": "/participant/01", "value": {}}]`,
+		`[{"op": "add", "path": "/status/code", "value": "x"}]`,
+	} {
+		ops, err := decodePatch([]byte(patch))
+		util.CheckErr(err)
+		_, err = applyPatch(s.decode(c, `{"status": "booked", "participant": [{"actor": "a"}]}`), ops)
+		_, ok := err.(*patchError)
+		c.Assert(ok, Equals, true, Commentf("patch: %s", patch))
+	}
+}
+
+func (s *JSONPatchSuite) TestDecodeInvalidPatch(c *C) {
+	for _, patch := range []string{
+		`{"op": "remove", "path": "/status"}`,
+		`[{"op": "jump", "path": "/status"}]`,
+		`[{"op": "add", "path": "/status"}]`,
+		`[{"op": "test", "path": "/status"}]`,
+		`[{"op": "remove", "path": "status"}]`,
+		`[{"op": "move", "from": "status", "path": "/code"}]`,
+	} {
+		_, err := decodePatch([]byte(patch))
+		c.Assert(err, NotNil, Commentf("patch: %s", patch))
+	}
+}
+
+func (s *JSONPatchSuite) patch(c *C, doc string, patch string) interface{} {
+	ops, err := decodePatch([]byte(patch))
+	util.CheckErr(err)
+	result, err := applyPatch(s.decode(c, doc), ops)
+	util.CheckErr(err)
+	return result
+}
+
+func (s *JSONPatchSuite) decode(c *C, doc string) interface{} {
+	var result interface{}
+	err := json.Unmarshal([]byte(doc), &result)
+	util.CheckErr(err)
+	return result
+}
@@ -0,0 +1,273 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/intervention-engine/fhir/models"
+	"github.com/intervention-engine/fhir/search"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// SearchSnapshotExpiry is how long the results of a search that spans more
+// than one page are kept, and therefore how long its page links remain valid.
+var SearchSnapshotExpiry = 30 * time.Minute
+
+// SearchSnapshotLimit is the maximum number of results of a search.  The
+// results of a search that spans more than one page are stored in a snapshot,
+// so searches with more results are refused as too costly.
+var SearchSnapshotLimit = 10000
+
+// Search results are paged through a snapshot of the ids of the matching
+// resources, in order, so that pages don't change (or skip or repeat
+// resources) when the data changes between requests.  The ids are stored in
+// chunks, so that storing a snapshot only takes a few writes.
+const (
+	snapshotCollectionName      = "searchsnapshots"
+	snapshotChunkCollectionName = "searchsnapshotchunks"
+)
+
+// snapshotChunkSize is the number of results in each chunk of a snapshot.
+const snapshotChunkSize = 1000
+
+// searchSnapshot describes the stored results of a search.  The query is kept
+// so that options such as _include and _elements apply to every page.
+type searchSnapshot struct {
+	ID           bson.ObjectId `bson:"_id"`
+	ResourceType string        `bson:"resourceType"`
+	Query        string        `bson:"query"`
+	Total        int           `bson:"total"`
+	Expires      time.Time     `bson:"expires"`
+}
+
+// snapshotChunk is a run of the results of a stored search, starting at the
+// given index, with their relevance if the search was a text search.
+type snapshotChunk struct {
+	Snapshot bson.ObjectId `bson:"snapshot"`
+	Start    int           `bson:"start"`
+	IDs      []string      `bson:"ids"`
+	Scores   []float64     `bson:"scores,omitempty"`
+	Expires  time.Time     `bson:"expires"`
+}
+
+// searchResults are the ids of the resources matching a search, in order, and
+// their relevance if the search was a text search.
+type searchResults struct {
+	IDs    []string
+	Scores []float64
+}
+
+// EnsurePagingIndexes creates the indexes needed to efficiently serve pages of
+// search results, and to remove the snapshots once they have expired.
+func EnsurePagingIndexes(db *mgo.Database) error {
+	expiry := mgo.Index{Key: []string{"expires"}, ExpireAfter: time.Second}
+	if err := db.C(snapshotCollectionName).EnsureIndex(expiry); err != nil {
+		return err
+	}
+	chunks := db.C(snapshotChunkCollectionName)
+	if err := chunks.EnsureIndex(expiry); err != nil {
+		return err
+	}
+	return chunks.EnsureIndexKey("snapshot", "start")
+}
+
+// findResults runs the query, returning the ids of the matching resources (and
+// their relevance if the query is a text search).  Searches matching more than
+// SearchSnapshotLimit resources are refused.
+func findResults(searcher *search.MongoSearcher, query search.Query) (*searchResults, error) {
+	textSearch := query.Options().TextSearch
+	selector := bson.M{"_id": 1}
+	if textSearch {
+		selector = search.WithTextScore(selector)
+	}
+
+	results := &searchResults{}
+	var result search.TextScore
+	iter := searcher.CreateQueryWithoutPaging(query).Select(selector).Limit(SearchSnapshotLimit + 1).Iter()
+	for iter.Next(&result) {
+		results.IDs = append(results.IDs, result.ID)
+		if textSearch {
+			results.Scores = append(results.Scores, result.Score)
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	if len(results.IDs) > SearchSnapshotLimit {
+		return nil, NewError(http.StatusForbidden, "error", "too-costly",
+			fmt.Sprintf("Search on %s matches more than %d resources; please refine the search", query.Resource, SearchSnapshotLimit))
+	}
+	return results, nil
+}
+
+// page returns the results on the page with the offset and count.
+func (r *searchResults) page(offset, count int) *searchResults {
+	start, end := offset, offset+count
+	if start > len(r.IDs) {
+		start = len(r.IDs)
+	}
+	if end > len(r.IDs) {
+		end = len(r.IDs)
+	}
+	page := &searchResults{IDs: r.IDs[start:end]}
+	if r.Scores != nil {
+		page.Scores = r.Scores[start:end]
+	}
+	return page
+}
+
+// resources loads the resources of the given type with the ids of the results,
+// in order, selecting only the fields in the projection (if it is not nil).  It
+// returns a pointer to a slice of the resource type, and the relevance of the
+// resources by id if the search was a text search.  Resources that were deleted
+// since the search are left out.
+func (r *searchResults) resources(resourceType string, projection bson.M) (interface{}, map[string]float64, error) {
+	scores := make(map[string]float64)
+	for i, score := range r.Scores {
+		scores[r.IDs[i]] = score
+	}
+
+	found := models.NewSliceForResourceName(resourceType, 0, 0)
+	query := Database.C(models.PluralizeLowerResourceName(resourceType)).Find(bson.M{"_id": bson.M{"$in": r.IDs}})
+	if projection != nil {
+		query = query.Select(projection)
+	}
+	if err := query.All(found); err != nil {
+		return nil, nil, err
+	}
+
+	// Put the resources back in the order of the results
+	foundVal := reflect.ValueOf(found).Elem()
+	byID := make(map[string]reflect.Value, foundVal.Len())
+	for i := 0; i < foundVal.Len(); i++ {
+		byID[foundVal.Index(i).FieldByName("Id").String()] = foundVal.Index(i)
+	}
+	result := models.NewSliceForResourceName(resourceType, 0, len(r.IDs))
+	resultVal := reflect.ValueOf(result).Elem()
+	for _, id := range r.IDs {
+		if resource, ok := byID[id]; ok {
+			resultVal.Set(reflect.Append(resultVal, resource))
+		}
+	}
+	return result, scores, nil
+}
+
+// createSnapshot stores the results of the query, so that every page of them
+// can be served from the snapshot.
+func createSnapshot(query search.Query, results *searchResults) (*searchSnapshot, error) {
+	snapshot := &searchSnapshot{
+		ID:           bson.NewObjectId(),
+		ResourceType: query.Resource,
+		Query:        query.Query,
+		Total:        len(results.IDs),
+		Expires:      time.Now().Add(SearchSnapshotExpiry),
+	}
+
+	var chunks []interface{}
+	for start := 0; start < snapshot.Total; start += snapshotChunkSize {
+		chunk := results.page(start, snapshotChunkSize)
+		chunks = append(chunks, snapshotChunk{
+			Snapshot: snapshot.ID,
+			Start:    start,
+			IDs:      chunk.IDs,
+			Scores:   chunk.Scores,
+			Expires:  snapshot.Expires,
+		})
+	}
+	if len(chunks) > 0 {
+		if err := Database.C(snapshotChunkCollectionName).Insert(chunks...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := Database.C(snapshotCollectionName).Insert(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// loadSnapshot loads the snapshot of a search on the resource type.  Unknown
+// and expired snapshots are reported as 410 Gone, since their page links were
+// once valid.
+func loadSnapshot(resourceType string, id bson.ObjectId) (*searchSnapshot, error) {
+	snapshot := &searchSnapshot{}
+	err := Database.C(snapshotCollectionName).FindId(id).One(snapshot)
+	if err != nil && err != mgo.ErrNotFound {
+		return nil, internalError(err)
+	}
+	if err == mgo.ErrNotFound || snapshot.ResourceType != resourceType || time.Now().After(snapshot.Expires) {
+		return nil, NewError(http.StatusGone, "error", "expired",
+			"The search results have expired; please repeat the search", "http."+search.PageParam)
+	}
+	return snapshot, nil
+}
+
+// page loads the results on the page of the snapshot with the offset and count.
+func (s *searchSnapshot) page(offset, count int) (*searchResults, error) {
+	first := offset - offset%snapshotChunkSize
+	var chunks []snapshotChunk
+	err := Database.C(snapshotChunkCollectionName).
+		Find(bson.M{"snapshot": s.ID, "start": bson.M{"$gte": first, "$lt": offset + count}}).
+		Sort("start").All(&chunks)
+	if err != nil {
+		return nil, err
+	}
+	results := &searchResults{}
+	for _, chunk := range chunks {
+		results.IDs = append(results.IDs, chunk.IDs...)
+		results.Scores = append(results.Scores, chunk.Scores...)
+	}
+	return results.page(offset-first, count), nil
+}
+
+// linkFunc returns a function creating paging links to pages of the snapshot.
+func (s *searchSnapshot) linkFunc(baseURL *url.URL) linkFunc {
+	return func(relation string, count uint32, offset uint32) models.BundleLinkComponent {
+		values := url.Values{}
+		values.Set(search.PageParam, encodePageToken(pageToken{Snapshot: s.ID, Offset: int(offset), Count: int(count)}))
+		linkURL := *baseURL
+		linkURL.RawQuery = values.Encode()
+		return models.BundleLinkComponent{Relation: relation, Url: linkURL.String()}
+	}
+}
+
+// pageToken identifies a page of a search snapshot.  It is sent to clients
+// as an opaque string.
+type pageToken struct {
+	Snapshot bson.ObjectId
+	Offset   int
+	Count    int
+}
+
+func encodePageToken(t pageToken) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s.%d.%d", t.Snapshot.Hex(), t.Offset, t.Count)))
+}
+
+func decodePageToken(token string) (pageToken, error) {
+	invalid := NewError(http.StatusBadRequest, "error", "value", "Invalid page token", "http."+search.PageParam)
+
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken{}, invalid
+	}
+	parts := strings.Split(string(decoded), ".")
+	if len(parts) != 3 || !bson.IsObjectIdHex(parts[0]) {
+		return pageToken{}, invalid
+	}
+	offset, err := strconv.Atoi(parts[1])
+	if err != nil || offset < 0 {
+		return pageToken{}, invalid
+	}
+	count, err := strconv.Atoi(parts[2])
+	if err != nil || count <= 0 {
+		return pageToken{}, invalid
+	}
+	return pageToken{Snapshot: bson.ObjectIdHex(parts[0]), Offset: offset, Count: count}, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"net/url"
+
+	"github.com/intervention-engine/fhir/search"
+	"github.com/pebbe/util"
+	. "gopkg.in/check.v1"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type PagingSuite struct {
+}
+
+var _ = Suite(&PagingSuite{})
+
+func (s *PagingSuite) TestPageToken(c *C) {
+	token := pageToken{Snapshot: bson.NewObjectId(), Offset: 20, Count: 10}
+	decoded, err := decodePageToken(encodePageToken(token))
+	util.CheckErr(err)
+	c.Assert(decoded, DeepEquals, token)
+}
+
+func (s *PagingSuite) TestInvalidPageToken(c *C) {
+	for _, token := range []string{
+		"foo",
+		encodePageToken(pageToken{Snapshot: bson.NewObjectId(), Offset: -1, Count: 10}),
+		encodePageToken(pageToken{Snapshot: bson.NewObjectId(), Offset: 0, Count: 0}),
+		"NTgwZGQ0YjAuMjAuMTA",
+	} {
+		_, err := decodePageToken(token)
+		c.Assert(err, NotNil, Commentf("token: %s", token))
+	}
+}
+
+func (s *PagingSuite) TestSnapshotPagingLinks(c *C) {
+	snapshot := &searchSnapshot{ID: bson.NewObjectId(), ResourceType: "Patient"}
+	baseURL := &url.URL{Scheme: "http", Host: "localhost", Path: "/Patient"}
+	links := pagingLinks(&search.QueryOptions{Count: 10, Offset: 10}, 25, snapshot.linkFunc(baseURL))
+	c.Assert(links, HasLen, 5)
+
+	expected := []pageToken{
+		pageToken{Snapshot: snapshot.ID, Offset: 10, Count: 10},
+		pageToken{Snapshot: snapshot.ID, Offset: 0, Count: 10},
+		pageToken{Snapshot: snapshot.ID, Offset: 0, Count: 10},
+		pageToken{Snapshot: snapshot.ID, Offset: 20, Count: 10},
+		pageToken{Snapshot: snapshot.ID, Offset: 20, Count: 10},
+	}
+	for i, link := range links {
+		linkURL, err := url.Parse(link.Url)
+		util.CheckErr(err)
+		c.Assert(linkURL.Path, Equals, "/Patient")
+		c.Assert(linkURL.Query(), HasLen, 1)
+		page, err := decodePageToken(linkURL.Query().Get(search.PageParam))
+		util.CheckErr(err)
+		c.Assert(page, DeepEquals, expected[i], Commentf("link: %s", link.Relation))
+	}
+}
+
+func (s *PagingSuite) TestPagingLinksWithEmptyPages(c *C) {
+	baseURL := &url.URL{Scheme: "http", Host: "localhost", Path: "/Patient"}
+	links := pagingLinks(&search.QueryOptions{Count: 0, Offset: 0}, 25, offsetLinkFunc(baseURL, url.Values{}))
+	c.Assert(links, HasLen, 3)
+	c.Assert(links[2].Relation, Equals, "last")
+}
+
+func (s *PagingSuite) TestSearchResultsPage(c *C) {
+	results := &searchResults{IDs: []string{"a", "b", "c", "d", "e"}, Scores: []float64{5, 4, 3, 2, 1}}
+	c.Assert(results.page(1, 2), DeepEquals, &searchResults{IDs: []string{"b", "c"}, Scores: []float64{4, 3}})
+	c.Assert(results.page(4, 2), DeepEquals, &searchResults{IDs: []string{"e"}, Scores: []float64{1}})
+	c.Assert(results.page(10, 2).IDs, HasLen, 0)
+
+	results = &searchResults{IDs: []string{"a", "b", "c"}}
+	c.Assert(results.page(0, 2), DeepEquals, &searchResults{IDs: []string{"a", "b"}})
+}
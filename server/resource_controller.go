@@ -5,20 +5,32 @@ package server
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
+	"io/ioutil"
+	"mime"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 	"github.com/intervention-engine/fhir/models"
 	"github.com/intervention-engine/fhir/search"
+	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
+// idPattern matches the logical ids allowed by FHIR.
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9\-\.]{1,64}$`)
+
+// IsValidID indicates whether the string is a valid FHIR logical id.
+func IsValidID(id string) bool {
+	return idPattern.MatchString(id)
+}
+
 type ResourceController struct {
 	Name string
 }
@@ -26,23 +38,12 @@ type ResourceController struct {
 func (rc *ResourceController) IndexHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 	defer func() {
 		if r := recover(); r != nil {
-			rw.Header().Set("Content-Type", "application/json; charset=utf-8")
-			switch x := r.(type) {
-			case search.Error:
-				rw.WriteHeader(x.HTTPStatus)
-				json.NewEncoder(rw).Encode(x.OperationOutcome)
-				return
-			default:
-				outcome := &models.OperationOutcome{
-					Issue: []models.OperationOutcomeIssueComponent{
-						models.OperationOutcomeIssueComponent{
-							Severity: "fatal",
-							Code:     "exception",
-						},
-					},
-				}
-				rw.WriteHeader(http.StatusInternalServerError)
-				json.NewEncoder(rw).Encode(outcome)
+			// Search errors are raised as *search.Error, which sendError reports with
+			// their own status; anything else is reported as an internal error.
+			if err, ok := r.(error); ok {
+				sendError(rw, err)
+			} else {
+				sendError(rw, fmt.Errorf("%v", r))
 			}
 		}
 	}()
@@ -52,54 +53,108 @@ func (rc *ResourceController) IndexHandler(rw http.ResponseWriter, r *http.Reque
 	// Create and execute the Mongo query based on the http query params
 	searcher := search.NewMongoSearcher(Database)
 	searchQuery := search.Query{Resource: rc.Name, Query: r.URL.RawQuery}
-	mgoQuery := searcher.CreateQuery(searchQuery)
 
-	// Horrible, horrible hack (for now) to ensure patients are sorted by name.  This is needed by
-	// the frontend, else paging won't work correctly.  This should be removed when the general
-	// sorting feature is implemented.
-	if rc.Name == "Patient" {
-		// To add insult to injury, mongo will not let us sort by family *and* given name:
-		// Executor error: BadValue cannot sort with keys that are parallel arrays
-		mgoQuery = mgoQuery.Sort("name.0.family.0" /*", name.0.given.0"*/, "_id")
+	// A page token refers to the stored results of an earlier search
+	var snapshot *searchSnapshot
+	var page pageToken
+	if token := r.URL.Query().Get(search.PageParam); token != "" {
+		var err error
+		if page, err = decodePageToken(token); err != nil {
+			sendError(rw, err)
+			return
+		}
+		if snapshot, err = loadSnapshot(rc.Name, page.Snapshot); err != nil {
+			sendError(rw, err)
+			return
+		}
+		searchQuery.Query = snapshot.Query
+	}
+	options := searchQuery.Options()
+	if snapshot != nil {
+		options.Count, options.Offset = page.Count, page.Offset
 	}
 
-	err := mgoQuery.All(result)
+	var total uint32
+	var scores map[string]float64
+	var err error
+	switch {
+	case options.Summary == "count" || options.Count == 0:
+		// Only the total is reported, so there's no need to fetch the resources
+		var count int
+		count, err = searcher.CreateQueryWithoutOptions(searchQuery).Count()
+		total = uint32(count)
+	case snapshot != nil:
+		var page *searchResults
+		if page, err = snapshot.page(options.Offset, options.Count); err == nil {
+			result, scores, err = page.resources(rc.Name, search.Projection(rc.Name, options))
+		}
+		total = uint32(snapshot.Total)
+	default:
+		// Results spanning more than one page are stored in a snapshot, which every
+		// page (including this one) is served from, so that the pages are
+		// consistent with each other
+		var results *searchResults
+		if results, err = findResults(searcher, searchQuery); err != nil {
+			break
+		}
+		total = uint32(len(results.IDs))
+		if len(results.IDs) > options.Count || options.Offset > 0 {
+			if snapshot, err = createSnapshot(searchQuery, results); err != nil {
+				break
+			}
+		}
+		result, scores, err = results.page(options.Offset, options.Count).resources(rc.Name, search.Projection(rc.Name, options))
+	}
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
 	}
 
 	var entryList []models.BundleEntryComponent
 	resultVal := reflect.ValueOf(result).Elem()
+	ids := make([]string, resultVal.Len())
 	for i := 0; i < resultVal.Len(); i++ {
 		var entry models.BundleEntryComponent
 		entry.Resource = resultVal.Index(i).Addr().Interface()
+		if options.IsSubsetted() {
+			markSubsetted(entry.Resource)
+		}
+		ids[i] = resultVal.Index(i).FieldByName("Id").String()
+		entry.Search = &models.BundleEntrySearchComponent{Mode: "match"}
+		if score, ok := scores[ids[i]]; ok {
+			entry.Search.Score = &score
+		}
 		entryList = append(entryList, entry)
 	}
 
+	// Included resources are added to the bundle, but are not counted in the total
+	if len(options.Include) > 0 || len(options.RevInclude) > 0 {
+		included, err := searcher.FindIncludes(rc.Name, ids, options)
+		if err != nil {
+			sendError(rw, err)
+			return
+		}
+		for _, resource := range included {
+			entryList = append(entryList, models.BundleEntryComponent{
+				Resource: resource,
+				Search:   &models.BundleEntrySearchComponent{Mode: "include"},
+			})
+		}
+	}
+
 	var bundle models.Bundle
 	bundle.Id = bson.NewObjectId().Hex()
 	bundle.Type = "searchset"
 	bundle.Entry = entryList
 
-	options := searchQuery.Options()
-
-	// Need to get the true total (not just how many were returned in this response)
-	var total uint32
-	if resultVal.Len() == options.Count || resultVal.Len() == 0 {
-		// Need to get total count from the server, since there may be more or the offset was too high
-		intTotal, err := searcher.CreateQueryWithoutOptions(searchQuery).Count()
-		if err != nil {
-			http.Error(rw, err.Error(), http.StatusInternalServerError)
-		}
-		total = uint32(intTotal)
-	} else {
-		// We can figure out the total by adding the offset and # results returned
-		total = uint32(options.Offset + resultVal.Len())
-	}
 	bundle.Total = &total
 
 	// Add links for paging
-	bundle.Link = generatePagingLinks(r, searchQuery, total)
+	if snapshot != nil {
+		bundle.Link = pagingLinks(options, total, snapshot.linkFunc(responseURL(r, rc.Name)))
+	} else {
+		bundle.Link = generatePagingLinks(r, searchQuery, total)
+	}
 
 	context.Set(r, rc.Name, reflect.ValueOf(result).Elem().Interface())
 	context.Set(r, "Resource", rc.Name)
@@ -111,20 +166,33 @@ func (rc *ResourceController) IndexHandler(rw http.ResponseWriter, r *http.Reque
 }
 
 func generatePagingLinks(r *http.Request, query search.Query, total uint32) []models.BundleLinkComponent {
+	return pagingLinks(query.Options(), total, offsetLinkFunc(responseURL(r, query.Resource), query.NormalizedQueryValues(true)))
+}
+
+// linkFunc creates the paging link with the relation to the page with the
+// count and offset.
+type linkFunc func(relation string, count uint32, offset uint32) models.BundleLinkComponent
+
+// offsetLinkFunc returns a function creating paging links that carry over the
+// query values, setting the _count and _offset parameters.
+func offsetLinkFunc(baseURL *url.URL, values url.Values) linkFunc {
+	return func(relation string, count uint32, offset uint32) models.BundleLinkComponent {
+		return newLink(relation, baseURL, values, count, offset)
+	}
+}
+
+// pagingLinks builds the self, first, previous, next and last links for a
+// paged bundle, given the count and offset of the current page.
+func pagingLinks(options *search.QueryOptions, total uint32, newLink linkFunc) []models.BundleLinkComponent {
 	links := make([]models.BundleLinkComponent, 0, 5)
-	values := query.NormalizedQueryValues(false)
-	options := query.Options()
 	count := uint32(options.Count)
 	offset := uint32(options.Offset)
 
-	// First create the base URL for paging
-	baseURL := responseURL(r, query.Resource)
-
 	// Self link
-	links = append(links, newLink("self", baseURL, values, count, offset))
+	links = append(links, newLink("self", count, offset))
 
 	// First link
-	links = append(links, newLink("first", baseURL, values, count, uint32(0)))
+	links = append(links, newLink("first", count, uint32(0)))
 
 	// Previous link
 	if offset > uint32(0) {
@@ -133,24 +201,24 @@ func generatePagingLinks(r *http.Request, query search.Query, total uint32) []mo
 		if count > offset {
 			newOffset = uint32(0)
 		}
-		links = append(links, newLink("previous", baseURL, values, offset-newOffset, newOffset))
+		links = append(links, newLink("previous", offset-newOffset, newOffset))
 	}
 
-	// Next Link
-	if total > (offset + count) {
-		links = append(links, newLink("next", baseURL, values, count, offset+count))
+	// Next Link (an empty page has no next page)
+	if count > 0 && total > (offset+count) {
+		links = append(links, newLink("next", count, offset+count))
 	}
 
 	// Last Link
-	remainder := (total - offset) % count
-	if total < offset {
-		remainder = uint32(0)
+	var remainder uint32
+	if total > offset && count > 0 {
+		remainder = (total - offset) % count
 	}
 	newOffset := total - remainder
 	if remainder == uint32(0) && total > count {
 		newOffset = total - count
 	}
-	links = append(links, newLink("last", baseURL, values, count, newOffset))
+	links = append(links, newLink("last", count, newOffset))
 
 	return links
 }
@@ -162,21 +230,32 @@ func newLink(relation string, baseURL *url.URL, values url.Values, count uint32,
 	return models.BundleLinkComponent{Relation: relation, Url: baseURL.String()}
 }
 
+// LoadResource loads the resource with the id in the URL and stores it in the
+// request context.  If the resource can't be loaded, the returned error is an
+// *Error with the appropriate HTTP status (400, 404, 410 or 500).
 func (rc *ResourceController) LoadResource(r *http.Request) (interface{}, error) {
-	var id bson.ObjectId
-
-	idString := mux.Vars(r)["id"]
-	if bson.IsObjectIdHex(idString) {
-		id = bson.ObjectIdHex(idString)
-	} else {
-		return nil, errors.New("Invalid id")
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		return nil, invalidIDError(rc.Name, id)
 	}
 
+	return rc.loadResource(r, id, nil)
+}
+
+// loadResource loads the resource with the id, selecting only the fields in
+// the projection (if it is not nil).
+func (rc *ResourceController) loadResource(r *http.Request, id string, projection bson.M) (interface{}, error) {
 	c := Database.C(models.PluralizeLowerResourceName(rc.Name))
 	result := models.NewStructForResourceName(rc.Name)
-	err := c.Find(bson.M{"_id": id.Hex()}).One(result)
-	if err != nil {
-		return nil, err
+	query := c.Find(bson.M{"_id": id})
+	if projection != nil {
+		query = query.Select(projection)
+	}
+	err := query.One(result)
+	if err == mgo.ErrNotFound {
+		return nil, rc.missingError(id)
+	} else if err != nil {
+		return nil, internalError(err)
 	}
 
 	context.Set(r, rc.Name, result)
@@ -186,29 +265,129 @@ func (rc *ResourceController) LoadResource(r *http.Request) (interface{}, error)
 
 func (rc *ResourceController) ShowHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 	context.Set(r, "Action", "read")
-	_, err := rc.LoadResource(r)
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		sendError(rw, invalidIDError(rc.Name, id))
+		return
+	}
+	options, err := readOptions(r, rc.Name)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
 	}
+
+	resource, err := rc.loadResource(r, id, search.Projection(rc.Name, options))
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	if options.IsSubsetted() {
+		markSubsetted(resource)
+	}
+	setETag(rw, resource)
 	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 	rw.Header().Set("Access-Control-Allow-Origin", "*")
 	json.NewEncoder(rw).Encode(context.Get(r, rc.Name))
 }
 
+// readOptions parses the _summary and _elements parameters of a read, which
+// are the only search result parameters that apply to a single resource.
+func readOptions(r *http.Request, resourceType string) (options *search.QueryOptions, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			searchErr, ok := e.(*search.Error)
+			if !ok {
+				panic(e)
+			}
+			err = searchErr
+		}
+	}()
+
+	values := url.Values{}
+	for _, param := range []string{search.SummaryParam, search.ElementsParam} {
+		if v, ok := r.URL.Query()[param]; ok {
+			values[param] = v
+		}
+	}
+	query := search.Query{Resource: resourceType, Query: values.Encode()}
+	options = query.Options()
+	if options.Summary == "count" {
+		return nil, NewError(http.StatusBadRequest, "error", "value",
+			"Parameter \"_summary\" cannot be \"count\" when reading a resource", "http._summary")
+	}
+	return options, nil
+}
+
+// markSubsetted adds the SUBSETTED security label to a resource that was
+// loaded with only some of its elements.
+func markSubsetted(resource interface{}) {
+	metaVal := reflect.ValueOf(resource).Elem().FieldByName("Meta")
+	meta, _ := metaVal.Interface().(*models.Meta)
+	if meta == nil {
+		meta = &models.Meta{}
+		metaVal.Set(reflect.ValueOf(meta))
+	}
+	meta.Security = append(meta.Security, models.Coding{
+		System:  "http://hl7.org/fhir/v3/ObservationValue",
+		Code:    "SUBSETTED",
+		Display: "subsetted",
+	})
+}
+
 func (rc *ResourceController) CreateHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
-	decoder := json.NewDecoder(r.Body)
-	resource := models.NewStructForResourceName(rc.Name)
-	err := decoder.Decode(resource)
+	resource, err := decodeResource(r, rc.Name)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
+	}
+
+	// Conditional create: only create the resource if no resource matches the criteria
+	if criteria := r.Header.Get("If-None-Exist"); criteria != "" {
+		matches, err := findMatches(rc.Name, criteria, 2)
+		if err != nil {
+			sendError(rw, err)
+			return
+		}
+		if len(matches) > 1 {
+			sendError(rw, NewError(http.StatusPreconditionFailed, "error", "duplicate",
+				fmt.Sprintf("Multiple %s resources match the If-None-Exist criteria \"%s\"", rc.Name, criteria)))
+			return
+		}
+		if len(matches) == 1 {
+			existing := matches[0]
+			context.Set(r, rc.Name, existing)
+			context.Set(r, "Resource", rc.Name)
+			context.Set(r, "Action", "read")
+
+			id := reflect.ValueOf(existing).Elem().FieldByName("Id").String()
+			rw.Header().Add("Location", responseURL(r, rc.Name, id).String())
+			setETag(rw, existing)
+			rw.Header().Set("Content-Type", "application/json; charset=utf-8")
+			rw.Header().Set("Access-Control-Allow-Origin", "*")
+			rw.WriteHeader(http.StatusOK)
+			json.NewEncoder(rw).Encode(existing)
+			return
+		}
 	}
 
+	rc.createResource(rw, r, resource)
+}
+
+// createResource stores the resource under a newly assigned id and writes it
+// to the response.
+func (rc *ResourceController) createResource(rw http.ResponseWriter, r *http.Request, resource interface{}) {
 	c := Database.C(models.PluralizeLowerResourceName(rc.Name))
 	i := bson.NewObjectId()
 	reflect.ValueOf(resource).Elem().FieldByName("Id").SetString(i.Hex())
-	err = c.Insert(resource)
+	now := time.Now()
+	setVersionMeta(resource, 1, now)
+	err := c.Insert(resource)
+	if err == nil {
+		err = saveVersion(rc.Name, i.Hex(), 1, "POST", resource, now)
+	}
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
 	}
 
 	context.Set(r, rc.Name, resource)
@@ -216,69 +395,365 @@ func (rc *ResourceController) CreateHandler(rw http.ResponseWriter, r *http.Requ
 	context.Set(r, "Action", "create")
 
 	rw.Header().Add("Location", responseURL(r, rc.Name, i.Hex()).String())
+	setETag(rw, resource)
 	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 	rw.Header().Set("Access-Control-Allow-Origin", "*")
 	rw.WriteHeader(http.StatusCreated)
 	json.NewEncoder(rw).Encode(resource)
 }
 
+// UpdateHandler replaces the resource with the id in the URL, or creates the
+// resource with that id if it does not exist yet.
 func (rc *ResourceController) UpdateHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 
-	var id bson.ObjectId
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		sendError(rw, invalidIDError(rc.Name, id))
+		return
+	}
 
-	idString := mux.Vars(r)["id"]
-	if bson.IsObjectIdHex(idString) {
-		id = bson.ObjectIdHex(idString)
-	} else {
-		http.Error(rw, "Invalid id", http.StatusBadRequest)
+	resource, err := decodeResource(r, rc.Name)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	if bodyID := reflect.ValueOf(resource).Elem().FieldByName("Id").String(); bodyID != "" && bodyID != id {
+		sendError(rw, NewError(http.StatusBadRequest, "error", "invalid",
+			fmt.Sprintf("The resource id \"%s\" does not match the id in the URL \"%s\"", bodyID, id), rc.Name+".id"))
+		return
 	}
 
-	decoder := json.NewDecoder(r.Body)
+	rc.updateResource(rw, r, id, resource)
+}
+
+// PatchHandler applies an RFC 6902 JSON Patch document to the stored resource
+// and saves the result as a new version, just as UpdateHandler would.
+func (rc *ResourceController) PatchHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		sendError(rw, invalidIDError(rc.Name, id))
+		return
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != JSONPatchContentType {
+		sendError(rw, NewError(http.StatusUnsupportedMediaType, "error", "not-supported",
+			fmt.Sprintf("PATCH requires a JSON Patch document (%s)", JSONPatchContentType)))
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	ops, err := decodePatch(data)
+	if err != nil {
+		sendError(rw, NewError(http.StatusBadRequest, "error", "structure", err.Error()))
+		return
+	}
+
+	c := Database.C(models.PluralizeLowerResourceName(rc.Name))
+	stored := models.NewStructForResourceName(rc.Name)
+	err = c.FindId(id).One(stored)
+	if err == mgo.ErrNotFound {
+		sendError(rw, rc.missingError(id))
+		return
+	} else if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	rc.patchResource(rw, r, id, stored, ops)
+}
+
+// patchResource applies the operations to the stored resource and saves the
+// result as its new version.  The result is only saved if the stored resource
+// is still the version that was patched.
+func (rc *ResourceController) patchResource(rw http.ResponseWriter, r *http.Request, id string, stored interface{}, ops []patchOperation) {
+	// Apply the patch to the JSON representation of the resource
+	var doc interface{}
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = json.Unmarshal(data, &doc)
+	}
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	if doc, err = applyPatch(doc, ops); err != nil {
+		sendError(rw, NewError(http.StatusUnprocessableEntity, "error", "processing", err.Error()))
+		return
+	}
+
+	// Make sure the patched document is still a valid resource of this type
+	if m, ok := doc.(map[string]interface{}); !ok || m["resourceType"] != rc.Name {
+		sendError(rw, NewError(http.StatusUnprocessableEntity, "error", "invalid",
+			fmt.Sprintf("The patched resource must be a %s", rc.Name), rc.Name+".resourceType"))
+		return
+	}
+	data, err = json.Marshal(doc)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
 	resource := models.NewStructForResourceName(rc.Name)
-	err := decoder.Decode(resource)
+	if err = json.Unmarshal(data, resource); err != nil {
+		e := decodeError(rc.Name, err)
+		e.HTTPStatus = http.StatusUnprocessableEntity
+		sendError(rw, e)
+		return
+	}
+
+	rc.replaceResource(rw, r, id, resource, resourceVersion(stored), true)
+}
+
+// ConditionalUpdateHandler updates the single resource matching the search
+// criteria in the query string.  If no resource matches, the resource is
+// created instead.  A resource with an id must have the matching resource's id.
+func (rc *ResourceController) ConditionalUpdateHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if r.URL.RawQuery == "" {
+		sendError(rw, NewError(http.StatusBadRequest, "error", "required", "Conditional update requires search criteria"))
+		return
+	}
+
+	resource, err := decodeResource(r, rc.Name)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	matches, err := findMatches(rc.Name, r.URL.RawQuery, 2)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		rc.createResource(rw, r, resource)
+	case 1:
+		id := reflect.ValueOf(matches[0]).Elem().FieldByName("Id").String()
+		if bodyID := reflect.ValueOf(resource).Elem().FieldByName("Id").String(); bodyID != "" && bodyID != id {
+			sendError(rw, NewError(http.StatusBadRequest, "error", "invalid",
+				fmt.Sprintf("The resource id \"%s\" does not match the id of the matching resource \"%s\"", bodyID, id), rc.Name+".id"))
+			return
+		}
+		rc.updateResource(rw, r, id, resource)
+	default:
+		sendError(rw, NewError(http.StatusPreconditionFailed, "error", "multiple-matches",
+			fmt.Sprintf("Multiple %s resources match the criteria \"%s\"", rc.Name, r.URL.RawQuery)))
+	}
+}
+
+// updateResource replaces the stored resource with the given id and writes the
+// new version to the response.  If no resource has the id, the resource is
+// created with it.  The resource is only replaced if it is still the version
+// that was read, so concurrent updates can't replace each other.
+func (rc *ResourceController) updateResource(rw http.ResponseWriter, r *http.Request, id string, resource interface{}) {
+	current, exists, err := rc.currentVersion(id)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
 	}
+	rc.replaceResource(rw, r, id, resource, current, exists)
+}
 
+// replaceResource replaces the stored resource with the given id if it is
+// still the given current version, which the new version is based on.  If the
+// resource doesn't exist, it is created with the id.
+func (rc *ResourceController) replaceResource(rw http.ResponseWriter, r *http.Request, id string, resource interface{}, current string, exists bool) {
 	c := Database.C(models.PluralizeLowerResourceName(rc.Name))
-	reflect.ValueOf(resource).Elem().FieldByName("Id").SetString(id.Hex())
-	err = c.Update(bson.M{"_id": id.Hex()}, resource)
+	reflect.ValueOf(resource).Elem().FieldByName("Id").SetString(id)
+	var err error
+	if !exists && r.Header.Get("If-Match") != "" {
+		err = NewError(http.StatusPreconditionFailed, "error", "not-found",
+			fmt.Sprintf("%s/%s does not exist", rc.Name, id))
+	}
+	if err == nil {
+		err = checkIfMatch(r, rc.Name, id, current)
+	}
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+	version, err := nextVersion(rc.Name, id, current)
 	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		sendError(rw, err)
+		return
+	}
+	now := time.Now()
+	setVersionMeta(resource, version, now)
+
+	// Saving the version first claims it, so it can't be given to a concurrent
+	// change, and the resource is then only replaced if it hasn't changed
+	created := false
+	if err = saveVersion(rc.Name, id, version, "PUT", resource, now); err == nil {
+		if exists {
+			err = c.Update(versionSelector(id, current), resource)
+		} else {
+			err = c.Insert(resource)
+			created = true
+		}
+		if err != nil {
+			discardVersion(rc.Name, id, version)
+		}
+	}
+	if err == mgo.ErrNotFound || mgo.IsDup(err) {
+		sendError(rw, concurrentChangeError(rc.Name, id))
+		return
+	} else if err != nil {
+		sendError(rw, err)
+		return
 	}
 
 	context.Set(r, rc.Name, resource)
 	context.Set(r, "Resource", rc.Name)
 	context.Set(r, "Action", "update")
 
+	setETag(rw, resource)
 	rw.Header().Set("Content-Type", "application/json; charset=utf-8")
 	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	if created {
+		rw.Header().Add("Location", responseURL(r, rc.Name, id).String())
+		rw.WriteHeader(http.StatusCreated)
+	}
 	json.NewEncoder(rw).Encode(resource)
 }
 
 func (rc *ResourceController) DeleteHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
-	var id bson.ObjectId
+	id := mux.Vars(r)["id"]
+	if !IsValidID(id) {
+		sendError(rw, invalidIDError(rc.Name, id))
+		return
+	}
 
-	idString := mux.Vars(r)["id"]
-	if bson.IsObjectIdHex(idString) {
-		id = bson.ObjectIdHex(idString)
-	} else {
-		http.Error(rw, "Invalid id", http.StatusBadRequest)
+	rc.deleteResource(rw, r, id)
+}
+
+// ConditionalDeleteHandler deletes the single resource matching the search
+// criteria in the query string.
+func (rc *ResourceController) ConditionalDeleteHandler(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if r.URL.RawQuery == "" {
+		sendError(rw, NewError(http.StatusBadRequest, "error", "required", "Conditional delete requires search criteria"))
+		return
+	}
+
+	matches, err := findMatches(rc.Name, r.URL.RawQuery, 2)
+	if err != nil {
+		sendError(rw, err)
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		sendError(rw, NewError(http.StatusNotFound, "error", "not-found",
+			fmt.Sprintf("No %s resources match the criteria \"%s\"", rc.Name, r.URL.RawQuery)))
+	case 1:
+		id := reflect.ValueOf(matches[0]).Elem().FieldByName("Id").String()
+		rc.deleteResource(rw, r, id)
+	default:
+		sendError(rw, NewError(http.StatusPreconditionFailed, "error", "multiple-matches",
+			fmt.Sprintf("Multiple %s resources match the criteria \"%s\"", rc.Name, r.URL.RawQuery)))
 	}
+}
 
+// deleteResource removes the resource with the given id, recording the delete
+// in the resource's history.  As with updateResource, the resource is only
+// removed if it is still the version that was read.
+func (rc *ResourceController) deleteResource(rw http.ResponseWriter, r *http.Request, id string) {
 	c := Database.C(models.PluralizeLowerResourceName(rc.Name))
 
-	err := c.Remove(bson.M{"_id": id.Hex()})
-	if err != nil {
-		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	current, exists, err := rc.currentVersion(id)
+	if err == nil && !exists {
+		err = rc.missingError(id)
+	}
+	if err == nil {
+		err = checkIfMatch(r, rc.Name, id, current)
+	}
+	var version int
+	if err == nil {
+		version, err = nextVersion(rc.Name, id, current)
+	}
+	// As with updates, the version is claimed before the resource is removed
+	if err == nil {
+		if err = saveVersion(rc.Name, id, version, "DELETE", nil, time.Now()); err == nil {
+			if err = c.Remove(versionSelector(id, current)); err != nil {
+				discardVersion(rc.Name, id, version)
+			}
+		}
+	}
+	if err == mgo.ErrNotFound || mgo.IsDup(err) {
+		sendError(rw, concurrentChangeError(rc.Name, id))
+		return
+	} else if err != nil {
+		sendError(rw, err)
 		return
 	}
 
-	context.Set(r, rc.Name, id.Hex())
+	context.Set(r, rc.Name, id)
 	context.Set(r, "Resource", rc.Name)
 	context.Set(r, "Action", "delete")
 }
 
+// currentVersion returns the versionId of the stored resource with the given
+// id (which is empty for resources stored before they were versioned), and
+// whether there is such a resource.
+func (rc *ResourceController) currentVersion(id string) (string, bool, error) {
+	var stored struct {
+		Meta *models.Meta `bson:"meta"`
+	}
+	err := Database.C(models.PluralizeLowerResourceName(rc.Name)).FindId(id).Select(bson.M{"meta.versionId": 1}).One(&stored)
+	if err == mgo.ErrNotFound {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+	if stored.Meta == nil {
+		return "", true, nil
+	}
+	return stored.Meta.VersionId, true, nil
+}
+
+// checkIfMatch returns a conflict if the request has an If-Match header that
+// doesn't identify the current version of the resource.
+func checkIfMatch(r *http.Request, resourceType, id, current string) error {
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" && versionFromETag(ifMatch) != current {
+		return versionConflictError(r, resourceType, id)
+	}
+	return nil
+}
+
+// versionSelector selects the resource with the given id only if it is still
+// the given version.  This is the check that the resource hasn't changed since
+// its version was read, for both If-Match and unconditional writes.
+func versionSelector(id, version string) bson.M {
+	if version == "" {
+		// Resources stored before they were versioned have no versionId
+		return bson.M{"_id": id, "meta.versionId": nil}
+	}
+	return bson.M{"_id": id, "meta.versionId": version}
+}
+
+// missingError returns the error for a resource that is not in the database:
+// 410 Gone if its history shows it was deleted, otherwise 404 Not Found.
+func (rc *ResourceController) missingError(id string) *Error {
+	latest, err := latestVersion(rc.Name, id)
+	if err == nil && latest.Method == "DELETE" {
+		return goneError(rc.Name, id)
+	}
+	return notFoundError(rc.Name, id)
+}
+
+func concurrentChangeError(resourceType, id string) *Error {
+	return NewError(http.StatusConflict, "error", "conflict",
+		fmt.Sprintf("%s/%s was changed by another request; please try again", resourceType, id))
+}
+
+func versionConflictError(r *http.Request, resourceType, id string) *Error {
+	return NewError(http.StatusConflict, "error", "conflict",
+		fmt.Sprintf("Version %s of %s/%s is not the current version", versionFromETag(r.Header.Get("If-Match")), resourceType, id),
+		"http.If-Match")
+}
+
 func responseURL(r *http.Request, paths ...string) *url.URL {
 	responseURL := url.URL{}
 	if r.TLS == nil {
@@ -7,10 +7,26 @@ import (
 
 func RegisterRoutes(router *mux.Router, config map[string][]negroni.Handler) {
 
+	// Conformance Support
+
+	metadataController := &ConformanceController{}
+	metadataHandler := negroni.New(append(config["Conformance"], negroni.HandlerFunc(metadataController.ConformanceHandler))...)
+	metadataBase := router.Path("/metadata").Subrouter()
+	metadataBase.Methods("GET").Handler(metadataHandler)
+	metadataBase.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
 	// Batch Support
 
 	batchBase := router.Path("/").Subrouter()
 	batchBase.Methods("POST").Handler(negroni.New(append(config["Batch"], negroni.HandlerFunc(BatchHandler))...))
+	batchBase.Methods("OPTIONS").Handler(metadataHandler)
+	batchBase.NewRoute().Handler(MethodNotAllowedHandler("POST", "OPTIONS"))
+
+	// History Support
+
+	historyBase := router.Path("/_history").Subrouter()
+	historyBase.Methods("GET").Handler(negroni.New(append(config["History"], negroni.HandlerFunc(SystemHistoryHandler))...))
+	historyBase.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	// Resources
 
@@ -18,930 +34,2513 @@ func RegisterRoutes(router *mux.Router, config map[string][]negroni.Handler) {
 	appointmentBase := router.Path("/Appointment").Subrouter()
 	appointmentBase.Methods("GET").Handler(negroni.New(append(config["AppointmentIndex"], negroni.HandlerFunc(appointmentController.IndexHandler))...))
 	appointmentBase.Methods("POST").Handler(negroni.New(append(config["AppointmentCreate"], negroni.HandlerFunc(appointmentController.CreateHandler))...))
+	appointmentBase.Methods("PUT").Handler(negroni.New(append(config["AppointmentConditionalUpdate"], negroni.HandlerFunc(appointmentController.ConditionalUpdateHandler))...))
+	appointmentBase.Methods("DELETE").Handler(negroni.New(append(config["AppointmentConditionalDelete"], negroni.HandlerFunc(appointmentController.ConditionalDeleteHandler))...))
+	appointmentBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	appointmentTypeHistory := router.Path("/Appointment/_history").Subrouter()
+	appointmentTypeHistory.Methods("GET").Handler(negroni.New(append(config["AppointmentTypeHistory"], negroni.HandlerFunc(appointmentController.TypeHistoryHandler))...))
+	appointmentTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	appointment := router.Path("/Appointment/{id}").Subrouter()
 	appointment.Methods("GET").Handler(negroni.New(append(config["AppointmentShow"], negroni.HandlerFunc(appointmentController.ShowHandler))...))
 	appointment.Methods("PUT").Handler(negroni.New(append(config["AppointmentUpdate"], negroni.HandlerFunc(appointmentController.UpdateHandler))...))
+	appointment.Methods("PATCH").Handler(negroni.New(append(config["AppointmentUpdate"], negroni.HandlerFunc(appointmentController.PatchHandler))...))
 	appointment.Methods("DELETE").Handler(negroni.New(append(config["AppointmentDelete"], negroni.HandlerFunc(appointmentController.DeleteHandler))...))
+	appointment.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	appointmentInstanceHistory := router.Path("/Appointment/{id}/_history").Subrouter()
+	appointmentInstanceHistory.Methods("GET").Handler(negroni.New(append(config["AppointmentInstanceHistory"], negroni.HandlerFunc(appointmentController.InstanceHistoryHandler))...))
+	appointmentInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	appointmentVersion := router.Path("/Appointment/{id}/_history/{vid}").Subrouter()
+	appointmentVersion.Methods("GET").Handler(negroni.New(append(config["AppointmentVRead"], negroni.HandlerFunc(appointmentController.VersionReadHandler))...))
+	appointmentVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	referralrequestController := ResourceController{"ReferralRequest"}
 	referralrequestBase := router.Path("/ReferralRequest").Subrouter()
 	referralrequestBase.Methods("GET").Handler(negroni.New(append(config["ReferralRequestIndex"], negroni.HandlerFunc(referralrequestController.IndexHandler))...))
 	referralrequestBase.Methods("POST").Handler(negroni.New(append(config["ReferralRequestCreate"], negroni.HandlerFunc(referralrequestController.CreateHandler))...))
+	referralrequestBase.Methods("PUT").Handler(negroni.New(append(config["ReferralRequestConditionalUpdate"], negroni.HandlerFunc(referralrequestController.ConditionalUpdateHandler))...))
+	referralrequestBase.Methods("DELETE").Handler(negroni.New(append(config["ReferralRequestConditionalDelete"], negroni.HandlerFunc(referralrequestController.ConditionalDeleteHandler))...))
+	referralrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	referralrequestTypeHistory := router.Path("/ReferralRequest/_history").Subrouter()
+	referralrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["ReferralRequestTypeHistory"], negroni.HandlerFunc(referralrequestController.TypeHistoryHandler))...))
+	referralrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	referralrequest := router.Path("/ReferralRequest/{id}").Subrouter()
 	referralrequest.Methods("GET").Handler(negroni.New(append(config["ReferralRequestShow"], negroni.HandlerFunc(referralrequestController.ShowHandler))...))
 	referralrequest.Methods("PUT").Handler(negroni.New(append(config["ReferralRequestUpdate"], negroni.HandlerFunc(referralrequestController.UpdateHandler))...))
+	referralrequest.Methods("PATCH").Handler(negroni.New(append(config["ReferralRequestUpdate"], negroni.HandlerFunc(referralrequestController.PatchHandler))...))
 	referralrequest.Methods("DELETE").Handler(negroni.New(append(config["ReferralRequestDelete"], negroni.HandlerFunc(referralrequestController.DeleteHandler))...))
+	referralrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	referralrequestInstanceHistory := router.Path("/ReferralRequest/{id}/_history").Subrouter()
+	referralrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ReferralRequestInstanceHistory"], negroni.HandlerFunc(referralrequestController.InstanceHistoryHandler))...))
+	referralrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	referralrequestVersion := router.Path("/ReferralRequest/{id}/_history/{vid}").Subrouter()
+	referralrequestVersion.Methods("GET").Handler(negroni.New(append(config["ReferralRequestVRead"], negroni.HandlerFunc(referralrequestController.VersionReadHandler))...))
+	referralrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	accountController := ResourceController{"Account"}
 	accountBase := router.Path("/Account").Subrouter()
 	accountBase.Methods("GET").Handler(negroni.New(append(config["AccountIndex"], negroni.HandlerFunc(accountController.IndexHandler))...))
 	accountBase.Methods("POST").Handler(negroni.New(append(config["AccountCreate"], negroni.HandlerFunc(accountController.CreateHandler))...))
+	accountBase.Methods("PUT").Handler(negroni.New(append(config["AccountConditionalUpdate"], negroni.HandlerFunc(accountController.ConditionalUpdateHandler))...))
+	accountBase.Methods("DELETE").Handler(negroni.New(append(config["AccountConditionalDelete"], negroni.HandlerFunc(accountController.ConditionalDeleteHandler))...))
+	accountBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	accountTypeHistory := router.Path("/Account/_history").Subrouter()
+	accountTypeHistory.Methods("GET").Handler(negroni.New(append(config["AccountTypeHistory"], negroni.HandlerFunc(accountController.TypeHistoryHandler))...))
+	accountTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	account := router.Path("/Account/{id}").Subrouter()
 	account.Methods("GET").Handler(negroni.New(append(config["AccountShow"], negroni.HandlerFunc(accountController.ShowHandler))...))
 	account.Methods("PUT").Handler(negroni.New(append(config["AccountUpdate"], negroni.HandlerFunc(accountController.UpdateHandler))...))
+	account.Methods("PATCH").Handler(negroni.New(append(config["AccountUpdate"], negroni.HandlerFunc(accountController.PatchHandler))...))
 	account.Methods("DELETE").Handler(negroni.New(append(config["AccountDelete"], negroni.HandlerFunc(accountController.DeleteHandler))...))
+	account.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	accountInstanceHistory := router.Path("/Account/{id}/_history").Subrouter()
+	accountInstanceHistory.Methods("GET").Handler(negroni.New(append(config["AccountInstanceHistory"], negroni.HandlerFunc(accountController.InstanceHistoryHandler))...))
+	accountInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	accountVersion := router.Path("/Account/{id}/_history/{vid}").Subrouter()
+	accountVersion.Methods("GET").Handler(negroni.New(append(config["AccountVRead"], negroni.HandlerFunc(accountController.VersionReadHandler))...))
+	accountVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	provenanceController := ResourceController{"Provenance"}
 	provenanceBase := router.Path("/Provenance").Subrouter()
 	provenanceBase.Methods("GET").Handler(negroni.New(append(config["ProvenanceIndex"], negroni.HandlerFunc(provenanceController.IndexHandler))...))
 	provenanceBase.Methods("POST").Handler(negroni.New(append(config["ProvenanceCreate"], negroni.HandlerFunc(provenanceController.CreateHandler))...))
+	provenanceBase.Methods("PUT").Handler(negroni.New(append(config["ProvenanceConditionalUpdate"], negroni.HandlerFunc(provenanceController.ConditionalUpdateHandler))...))
+	provenanceBase.Methods("DELETE").Handler(negroni.New(append(config["ProvenanceConditionalDelete"], negroni.HandlerFunc(provenanceController.ConditionalDeleteHandler))...))
+	provenanceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	provenanceTypeHistory := router.Path("/Provenance/_history").Subrouter()
+	provenanceTypeHistory.Methods("GET").Handler(negroni.New(append(config["ProvenanceTypeHistory"], negroni.HandlerFunc(provenanceController.TypeHistoryHandler))...))
+	provenanceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	provenance := router.Path("/Provenance/{id}").Subrouter()
 	provenance.Methods("GET").Handler(negroni.New(append(config["ProvenanceShow"], negroni.HandlerFunc(provenanceController.ShowHandler))...))
 	provenance.Methods("PUT").Handler(negroni.New(append(config["ProvenanceUpdate"], negroni.HandlerFunc(provenanceController.UpdateHandler))...))
+	provenance.Methods("PATCH").Handler(negroni.New(append(config["ProvenanceUpdate"], negroni.HandlerFunc(provenanceController.PatchHandler))...))
 	provenance.Methods("DELETE").Handler(negroni.New(append(config["ProvenanceDelete"], negroni.HandlerFunc(provenanceController.DeleteHandler))...))
+	provenance.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	provenanceInstanceHistory := router.Path("/Provenance/{id}/_history").Subrouter()
+	provenanceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ProvenanceInstanceHistory"], negroni.HandlerFunc(provenanceController.InstanceHistoryHandler))...))
+	provenanceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	provenanceVersion := router.Path("/Provenance/{id}/_history/{vid}").Subrouter()
+	provenanceVersion.Methods("GET").Handler(negroni.New(append(config["ProvenanceVRead"], negroni.HandlerFunc(provenanceController.VersionReadHandler))...))
+	provenanceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	questionnaireController := ResourceController{"Questionnaire"}
 	questionnaireBase := router.Path("/Questionnaire").Subrouter()
 	questionnaireBase.Methods("GET").Handler(negroni.New(append(config["QuestionnaireIndex"], negroni.HandlerFunc(questionnaireController.IndexHandler))...))
 	questionnaireBase.Methods("POST").Handler(negroni.New(append(config["QuestionnaireCreate"], negroni.HandlerFunc(questionnaireController.CreateHandler))...))
+	questionnaireBase.Methods("PUT").Handler(negroni.New(append(config["QuestionnaireConditionalUpdate"], negroni.HandlerFunc(questionnaireController.ConditionalUpdateHandler))...))
+	questionnaireBase.Methods("DELETE").Handler(negroni.New(append(config["QuestionnaireConditionalDelete"], negroni.HandlerFunc(questionnaireController.ConditionalDeleteHandler))...))
+	questionnaireBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	questionnaireTypeHistory := router.Path("/Questionnaire/_history").Subrouter()
+	questionnaireTypeHistory.Methods("GET").Handler(negroni.New(append(config["QuestionnaireTypeHistory"], negroni.HandlerFunc(questionnaireController.TypeHistoryHandler))...))
+	questionnaireTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	questionnaire := router.Path("/Questionnaire/{id}").Subrouter()
 	questionnaire.Methods("GET").Handler(negroni.New(append(config["QuestionnaireShow"], negroni.HandlerFunc(questionnaireController.ShowHandler))...))
 	questionnaire.Methods("PUT").Handler(negroni.New(append(config["QuestionnaireUpdate"], negroni.HandlerFunc(questionnaireController.UpdateHandler))...))
+	questionnaire.Methods("PATCH").Handler(negroni.New(append(config["QuestionnaireUpdate"], negroni.HandlerFunc(questionnaireController.PatchHandler))...))
 	questionnaire.Methods("DELETE").Handler(negroni.New(append(config["QuestionnaireDelete"], negroni.HandlerFunc(questionnaireController.DeleteHandler))...))
+	questionnaire.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	questionnaireInstanceHistory := router.Path("/Questionnaire/{id}/_history").Subrouter()
+	questionnaireInstanceHistory.Methods("GET").Handler(negroni.New(append(config["QuestionnaireInstanceHistory"], negroni.HandlerFunc(questionnaireController.InstanceHistoryHandler))...))
+	questionnaireInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	questionnaireVersion := router.Path("/Questionnaire/{id}/_history/{vid}").Subrouter()
+	questionnaireVersion.Methods("GET").Handler(negroni.New(append(config["QuestionnaireVRead"], negroni.HandlerFunc(questionnaireController.VersionReadHandler))...))
+	questionnaireVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	explanationofbenefitController := ResourceController{"ExplanationOfBenefit"}
 	explanationofbenefitBase := router.Path("/ExplanationOfBenefit").Subrouter()
 	explanationofbenefitBase.Methods("GET").Handler(negroni.New(append(config["ExplanationOfBenefitIndex"], negroni.HandlerFunc(explanationofbenefitController.IndexHandler))...))
 	explanationofbenefitBase.Methods("POST").Handler(negroni.New(append(config["ExplanationOfBenefitCreate"], negroni.HandlerFunc(explanationofbenefitController.CreateHandler))...))
+	explanationofbenefitBase.Methods("PUT").Handler(negroni.New(append(config["ExplanationOfBenefitConditionalUpdate"], negroni.HandlerFunc(explanationofbenefitController.ConditionalUpdateHandler))...))
+	explanationofbenefitBase.Methods("DELETE").Handler(negroni.New(append(config["ExplanationOfBenefitConditionalDelete"], negroni.HandlerFunc(explanationofbenefitController.ConditionalDeleteHandler))...))
+	explanationofbenefitBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	explanationofbenefitTypeHistory := router.Path("/ExplanationOfBenefit/_history").Subrouter()
+	explanationofbenefitTypeHistory.Methods("GET").Handler(negroni.New(append(config["ExplanationOfBenefitTypeHistory"], negroni.HandlerFunc(explanationofbenefitController.TypeHistoryHandler))...))
+	explanationofbenefitTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	explanationofbenefit := router.Path("/ExplanationOfBenefit/{id}").Subrouter()
 	explanationofbenefit.Methods("GET").Handler(negroni.New(append(config["ExplanationOfBenefitShow"], negroni.HandlerFunc(explanationofbenefitController.ShowHandler))...))
 	explanationofbenefit.Methods("PUT").Handler(negroni.New(append(config["ExplanationOfBenefitUpdate"], negroni.HandlerFunc(explanationofbenefitController.UpdateHandler))...))
+	explanationofbenefit.Methods("PATCH").Handler(negroni.New(append(config["ExplanationOfBenefitUpdate"], negroni.HandlerFunc(explanationofbenefitController.PatchHandler))...))
 	explanationofbenefit.Methods("DELETE").Handler(negroni.New(append(config["ExplanationOfBenefitDelete"], negroni.HandlerFunc(explanationofbenefitController.DeleteHandler))...))
+	explanationofbenefit.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	explanationofbenefitInstanceHistory := router.Path("/ExplanationOfBenefit/{id}/_history").Subrouter()
+	explanationofbenefitInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ExplanationOfBenefitInstanceHistory"], negroni.HandlerFunc(explanationofbenefitController.InstanceHistoryHandler))...))
+	explanationofbenefitInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	explanationofbenefitVersion := router.Path("/ExplanationOfBenefit/{id}/_history/{vid}").Subrouter()
+	explanationofbenefitVersion.Methods("GET").Handler(negroni.New(append(config["ExplanationOfBenefitVRead"], negroni.HandlerFunc(explanationofbenefitController.VersionReadHandler))...))
+	explanationofbenefitVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	documentmanifestController := ResourceController{"DocumentManifest"}
 	documentmanifestBase := router.Path("/DocumentManifest").Subrouter()
 	documentmanifestBase.Methods("GET").Handler(negroni.New(append(config["DocumentManifestIndex"], negroni.HandlerFunc(documentmanifestController.IndexHandler))...))
 	documentmanifestBase.Methods("POST").Handler(negroni.New(append(config["DocumentManifestCreate"], negroni.HandlerFunc(documentmanifestController.CreateHandler))...))
+	documentmanifestBase.Methods("PUT").Handler(negroni.New(append(config["DocumentManifestConditionalUpdate"], negroni.HandlerFunc(documentmanifestController.ConditionalUpdateHandler))...))
+	documentmanifestBase.Methods("DELETE").Handler(negroni.New(append(config["DocumentManifestConditionalDelete"], negroni.HandlerFunc(documentmanifestController.ConditionalDeleteHandler))...))
+	documentmanifestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	documentmanifestTypeHistory := router.Path("/DocumentManifest/_history").Subrouter()
+	documentmanifestTypeHistory.Methods("GET").Handler(negroni.New(append(config["DocumentManifestTypeHistory"], negroni.HandlerFunc(documentmanifestController.TypeHistoryHandler))...))
+	documentmanifestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	documentmanifest := router.Path("/DocumentManifest/{id}").Subrouter()
 	documentmanifest.Methods("GET").Handler(negroni.New(append(config["DocumentManifestShow"], negroni.HandlerFunc(documentmanifestController.ShowHandler))...))
 	documentmanifest.Methods("PUT").Handler(negroni.New(append(config["DocumentManifestUpdate"], negroni.HandlerFunc(documentmanifestController.UpdateHandler))...))
+	documentmanifest.Methods("PATCH").Handler(negroni.New(append(config["DocumentManifestUpdate"], negroni.HandlerFunc(documentmanifestController.PatchHandler))...))
 	documentmanifest.Methods("DELETE").Handler(negroni.New(append(config["DocumentManifestDelete"], negroni.HandlerFunc(documentmanifestController.DeleteHandler))...))
+	documentmanifest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	documentmanifestInstanceHistory := router.Path("/DocumentManifest/{id}/_history").Subrouter()
+	documentmanifestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DocumentManifestInstanceHistory"], negroni.HandlerFunc(documentmanifestController.InstanceHistoryHandler))...))
+	documentmanifestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	documentmanifestVersion := router.Path("/DocumentManifest/{id}/_history/{vid}").Subrouter()
+	documentmanifestVersion.Methods("GET").Handler(negroni.New(append(config["DocumentManifestVRead"], negroni.HandlerFunc(documentmanifestController.VersionReadHandler))...))
+	documentmanifestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	specimenController := ResourceController{"Specimen"}
 	specimenBase := router.Path("/Specimen").Subrouter()
 	specimenBase.Methods("GET").Handler(negroni.New(append(config["SpecimenIndex"], negroni.HandlerFunc(specimenController.IndexHandler))...))
 	specimenBase.Methods("POST").Handler(negroni.New(append(config["SpecimenCreate"], negroni.HandlerFunc(specimenController.CreateHandler))...))
+	specimenBase.Methods("PUT").Handler(negroni.New(append(config["SpecimenConditionalUpdate"], negroni.HandlerFunc(specimenController.ConditionalUpdateHandler))...))
+	specimenBase.Methods("DELETE").Handler(negroni.New(append(config["SpecimenConditionalDelete"], negroni.HandlerFunc(specimenController.ConditionalDeleteHandler))...))
+	specimenBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	specimenTypeHistory := router.Path("/Specimen/_history").Subrouter()
+	specimenTypeHistory.Methods("GET").Handler(negroni.New(append(config["SpecimenTypeHistory"], negroni.HandlerFunc(specimenController.TypeHistoryHandler))...))
+	specimenTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	specimen := router.Path("/Specimen/{id}").Subrouter()
 	specimen.Methods("GET").Handler(negroni.New(append(config["SpecimenShow"], negroni.HandlerFunc(specimenController.ShowHandler))...))
 	specimen.Methods("PUT").Handler(negroni.New(append(config["SpecimenUpdate"], negroni.HandlerFunc(specimenController.UpdateHandler))...))
+	specimen.Methods("PATCH").Handler(negroni.New(append(config["SpecimenUpdate"], negroni.HandlerFunc(specimenController.PatchHandler))...))
 	specimen.Methods("DELETE").Handler(negroni.New(append(config["SpecimenDelete"], negroni.HandlerFunc(specimenController.DeleteHandler))...))
+	specimen.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	specimenInstanceHistory := router.Path("/Specimen/{id}/_history").Subrouter()
+	specimenInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SpecimenInstanceHistory"], negroni.HandlerFunc(specimenController.InstanceHistoryHandler))...))
+	specimenInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	specimenVersion := router.Path("/Specimen/{id}/_history/{vid}").Subrouter()
+	specimenVersion.Methods("GET").Handler(negroni.New(append(config["SpecimenVRead"], negroni.HandlerFunc(specimenController.VersionReadHandler))...))
+	specimenVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	allergyintoleranceController := ResourceController{"AllergyIntolerance"}
 	allergyintoleranceBase := router.Path("/AllergyIntolerance").Subrouter()
 	allergyintoleranceBase.Methods("GET").Handler(negroni.New(append(config["AllergyIntoleranceIndex"], negroni.HandlerFunc(allergyintoleranceController.IndexHandler))...))
 	allergyintoleranceBase.Methods("POST").Handler(negroni.New(append(config["AllergyIntoleranceCreate"], negroni.HandlerFunc(allergyintoleranceController.CreateHandler))...))
+	allergyintoleranceBase.Methods("PUT").Handler(negroni.New(append(config["AllergyIntoleranceConditionalUpdate"], negroni.HandlerFunc(allergyintoleranceController.ConditionalUpdateHandler))...))
+	allergyintoleranceBase.Methods("DELETE").Handler(negroni.New(append(config["AllergyIntoleranceConditionalDelete"], negroni.HandlerFunc(allergyintoleranceController.ConditionalDeleteHandler))...))
+	allergyintoleranceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	allergyintoleranceTypeHistory := router.Path("/AllergyIntolerance/_history").Subrouter()
+	allergyintoleranceTypeHistory.Methods("GET").Handler(negroni.New(append(config["AllergyIntoleranceTypeHistory"], negroni.HandlerFunc(allergyintoleranceController.TypeHistoryHandler))...))
+	allergyintoleranceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	allergyintolerance := router.Path("/AllergyIntolerance/{id}").Subrouter()
 	allergyintolerance.Methods("GET").Handler(negroni.New(append(config["AllergyIntoleranceShow"], negroni.HandlerFunc(allergyintoleranceController.ShowHandler))...))
 	allergyintolerance.Methods("PUT").Handler(negroni.New(append(config["AllergyIntoleranceUpdate"], negroni.HandlerFunc(allergyintoleranceController.UpdateHandler))...))
+	allergyintolerance.Methods("PATCH").Handler(negroni.New(append(config["AllergyIntoleranceUpdate"], negroni.HandlerFunc(allergyintoleranceController.PatchHandler))...))
 	allergyintolerance.Methods("DELETE").Handler(negroni.New(append(config["AllergyIntoleranceDelete"], negroni.HandlerFunc(allergyintoleranceController.DeleteHandler))...))
+	allergyintolerance.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	allergyintoleranceInstanceHistory := router.Path("/AllergyIntolerance/{id}/_history").Subrouter()
+	allergyintoleranceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["AllergyIntoleranceInstanceHistory"], negroni.HandlerFunc(allergyintoleranceController.InstanceHistoryHandler))...))
+	allergyintoleranceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	allergyintoleranceVersion := router.Path("/AllergyIntolerance/{id}/_history/{vid}").Subrouter()
+	allergyintoleranceVersion.Methods("GET").Handler(negroni.New(append(config["AllergyIntoleranceVRead"], negroni.HandlerFunc(allergyintoleranceController.VersionReadHandler))...))
+	allergyintoleranceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	careplanController := ResourceController{"CarePlan"}
 	careplanBase := router.Path("/CarePlan").Subrouter()
 	careplanBase.Methods("GET").Handler(negroni.New(append(config["CarePlanIndex"], negroni.HandlerFunc(careplanController.IndexHandler))...))
 	careplanBase.Methods("POST").Handler(negroni.New(append(config["CarePlanCreate"], negroni.HandlerFunc(careplanController.CreateHandler))...))
+	careplanBase.Methods("PUT").Handler(negroni.New(append(config["CarePlanConditionalUpdate"], negroni.HandlerFunc(careplanController.ConditionalUpdateHandler))...))
+	careplanBase.Methods("DELETE").Handler(negroni.New(append(config["CarePlanConditionalDelete"], negroni.HandlerFunc(careplanController.ConditionalDeleteHandler))...))
+	careplanBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	careplanTypeHistory := router.Path("/CarePlan/_history").Subrouter()
+	careplanTypeHistory.Methods("GET").Handler(negroni.New(append(config["CarePlanTypeHistory"], negroni.HandlerFunc(careplanController.TypeHistoryHandler))...))
+	careplanTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	careplan := router.Path("/CarePlan/{id}").Subrouter()
 	careplan.Methods("GET").Handler(negroni.New(append(config["CarePlanShow"], negroni.HandlerFunc(careplanController.ShowHandler))...))
 	careplan.Methods("PUT").Handler(negroni.New(append(config["CarePlanUpdate"], negroni.HandlerFunc(careplanController.UpdateHandler))...))
+	careplan.Methods("PATCH").Handler(negroni.New(append(config["CarePlanUpdate"], negroni.HandlerFunc(careplanController.PatchHandler))...))
 	careplan.Methods("DELETE").Handler(negroni.New(append(config["CarePlanDelete"], negroni.HandlerFunc(careplanController.DeleteHandler))...))
+	careplan.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	careplanInstanceHistory := router.Path("/CarePlan/{id}/_history").Subrouter()
+	careplanInstanceHistory.Methods("GET").Handler(negroni.New(append(config["CarePlanInstanceHistory"], negroni.HandlerFunc(careplanController.InstanceHistoryHandler))...))
+	careplanInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	careplanVersion := router.Path("/CarePlan/{id}/_history/{vid}").Subrouter()
+	careplanVersion.Methods("GET").Handler(negroni.New(append(config["CarePlanVRead"], negroni.HandlerFunc(careplanController.VersionReadHandler))...))
+	careplanVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	goalController := ResourceController{"Goal"}
 	goalBase := router.Path("/Goal").Subrouter()
 	goalBase.Methods("GET").Handler(negroni.New(append(config["GoalIndex"], negroni.HandlerFunc(goalController.IndexHandler))...))
 	goalBase.Methods("POST").Handler(negroni.New(append(config["GoalCreate"], negroni.HandlerFunc(goalController.CreateHandler))...))
+	goalBase.Methods("PUT").Handler(negroni.New(append(config["GoalConditionalUpdate"], negroni.HandlerFunc(goalController.ConditionalUpdateHandler))...))
+	goalBase.Methods("DELETE").Handler(negroni.New(append(config["GoalConditionalDelete"], negroni.HandlerFunc(goalController.ConditionalDeleteHandler))...))
+	goalBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	goalTypeHistory := router.Path("/Goal/_history").Subrouter()
+	goalTypeHistory.Methods("GET").Handler(negroni.New(append(config["GoalTypeHistory"], negroni.HandlerFunc(goalController.TypeHistoryHandler))...))
+	goalTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	goal := router.Path("/Goal/{id}").Subrouter()
 	goal.Methods("GET").Handler(negroni.New(append(config["GoalShow"], negroni.HandlerFunc(goalController.ShowHandler))...))
 	goal.Methods("PUT").Handler(negroni.New(append(config["GoalUpdate"], negroni.HandlerFunc(goalController.UpdateHandler))...))
+	goal.Methods("PATCH").Handler(negroni.New(append(config["GoalUpdate"], negroni.HandlerFunc(goalController.PatchHandler))...))
 	goal.Methods("DELETE").Handler(negroni.New(append(config["GoalDelete"], negroni.HandlerFunc(goalController.DeleteHandler))...))
+	goal.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	goalInstanceHistory := router.Path("/Goal/{id}/_history").Subrouter()
+	goalInstanceHistory.Methods("GET").Handler(negroni.New(append(config["GoalInstanceHistory"], negroni.HandlerFunc(goalController.InstanceHistoryHandler))...))
+	goalInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	goalVersion := router.Path("/Goal/{id}/_history/{vid}").Subrouter()
+	goalVersion.Methods("GET").Handler(negroni.New(append(config["GoalVRead"], negroni.HandlerFunc(goalController.VersionReadHandler))...))
+	goalVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	structuredefinitionController := ResourceController{"StructureDefinition"}
 	structuredefinitionBase := router.Path("/StructureDefinition").Subrouter()
 	structuredefinitionBase.Methods("GET").Handler(negroni.New(append(config["StructureDefinitionIndex"], negroni.HandlerFunc(structuredefinitionController.IndexHandler))...))
 	structuredefinitionBase.Methods("POST").Handler(negroni.New(append(config["StructureDefinitionCreate"], negroni.HandlerFunc(structuredefinitionController.CreateHandler))...))
+	structuredefinitionBase.Methods("PUT").Handler(negroni.New(append(config["StructureDefinitionConditionalUpdate"], negroni.HandlerFunc(structuredefinitionController.ConditionalUpdateHandler))...))
+	structuredefinitionBase.Methods("DELETE").Handler(negroni.New(append(config["StructureDefinitionConditionalDelete"], negroni.HandlerFunc(structuredefinitionController.ConditionalDeleteHandler))...))
+	structuredefinitionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	structuredefinitionTypeHistory := router.Path("/StructureDefinition/_history").Subrouter()
+	structuredefinitionTypeHistory.Methods("GET").Handler(negroni.New(append(config["StructureDefinitionTypeHistory"], negroni.HandlerFunc(structuredefinitionController.TypeHistoryHandler))...))
+	structuredefinitionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	structuredefinition := router.Path("/StructureDefinition/{id}").Subrouter()
 	structuredefinition.Methods("GET").Handler(negroni.New(append(config["StructureDefinitionShow"], negroni.HandlerFunc(structuredefinitionController.ShowHandler))...))
 	structuredefinition.Methods("PUT").Handler(negroni.New(append(config["StructureDefinitionUpdate"], negroni.HandlerFunc(structuredefinitionController.UpdateHandler))...))
+	structuredefinition.Methods("PATCH").Handler(negroni.New(append(config["StructureDefinitionUpdate"], negroni.HandlerFunc(structuredefinitionController.PatchHandler))...))
 	structuredefinition.Methods("DELETE").Handler(negroni.New(append(config["StructureDefinitionDelete"], negroni.HandlerFunc(structuredefinitionController.DeleteHandler))...))
+	structuredefinition.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	structuredefinitionInstanceHistory := router.Path("/StructureDefinition/{id}/_history").Subrouter()
+	structuredefinitionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["StructureDefinitionInstanceHistory"], negroni.HandlerFunc(structuredefinitionController.InstanceHistoryHandler))...))
+	structuredefinitionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	structuredefinitionVersion := router.Path("/StructureDefinition/{id}/_history/{vid}").Subrouter()
+	structuredefinitionVersion.Methods("GET").Handler(negroni.New(append(config["StructureDefinitionVRead"], negroni.HandlerFunc(structuredefinitionController.VersionReadHandler))...))
+	structuredefinitionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	enrollmentrequestController := ResourceController{"EnrollmentRequest"}
 	enrollmentrequestBase := router.Path("/EnrollmentRequest").Subrouter()
 	enrollmentrequestBase.Methods("GET").Handler(negroni.New(append(config["EnrollmentRequestIndex"], negroni.HandlerFunc(enrollmentrequestController.IndexHandler))...))
 	enrollmentrequestBase.Methods("POST").Handler(negroni.New(append(config["EnrollmentRequestCreate"], negroni.HandlerFunc(enrollmentrequestController.CreateHandler))...))
+	enrollmentrequestBase.Methods("PUT").Handler(negroni.New(append(config["EnrollmentRequestConditionalUpdate"], negroni.HandlerFunc(enrollmentrequestController.ConditionalUpdateHandler))...))
+	enrollmentrequestBase.Methods("DELETE").Handler(negroni.New(append(config["EnrollmentRequestConditionalDelete"], negroni.HandlerFunc(enrollmentrequestController.ConditionalDeleteHandler))...))
+	enrollmentrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	enrollmentrequestTypeHistory := router.Path("/EnrollmentRequest/_history").Subrouter()
+	enrollmentrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["EnrollmentRequestTypeHistory"], negroni.HandlerFunc(enrollmentrequestController.TypeHistoryHandler))...))
+	enrollmentrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	enrollmentrequest := router.Path("/EnrollmentRequest/{id}").Subrouter()
 	enrollmentrequest.Methods("GET").Handler(negroni.New(append(config["EnrollmentRequestShow"], negroni.HandlerFunc(enrollmentrequestController.ShowHandler))...))
 	enrollmentrequest.Methods("PUT").Handler(negroni.New(append(config["EnrollmentRequestUpdate"], negroni.HandlerFunc(enrollmentrequestController.UpdateHandler))...))
+	enrollmentrequest.Methods("PATCH").Handler(negroni.New(append(config["EnrollmentRequestUpdate"], negroni.HandlerFunc(enrollmentrequestController.PatchHandler))...))
 	enrollmentrequest.Methods("DELETE").Handler(negroni.New(append(config["EnrollmentRequestDelete"], negroni.HandlerFunc(enrollmentrequestController.DeleteHandler))...))
+	enrollmentrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	enrollmentrequestInstanceHistory := router.Path("/EnrollmentRequest/{id}/_history").Subrouter()
+	enrollmentrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EnrollmentRequestInstanceHistory"], negroni.HandlerFunc(enrollmentrequestController.InstanceHistoryHandler))...))
+	enrollmentrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	enrollmentrequestVersion := router.Path("/EnrollmentRequest/{id}/_history/{vid}").Subrouter()
+	enrollmentrequestVersion.Methods("GET").Handler(negroni.New(append(config["EnrollmentRequestVRead"], negroni.HandlerFunc(enrollmentrequestController.VersionReadHandler))...))
+	enrollmentrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	episodeofcareController := ResourceController{"EpisodeOfCare"}
 	episodeofcareBase := router.Path("/EpisodeOfCare").Subrouter()
 	episodeofcareBase.Methods("GET").Handler(negroni.New(append(config["EpisodeOfCareIndex"], negroni.HandlerFunc(episodeofcareController.IndexHandler))...))
 	episodeofcareBase.Methods("POST").Handler(negroni.New(append(config["EpisodeOfCareCreate"], negroni.HandlerFunc(episodeofcareController.CreateHandler))...))
+	episodeofcareBase.Methods("PUT").Handler(negroni.New(append(config["EpisodeOfCareConditionalUpdate"], negroni.HandlerFunc(episodeofcareController.ConditionalUpdateHandler))...))
+	episodeofcareBase.Methods("DELETE").Handler(negroni.New(append(config["EpisodeOfCareConditionalDelete"], negroni.HandlerFunc(episodeofcareController.ConditionalDeleteHandler))...))
+	episodeofcareBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	episodeofcareTypeHistory := router.Path("/EpisodeOfCare/_history").Subrouter()
+	episodeofcareTypeHistory.Methods("GET").Handler(negroni.New(append(config["EpisodeOfCareTypeHistory"], negroni.HandlerFunc(episodeofcareController.TypeHistoryHandler))...))
+	episodeofcareTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	episodeofcare := router.Path("/EpisodeOfCare/{id}").Subrouter()
 	episodeofcare.Methods("GET").Handler(negroni.New(append(config["EpisodeOfCareShow"], negroni.HandlerFunc(episodeofcareController.ShowHandler))...))
 	episodeofcare.Methods("PUT").Handler(negroni.New(append(config["EpisodeOfCareUpdate"], negroni.HandlerFunc(episodeofcareController.UpdateHandler))...))
+	episodeofcare.Methods("PATCH").Handler(negroni.New(append(config["EpisodeOfCareUpdate"], negroni.HandlerFunc(episodeofcareController.PatchHandler))...))
 	episodeofcare.Methods("DELETE").Handler(negroni.New(append(config["EpisodeOfCareDelete"], negroni.HandlerFunc(episodeofcareController.DeleteHandler))...))
+	episodeofcare.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	episodeofcareInstanceHistory := router.Path("/EpisodeOfCare/{id}/_history").Subrouter()
+	episodeofcareInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EpisodeOfCareInstanceHistory"], negroni.HandlerFunc(episodeofcareController.InstanceHistoryHandler))...))
+	episodeofcareInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	episodeofcareVersion := router.Path("/EpisodeOfCare/{id}/_history/{vid}").Subrouter()
+	episodeofcareVersion.Methods("GET").Handler(negroni.New(append(config["EpisodeOfCareVRead"], negroni.HandlerFunc(episodeofcareController.VersionReadHandler))...))
+	episodeofcareVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	operationoutcomeController := ResourceController{"OperationOutcome"}
 	operationoutcomeBase := router.Path("/OperationOutcome").Subrouter()
 	operationoutcomeBase.Methods("GET").Handler(negroni.New(append(config["OperationOutcomeIndex"], negroni.HandlerFunc(operationoutcomeController.IndexHandler))...))
 	operationoutcomeBase.Methods("POST").Handler(negroni.New(append(config["OperationOutcomeCreate"], negroni.HandlerFunc(operationoutcomeController.CreateHandler))...))
+	operationoutcomeBase.Methods("PUT").Handler(negroni.New(append(config["OperationOutcomeConditionalUpdate"], negroni.HandlerFunc(operationoutcomeController.ConditionalUpdateHandler))...))
+	operationoutcomeBase.Methods("DELETE").Handler(negroni.New(append(config["OperationOutcomeConditionalDelete"], negroni.HandlerFunc(operationoutcomeController.ConditionalDeleteHandler))...))
+	operationoutcomeBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	operationoutcomeTypeHistory := router.Path("/OperationOutcome/_history").Subrouter()
+	operationoutcomeTypeHistory.Methods("GET").Handler(negroni.New(append(config["OperationOutcomeTypeHistory"], negroni.HandlerFunc(operationoutcomeController.TypeHistoryHandler))...))
+	operationoutcomeTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	operationoutcome := router.Path("/OperationOutcome/{id}").Subrouter()
 	operationoutcome.Methods("GET").Handler(negroni.New(append(config["OperationOutcomeShow"], negroni.HandlerFunc(operationoutcomeController.ShowHandler))...))
 	operationoutcome.Methods("PUT").Handler(negroni.New(append(config["OperationOutcomeUpdate"], negroni.HandlerFunc(operationoutcomeController.UpdateHandler))...))
+	operationoutcome.Methods("PATCH").Handler(negroni.New(append(config["OperationOutcomeUpdate"], negroni.HandlerFunc(operationoutcomeController.PatchHandler))...))
 	operationoutcome.Methods("DELETE").Handler(negroni.New(append(config["OperationOutcomeDelete"], negroni.HandlerFunc(operationoutcomeController.DeleteHandler))...))
+	operationoutcome.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	operationoutcomeInstanceHistory := router.Path("/OperationOutcome/{id}/_history").Subrouter()
+	operationoutcomeInstanceHistory.Methods("GET").Handler(negroni.New(append(config["OperationOutcomeInstanceHistory"], negroni.HandlerFunc(operationoutcomeController.InstanceHistoryHandler))...))
+	operationoutcomeInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	operationoutcomeVersion := router.Path("/OperationOutcome/{id}/_history/{vid}").Subrouter()
+	operationoutcomeVersion.Methods("GET").Handler(negroni.New(append(config["OperationOutcomeVRead"], negroni.HandlerFunc(operationoutcomeController.VersionReadHandler))...))
+	operationoutcomeVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationController := ResourceController{"Medication"}
 	medicationBase := router.Path("/Medication").Subrouter()
 	medicationBase.Methods("GET").Handler(negroni.New(append(config["MedicationIndex"], negroni.HandlerFunc(medicationController.IndexHandler))...))
 	medicationBase.Methods("POST").Handler(negroni.New(append(config["MedicationCreate"], negroni.HandlerFunc(medicationController.CreateHandler))...))
+	medicationBase.Methods("PUT").Handler(negroni.New(append(config["MedicationConditionalUpdate"], negroni.HandlerFunc(medicationController.ConditionalUpdateHandler))...))
+	medicationBase.Methods("DELETE").Handler(negroni.New(append(config["MedicationConditionalDelete"], negroni.HandlerFunc(medicationController.ConditionalDeleteHandler))...))
+	medicationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	medicationTypeHistory := router.Path("/Medication/_history").Subrouter()
+	medicationTypeHistory.Methods("GET").Handler(negroni.New(append(config["MedicationTypeHistory"], negroni.HandlerFunc(medicationController.TypeHistoryHandler))...))
+	medicationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medication := router.Path("/Medication/{id}").Subrouter()
 	medication.Methods("GET").Handler(negroni.New(append(config["MedicationShow"], negroni.HandlerFunc(medicationController.ShowHandler))...))
 	medication.Methods("PUT").Handler(negroni.New(append(config["MedicationUpdate"], negroni.HandlerFunc(medicationController.UpdateHandler))...))
+	medication.Methods("PATCH").Handler(negroni.New(append(config["MedicationUpdate"], negroni.HandlerFunc(medicationController.PatchHandler))...))
 	medication.Methods("DELETE").Handler(negroni.New(append(config["MedicationDelete"], negroni.HandlerFunc(medicationController.DeleteHandler))...))
+	medication.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	medicationInstanceHistory := router.Path("/Medication/{id}/_history").Subrouter()
+	medicationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MedicationInstanceHistory"], negroni.HandlerFunc(medicationController.InstanceHistoryHandler))...))
+	medicationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	medicationVersion := router.Path("/Medication/{id}/_history/{vid}").Subrouter()
+	medicationVersion.Methods("GET").Handler(negroni.New(append(config["MedicationVRead"], negroni.HandlerFunc(medicationController.VersionReadHandler))...))
+	medicationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	procedureController := ResourceController{"Procedure"}
 	procedureBase := router.Path("/Procedure").Subrouter()
 	procedureBase.Methods("GET").Handler(negroni.New(append(config["ProcedureIndex"], negroni.HandlerFunc(procedureController.IndexHandler))...))
 	procedureBase.Methods("POST").Handler(negroni.New(append(config["ProcedureCreate"], negroni.HandlerFunc(procedureController.CreateHandler))...))
+	procedureBase.Methods("PUT").Handler(negroni.New(append(config["ProcedureConditionalUpdate"], negroni.HandlerFunc(procedureController.ConditionalUpdateHandler))...))
+	procedureBase.Methods("DELETE").Handler(negroni.New(append(config["ProcedureConditionalDelete"], negroni.HandlerFunc(procedureController.ConditionalDeleteHandler))...))
+	procedureBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	procedureTypeHistory := router.Path("/Procedure/_history").Subrouter()
+	procedureTypeHistory.Methods("GET").Handler(negroni.New(append(config["ProcedureTypeHistory"], negroni.HandlerFunc(procedureController.TypeHistoryHandler))...))
+	procedureTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	procedure := router.Path("/Procedure/{id}").Subrouter()
 	procedure.Methods("GET").Handler(negroni.New(append(config["ProcedureShow"], negroni.HandlerFunc(procedureController.ShowHandler))...))
 	procedure.Methods("PUT").Handler(negroni.New(append(config["ProcedureUpdate"], negroni.HandlerFunc(procedureController.UpdateHandler))...))
+	procedure.Methods("PATCH").Handler(negroni.New(append(config["ProcedureUpdate"], negroni.HandlerFunc(procedureController.PatchHandler))...))
 	procedure.Methods("DELETE").Handler(negroni.New(append(config["ProcedureDelete"], negroni.HandlerFunc(procedureController.DeleteHandler))...))
+	procedure.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	procedureInstanceHistory := router.Path("/Procedure/{id}/_history").Subrouter()
+	procedureInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ProcedureInstanceHistory"], negroni.HandlerFunc(procedureController.InstanceHistoryHandler))...))
+	procedureInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	procedureVersion := router.Path("/Procedure/{id}/_history/{vid}").Subrouter()
+	procedureVersion.Methods("GET").Handler(negroni.New(append(config["ProcedureVRead"], negroni.HandlerFunc(procedureController.VersionReadHandler))...))
+	procedureVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	listController := ResourceController{"List"}
 	listBase := router.Path("/List").Subrouter()
 	listBase.Methods("GET").Handler(negroni.New(append(config["ListIndex"], negroni.HandlerFunc(listController.IndexHandler))...))
 	listBase.Methods("POST").Handler(negroni.New(append(config["ListCreate"], negroni.HandlerFunc(listController.CreateHandler))...))
+	listBase.Methods("PUT").Handler(negroni.New(append(config["ListConditionalUpdate"], negroni.HandlerFunc(listController.ConditionalUpdateHandler))...))
+	listBase.Methods("DELETE").Handler(negroni.New(append(config["ListConditionalDelete"], negroni.HandlerFunc(listController.ConditionalDeleteHandler))...))
+	listBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	listTypeHistory := router.Path("/List/_history").Subrouter()
+	listTypeHistory.Methods("GET").Handler(negroni.New(append(config["ListTypeHistory"], negroni.HandlerFunc(listController.TypeHistoryHandler))...))
+	listTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	list := router.Path("/List/{id}").Subrouter()
 	list.Methods("GET").Handler(negroni.New(append(config["ListShow"], negroni.HandlerFunc(listController.ShowHandler))...))
 	list.Methods("PUT").Handler(negroni.New(append(config["ListUpdate"], negroni.HandlerFunc(listController.UpdateHandler))...))
+	list.Methods("PATCH").Handler(negroni.New(append(config["ListUpdate"], negroni.HandlerFunc(listController.PatchHandler))...))
 	list.Methods("DELETE").Handler(negroni.New(append(config["ListDelete"], negroni.HandlerFunc(listController.DeleteHandler))...))
+	list.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	listInstanceHistory := router.Path("/List/{id}/_history").Subrouter()
+	listInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ListInstanceHistory"], negroni.HandlerFunc(listController.InstanceHistoryHandler))...))
+	listInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	listVersion := router.Path("/List/{id}/_history/{vid}").Subrouter()
+	listVersion.Methods("GET").Handler(negroni.New(append(config["ListVRead"], negroni.HandlerFunc(listController.VersionReadHandler))...))
+	listVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	conceptmapController := ResourceController{"ConceptMap"}
 	conceptmapBase := router.Path("/ConceptMap").Subrouter()
 	conceptmapBase.Methods("GET").Handler(negroni.New(append(config["ConceptMapIndex"], negroni.HandlerFunc(conceptmapController.IndexHandler))...))
 	conceptmapBase.Methods("POST").Handler(negroni.New(append(config["ConceptMapCreate"], negroni.HandlerFunc(conceptmapController.CreateHandler))...))
+	conceptmapBase.Methods("PUT").Handler(negroni.New(append(config["ConceptMapConditionalUpdate"], negroni.HandlerFunc(conceptmapController.ConditionalUpdateHandler))...))
+	conceptmapBase.Methods("DELETE").Handler(negroni.New(append(config["ConceptMapConditionalDelete"], negroni.HandlerFunc(conceptmapController.ConditionalDeleteHandler))...))
+	conceptmapBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	conceptmapTypeHistory := router.Path("/ConceptMap/_history").Subrouter()
+	conceptmapTypeHistory.Methods("GET").Handler(negroni.New(append(config["ConceptMapTypeHistory"], negroni.HandlerFunc(conceptmapController.TypeHistoryHandler))...))
+	conceptmapTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	conceptmap := router.Path("/ConceptMap/{id}").Subrouter()
 	conceptmap.Methods("GET").Handler(negroni.New(append(config["ConceptMapShow"], negroni.HandlerFunc(conceptmapController.ShowHandler))...))
 	conceptmap.Methods("PUT").Handler(negroni.New(append(config["ConceptMapUpdate"], negroni.HandlerFunc(conceptmapController.UpdateHandler))...))
+	conceptmap.Methods("PATCH").Handler(negroni.New(append(config["ConceptMapUpdate"], negroni.HandlerFunc(conceptmapController.PatchHandler))...))
 	conceptmap.Methods("DELETE").Handler(negroni.New(append(config["ConceptMapDelete"], negroni.HandlerFunc(conceptmapController.DeleteHandler))...))
+	conceptmap.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	conceptmapInstanceHistory := router.Path("/ConceptMap/{id}/_history").Subrouter()
+	conceptmapInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ConceptMapInstanceHistory"], negroni.HandlerFunc(conceptmapController.InstanceHistoryHandler))...))
+	conceptmapInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	conceptmapVersion := router.Path("/ConceptMap/{id}/_history/{vid}").Subrouter()
+	conceptmapVersion.Methods("GET").Handler(negroni.New(append(config["ConceptMapVRead"], negroni.HandlerFunc(conceptmapController.VersionReadHandler))...))
+	conceptmapVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	subscriptionController := ResourceController{"Subscription"}
 	subscriptionBase := router.Path("/Subscription").Subrouter()
 	subscriptionBase.Methods("GET").Handler(negroni.New(append(config["SubscriptionIndex"], negroni.HandlerFunc(subscriptionController.IndexHandler))...))
 	subscriptionBase.Methods("POST").Handler(negroni.New(append(config["SubscriptionCreate"], negroni.HandlerFunc(subscriptionController.CreateHandler))...))
+	subscriptionBase.Methods("PUT").Handler(negroni.New(append(config["SubscriptionConditionalUpdate"], negroni.HandlerFunc(subscriptionController.ConditionalUpdateHandler))...))
+	subscriptionBase.Methods("DELETE").Handler(negroni.New(append(config["SubscriptionConditionalDelete"], negroni.HandlerFunc(subscriptionController.ConditionalDeleteHandler))...))
+	subscriptionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	subscriptionTypeHistory := router.Path("/Subscription/_history").Subrouter()
+	subscriptionTypeHistory.Methods("GET").Handler(negroni.New(append(config["SubscriptionTypeHistory"], negroni.HandlerFunc(subscriptionController.TypeHistoryHandler))...))
+	subscriptionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	subscription := router.Path("/Subscription/{id}").Subrouter()
 	subscription.Methods("GET").Handler(negroni.New(append(config["SubscriptionShow"], negroni.HandlerFunc(subscriptionController.ShowHandler))...))
 	subscription.Methods("PUT").Handler(negroni.New(append(config["SubscriptionUpdate"], negroni.HandlerFunc(subscriptionController.UpdateHandler))...))
+	subscription.Methods("PATCH").Handler(negroni.New(append(config["SubscriptionUpdate"], negroni.HandlerFunc(subscriptionController.PatchHandler))...))
 	subscription.Methods("DELETE").Handler(negroni.New(append(config["SubscriptionDelete"], negroni.HandlerFunc(subscriptionController.DeleteHandler))...))
+	subscription.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	subscriptionInstanceHistory := router.Path("/Subscription/{id}/_history").Subrouter()
+	subscriptionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SubscriptionInstanceHistory"], negroni.HandlerFunc(subscriptionController.InstanceHistoryHandler))...))
+	subscriptionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	subscriptionVersion := router.Path("/Subscription/{id}/_history/{vid}").Subrouter()
+	subscriptionVersion.Methods("GET").Handler(negroni.New(append(config["SubscriptionVRead"], negroni.HandlerFunc(subscriptionController.VersionReadHandler))...))
+	subscriptionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	valuesetController := ResourceController{"ValueSet"}
 	valuesetBase := router.Path("/ValueSet").Subrouter()
 	valuesetBase.Methods("GET").Handler(negroni.New(append(config["ValueSetIndex"], negroni.HandlerFunc(valuesetController.IndexHandler))...))
 	valuesetBase.Methods("POST").Handler(negroni.New(append(config["ValueSetCreate"], negroni.HandlerFunc(valuesetController.CreateHandler))...))
+	valuesetBase.Methods("PUT").Handler(negroni.New(append(config["ValueSetConditionalUpdate"], negroni.HandlerFunc(valuesetController.ConditionalUpdateHandler))...))
+	valuesetBase.Methods("DELETE").Handler(negroni.New(append(config["ValueSetConditionalDelete"], negroni.HandlerFunc(valuesetController.ConditionalDeleteHandler))...))
+	valuesetBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	valuesetTypeHistory := router.Path("/ValueSet/_history").Subrouter()
+	valuesetTypeHistory.Methods("GET").Handler(negroni.New(append(config["ValueSetTypeHistory"], negroni.HandlerFunc(valuesetController.TypeHistoryHandler))...))
+	valuesetTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	valueset := router.Path("/ValueSet/{id}").Subrouter()
 	valueset.Methods("GET").Handler(negroni.New(append(config["ValueSetShow"], negroni.HandlerFunc(valuesetController.ShowHandler))...))
 	valueset.Methods("PUT").Handler(negroni.New(append(config["ValueSetUpdate"], negroni.HandlerFunc(valuesetController.UpdateHandler))...))
+	valueset.Methods("PATCH").Handler(negroni.New(append(config["ValueSetUpdate"], negroni.HandlerFunc(valuesetController.PatchHandler))...))
 	valueset.Methods("DELETE").Handler(negroni.New(append(config["ValueSetDelete"], negroni.HandlerFunc(valuesetController.DeleteHandler))...))
+	valueset.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	valuesetInstanceHistory := router.Path("/ValueSet/{id}/_history").Subrouter()
+	valuesetInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ValueSetInstanceHistory"], negroni.HandlerFunc(valuesetController.InstanceHistoryHandler))...))
+	valuesetInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	valuesetVersion := router.Path("/ValueSet/{id}/_history/{vid}").Subrouter()
+	valuesetVersion.Methods("GET").Handler(negroni.New(append(config["ValueSetVRead"], negroni.HandlerFunc(valuesetController.VersionReadHandler))...))
+	valuesetVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	operationdefinitionController := ResourceController{"OperationDefinition"}
 	operationdefinitionBase := router.Path("/OperationDefinition").Subrouter()
 	operationdefinitionBase.Methods("GET").Handler(negroni.New(append(config["OperationDefinitionIndex"], negroni.HandlerFunc(operationdefinitionController.IndexHandler))...))
 	operationdefinitionBase.Methods("POST").Handler(negroni.New(append(config["OperationDefinitionCreate"], negroni.HandlerFunc(operationdefinitionController.CreateHandler))...))
+	operationdefinitionBase.Methods("PUT").Handler(negroni.New(append(config["OperationDefinitionConditionalUpdate"], negroni.HandlerFunc(operationdefinitionController.ConditionalUpdateHandler))...))
+	operationdefinitionBase.Methods("DELETE").Handler(negroni.New(append(config["OperationDefinitionConditionalDelete"], negroni.HandlerFunc(operationdefinitionController.ConditionalDeleteHandler))...))
+	operationdefinitionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	operationdefinitionTypeHistory := router.Path("/OperationDefinition/_history").Subrouter()
+	operationdefinitionTypeHistory.Methods("GET").Handler(negroni.New(append(config["OperationDefinitionTypeHistory"], negroni.HandlerFunc(operationdefinitionController.TypeHistoryHandler))...))
+	operationdefinitionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	operationdefinition := router.Path("/OperationDefinition/{id}").Subrouter()
 	operationdefinition.Methods("GET").Handler(negroni.New(append(config["OperationDefinitionShow"], negroni.HandlerFunc(operationdefinitionController.ShowHandler))...))
 	operationdefinition.Methods("PUT").Handler(negroni.New(append(config["OperationDefinitionUpdate"], negroni.HandlerFunc(operationdefinitionController.UpdateHandler))...))
+	operationdefinition.Methods("PATCH").Handler(negroni.New(append(config["OperationDefinitionUpdate"], negroni.HandlerFunc(operationdefinitionController.PatchHandler))...))
 	operationdefinition.Methods("DELETE").Handler(negroni.New(append(config["OperationDefinitionDelete"], negroni.HandlerFunc(operationdefinitionController.DeleteHandler))...))
+	operationdefinition.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	operationdefinitionInstanceHistory := router.Path("/OperationDefinition/{id}/_history").Subrouter()
+	operationdefinitionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["OperationDefinitionInstanceHistory"], negroni.HandlerFunc(operationdefinitionController.InstanceHistoryHandler))...))
+	operationdefinitionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	operationdefinitionVersion := router.Path("/OperationDefinition/{id}/_history/{vid}").Subrouter()
+	operationdefinitionVersion.Methods("GET").Handler(negroni.New(append(config["OperationDefinitionVRead"], negroni.HandlerFunc(operationdefinitionController.VersionReadHandler))...))
+	operationdefinitionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	documentreferenceController := ResourceController{"DocumentReference"}
 	documentreferenceBase := router.Path("/DocumentReference").Subrouter()
 	documentreferenceBase.Methods("GET").Handler(negroni.New(append(config["DocumentReferenceIndex"], negroni.HandlerFunc(documentreferenceController.IndexHandler))...))
 	documentreferenceBase.Methods("POST").Handler(negroni.New(append(config["DocumentReferenceCreate"], negroni.HandlerFunc(documentreferenceController.CreateHandler))...))
+	documentreferenceBase.Methods("PUT").Handler(negroni.New(append(config["DocumentReferenceConditionalUpdate"], negroni.HandlerFunc(documentreferenceController.ConditionalUpdateHandler))...))
+	documentreferenceBase.Methods("DELETE").Handler(negroni.New(append(config["DocumentReferenceConditionalDelete"], negroni.HandlerFunc(documentreferenceController.ConditionalDeleteHandler))...))
+	documentreferenceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	documentreferenceTypeHistory := router.Path("/DocumentReference/_history").Subrouter()
+	documentreferenceTypeHistory.Methods("GET").Handler(negroni.New(append(config["DocumentReferenceTypeHistory"], negroni.HandlerFunc(documentreferenceController.TypeHistoryHandler))...))
+	documentreferenceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	documentreference := router.Path("/DocumentReference/{id}").Subrouter()
 	documentreference.Methods("GET").Handler(negroni.New(append(config["DocumentReferenceShow"], negroni.HandlerFunc(documentreferenceController.ShowHandler))...))
 	documentreference.Methods("PUT").Handler(negroni.New(append(config["DocumentReferenceUpdate"], negroni.HandlerFunc(documentreferenceController.UpdateHandler))...))
+	documentreference.Methods("PATCH").Handler(negroni.New(append(config["DocumentReferenceUpdate"], negroni.HandlerFunc(documentreferenceController.PatchHandler))...))
 	documentreference.Methods("DELETE").Handler(negroni.New(append(config["DocumentReferenceDelete"], negroni.HandlerFunc(documentreferenceController.DeleteHandler))...))
+	documentreference.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	documentreferenceInstanceHistory := router.Path("/DocumentReference/{id}/_history").Subrouter()
+	documentreferenceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DocumentReferenceInstanceHistory"], negroni.HandlerFunc(documentreferenceController.InstanceHistoryHandler))...))
+	documentreferenceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	documentreferenceVersion := router.Path("/DocumentReference/{id}/_history/{vid}").Subrouter()
+	documentreferenceVersion.Methods("GET").Handler(negroni.New(append(config["DocumentReferenceVRead"], negroni.HandlerFunc(documentreferenceController.VersionReadHandler))...))
+	documentreferenceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	orderController := ResourceController{"Order"}
 	orderBase := router.Path("/Order").Subrouter()
 	orderBase.Methods("GET").Handler(negroni.New(append(config["OrderIndex"], negroni.HandlerFunc(orderController.IndexHandler))...))
 	orderBase.Methods("POST").Handler(negroni.New(append(config["OrderCreate"], negroni.HandlerFunc(orderController.CreateHandler))...))
+	orderBase.Methods("PUT").Handler(negroni.New(append(config["OrderConditionalUpdate"], negroni.HandlerFunc(orderController.ConditionalUpdateHandler))...))
+	orderBase.Methods("DELETE").Handler(negroni.New(append(config["OrderConditionalDelete"], negroni.HandlerFunc(orderController.ConditionalDeleteHandler))...))
+	orderBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	orderTypeHistory := router.Path("/Order/_history").Subrouter()
+	orderTypeHistory.Methods("GET").Handler(negroni.New(append(config["OrderTypeHistory"], negroni.HandlerFunc(orderController.TypeHistoryHandler))...))
+	orderTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	order := router.Path("/Order/{id}").Subrouter()
 	order.Methods("GET").Handler(negroni.New(append(config["OrderShow"], negroni.HandlerFunc(orderController.ShowHandler))...))
 	order.Methods("PUT").Handler(negroni.New(append(config["OrderUpdate"], negroni.HandlerFunc(orderController.UpdateHandler))...))
+	order.Methods("PATCH").Handler(negroni.New(append(config["OrderUpdate"], negroni.HandlerFunc(orderController.PatchHandler))...))
 	order.Methods("DELETE").Handler(negroni.New(append(config["OrderDelete"], negroni.HandlerFunc(orderController.DeleteHandler))...))
+	order.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	orderInstanceHistory := router.Path("/Order/{id}/_history").Subrouter()
+	orderInstanceHistory.Methods("GET").Handler(negroni.New(append(config["OrderInstanceHistory"], negroni.HandlerFunc(orderController.InstanceHistoryHandler))...))
+	orderInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	orderVersion := router.Path("/Order/{id}/_history/{vid}").Subrouter()
+	orderVersion.Methods("GET").Handler(negroni.New(append(config["OrderVRead"], negroni.HandlerFunc(orderController.VersionReadHandler))...))
+	orderVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	immunizationController := ResourceController{"Immunization"}
 	immunizationBase := router.Path("/Immunization").Subrouter()
 	immunizationBase.Methods("GET").Handler(negroni.New(append(config["ImmunizationIndex"], negroni.HandlerFunc(immunizationController.IndexHandler))...))
 	immunizationBase.Methods("POST").Handler(negroni.New(append(config["ImmunizationCreate"], negroni.HandlerFunc(immunizationController.CreateHandler))...))
+	immunizationBase.Methods("PUT").Handler(negroni.New(append(config["ImmunizationConditionalUpdate"], negroni.HandlerFunc(immunizationController.ConditionalUpdateHandler))...))
+	immunizationBase.Methods("DELETE").Handler(negroni.New(append(config["ImmunizationConditionalDelete"], negroni.HandlerFunc(immunizationController.ConditionalDeleteHandler))...))
+	immunizationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	immunizationTypeHistory := router.Path("/Immunization/_history").Subrouter()
+	immunizationTypeHistory.Methods("GET").Handler(negroni.New(append(config["ImmunizationTypeHistory"], negroni.HandlerFunc(immunizationController.TypeHistoryHandler))...))
+	immunizationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	immunization := router.Path("/Immunization/{id}").Subrouter()
 	immunization.Methods("GET").Handler(negroni.New(append(config["ImmunizationShow"], negroni.HandlerFunc(immunizationController.ShowHandler))...))
 	immunization.Methods("PUT").Handler(negroni.New(append(config["ImmunizationUpdate"], negroni.HandlerFunc(immunizationController.UpdateHandler))...))
+	immunization.Methods("PATCH").Handler(negroni.New(append(config["ImmunizationUpdate"], negroni.HandlerFunc(immunizationController.PatchHandler))...))
 	immunization.Methods("DELETE").Handler(negroni.New(append(config["ImmunizationDelete"], negroni.HandlerFunc(immunizationController.DeleteHandler))...))
+	immunization.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	immunizationInstanceHistory := router.Path("/Immunization/{id}/_history").Subrouter()
+	immunizationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ImmunizationInstanceHistory"], negroni.HandlerFunc(immunizationController.InstanceHistoryHandler))...))
+	immunizationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	immunizationVersion := router.Path("/Immunization/{id}/_history/{vid}").Subrouter()
+	immunizationVersion.Methods("GET").Handler(negroni.New(append(config["ImmunizationVRead"], negroni.HandlerFunc(immunizationController.VersionReadHandler))...))
+	immunizationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	deviceController := ResourceController{"Device"}
 	deviceBase := router.Path("/Device").Subrouter()
 	deviceBase.Methods("GET").Handler(negroni.New(append(config["DeviceIndex"], negroni.HandlerFunc(deviceController.IndexHandler))...))
 	deviceBase.Methods("POST").Handler(negroni.New(append(config["DeviceCreate"], negroni.HandlerFunc(deviceController.CreateHandler))...))
+	deviceBase.Methods("PUT").Handler(negroni.New(append(config["DeviceConditionalUpdate"], negroni.HandlerFunc(deviceController.ConditionalUpdateHandler))...))
+	deviceBase.Methods("DELETE").Handler(negroni.New(append(config["DeviceConditionalDelete"], negroni.HandlerFunc(deviceController.ConditionalDeleteHandler))...))
+	deviceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	deviceTypeHistory := router.Path("/Device/_history").Subrouter()
+	deviceTypeHistory.Methods("GET").Handler(negroni.New(append(config["DeviceTypeHistory"], negroni.HandlerFunc(deviceController.TypeHistoryHandler))...))
+	deviceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	device := router.Path("/Device/{id}").Subrouter()
 	device.Methods("GET").Handler(negroni.New(append(config["DeviceShow"], negroni.HandlerFunc(deviceController.ShowHandler))...))
 	device.Methods("PUT").Handler(negroni.New(append(config["DeviceUpdate"], negroni.HandlerFunc(deviceController.UpdateHandler))...))
+	device.Methods("PATCH").Handler(negroni.New(append(config["DeviceUpdate"], negroni.HandlerFunc(deviceController.PatchHandler))...))
 	device.Methods("DELETE").Handler(negroni.New(append(config["DeviceDelete"], negroni.HandlerFunc(deviceController.DeleteHandler))...))
+	device.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	deviceInstanceHistory := router.Path("/Device/{id}/_history").Subrouter()
+	deviceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DeviceInstanceHistory"], negroni.HandlerFunc(deviceController.InstanceHistoryHandler))...))
+	deviceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	deviceVersion := router.Path("/Device/{id}/_history/{vid}").Subrouter()
+	deviceVersion.Methods("GET").Handler(negroni.New(append(config["DeviceVRead"], negroni.HandlerFunc(deviceController.VersionReadHandler))...))
+	deviceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	visionprescriptionController := ResourceController{"VisionPrescription"}
 	visionprescriptionBase := router.Path("/VisionPrescription").Subrouter()
 	visionprescriptionBase.Methods("GET").Handler(negroni.New(append(config["VisionPrescriptionIndex"], negroni.HandlerFunc(visionprescriptionController.IndexHandler))...))
 	visionprescriptionBase.Methods("POST").Handler(negroni.New(append(config["VisionPrescriptionCreate"], negroni.HandlerFunc(visionprescriptionController.CreateHandler))...))
+	visionprescriptionBase.Methods("PUT").Handler(negroni.New(append(config["VisionPrescriptionConditionalUpdate"], negroni.HandlerFunc(visionprescriptionController.ConditionalUpdateHandler))...))
+	visionprescriptionBase.Methods("DELETE").Handler(negroni.New(append(config["VisionPrescriptionConditionalDelete"], negroni.HandlerFunc(visionprescriptionController.ConditionalDeleteHandler))...))
+	visionprescriptionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	visionprescriptionTypeHistory := router.Path("/VisionPrescription/_history").Subrouter()
+	visionprescriptionTypeHistory.Methods("GET").Handler(negroni.New(append(config["VisionPrescriptionTypeHistory"], negroni.HandlerFunc(visionprescriptionController.TypeHistoryHandler))...))
+	visionprescriptionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	visionprescription := router.Path("/VisionPrescription/{id}").Subrouter()
 	visionprescription.Methods("GET").Handler(negroni.New(append(config["VisionPrescriptionShow"], negroni.HandlerFunc(visionprescriptionController.ShowHandler))...))
 	visionprescription.Methods("PUT").Handler(negroni.New(append(config["VisionPrescriptionUpdate"], negroni.HandlerFunc(visionprescriptionController.UpdateHandler))...))
+	visionprescription.Methods("PATCH").Handler(negroni.New(append(config["VisionPrescriptionUpdate"], negroni.HandlerFunc(visionprescriptionController.PatchHandler))...))
 	visionprescription.Methods("DELETE").Handler(negroni.New(append(config["VisionPrescriptionDelete"], negroni.HandlerFunc(visionprescriptionController.DeleteHandler))...))
+	visionprescription.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	visionprescriptionInstanceHistory := router.Path("/VisionPrescription/{id}/_history").Subrouter()
+	visionprescriptionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["VisionPrescriptionInstanceHistory"], negroni.HandlerFunc(visionprescriptionController.InstanceHistoryHandler))...))
+	visionprescriptionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	visionprescriptionVersion := router.Path("/VisionPrescription/{id}/_history/{vid}").Subrouter()
+	visionprescriptionVersion.Methods("GET").Handler(negroni.New(append(config["VisionPrescriptionVRead"], negroni.HandlerFunc(visionprescriptionController.VersionReadHandler))...))
+	visionprescriptionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	mediaController := ResourceController{"Media"}
 	mediaBase := router.Path("/Media").Subrouter()
 	mediaBase.Methods("GET").Handler(negroni.New(append(config["MediaIndex"], negroni.HandlerFunc(mediaController.IndexHandler))...))
 	mediaBase.Methods("POST").Handler(negroni.New(append(config["MediaCreate"], negroni.HandlerFunc(mediaController.CreateHandler))...))
+	mediaBase.Methods("PUT").Handler(negroni.New(append(config["MediaConditionalUpdate"], negroni.HandlerFunc(mediaController.ConditionalUpdateHandler))...))
+	mediaBase.Methods("DELETE").Handler(negroni.New(append(config["MediaConditionalDelete"], negroni.HandlerFunc(mediaController.ConditionalDeleteHandler))...))
+	mediaBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	mediaTypeHistory := router.Path("/Media/_history").Subrouter()
+	mediaTypeHistory.Methods("GET").Handler(negroni.New(append(config["MediaTypeHistory"], negroni.HandlerFunc(mediaController.TypeHistoryHandler))...))
+	mediaTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	media := router.Path("/Media/{id}").Subrouter()
 	media.Methods("GET").Handler(negroni.New(append(config["MediaShow"], negroni.HandlerFunc(mediaController.ShowHandler))...))
 	media.Methods("PUT").Handler(negroni.New(append(config["MediaUpdate"], negroni.HandlerFunc(mediaController.UpdateHandler))...))
+	media.Methods("PATCH").Handler(negroni.New(append(config["MediaUpdate"], negroni.HandlerFunc(mediaController.PatchHandler))...))
 	media.Methods("DELETE").Handler(negroni.New(append(config["MediaDelete"], negroni.HandlerFunc(mediaController.DeleteHandler))...))
+	media.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	mediaInstanceHistory := router.Path("/Media/{id}/_history").Subrouter()
+	mediaInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MediaInstanceHistory"], negroni.HandlerFunc(mediaController.InstanceHistoryHandler))...))
+	mediaInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	mediaVersion := router.Path("/Media/{id}/_history/{vid}").Subrouter()
+	mediaVersion.Methods("GET").Handler(negroni.New(append(config["MediaVRead"], negroni.HandlerFunc(mediaController.VersionReadHandler))...))
+	mediaVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	conformanceController := ResourceController{"Conformance"}
 	conformanceBase := router.Path("/Conformance").Subrouter()
 	conformanceBase.Methods("GET").Handler(negroni.New(append(config["ConformanceIndex"], negroni.HandlerFunc(conformanceController.IndexHandler))...))
 	conformanceBase.Methods("POST").Handler(negroni.New(append(config["ConformanceCreate"], negroni.HandlerFunc(conformanceController.CreateHandler))...))
+	conformanceBase.Methods("PUT").Handler(negroni.New(append(config["ConformanceConditionalUpdate"], negroni.HandlerFunc(conformanceController.ConditionalUpdateHandler))...))
+	conformanceBase.Methods("DELETE").Handler(negroni.New(append(config["ConformanceConditionalDelete"], negroni.HandlerFunc(conformanceController.ConditionalDeleteHandler))...))
+	conformanceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	conformanceTypeHistory := router.Path("/Conformance/_history").Subrouter()
+	conformanceTypeHistory.Methods("GET").Handler(negroni.New(append(config["ConformanceTypeHistory"], negroni.HandlerFunc(conformanceController.TypeHistoryHandler))...))
+	conformanceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	conformance := router.Path("/Conformance/{id}").Subrouter()
 	conformance.Methods("GET").Handler(negroni.New(append(config["ConformanceShow"], negroni.HandlerFunc(conformanceController.ShowHandler))...))
 	conformance.Methods("PUT").Handler(negroni.New(append(config["ConformanceUpdate"], negroni.HandlerFunc(conformanceController.UpdateHandler))...))
+	conformance.Methods("PATCH").Handler(negroni.New(append(config["ConformanceUpdate"], negroni.HandlerFunc(conformanceController.PatchHandler))...))
 	conformance.Methods("DELETE").Handler(negroni.New(append(config["ConformanceDelete"], negroni.HandlerFunc(conformanceController.DeleteHandler))...))
+	conformance.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	conformanceInstanceHistory := router.Path("/Conformance/{id}/_history").Subrouter()
+	conformanceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ConformanceInstanceHistory"], negroni.HandlerFunc(conformanceController.InstanceHistoryHandler))...))
+	conformanceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	conformanceVersion := router.Path("/Conformance/{id}/_history/{vid}").Subrouter()
+	conformanceVersion.Methods("GET").Handler(negroni.New(append(config["ConformanceVRead"], negroni.HandlerFunc(conformanceController.VersionReadHandler))...))
+	conformanceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	procedurerequestController := ResourceController{"ProcedureRequest"}
 	procedurerequestBase := router.Path("/ProcedureRequest").Subrouter()
 	procedurerequestBase.Methods("GET").Handler(negroni.New(append(config["ProcedureRequestIndex"], negroni.HandlerFunc(procedurerequestController.IndexHandler))...))
 	procedurerequestBase.Methods("POST").Handler(negroni.New(append(config["ProcedureRequestCreate"], negroni.HandlerFunc(procedurerequestController.CreateHandler))...))
+	procedurerequestBase.Methods("PUT").Handler(negroni.New(append(config["ProcedureRequestConditionalUpdate"], negroni.HandlerFunc(procedurerequestController.ConditionalUpdateHandler))...))
+	procedurerequestBase.Methods("DELETE").Handler(negroni.New(append(config["ProcedureRequestConditionalDelete"], negroni.HandlerFunc(procedurerequestController.ConditionalDeleteHandler))...))
+	procedurerequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	procedurerequestTypeHistory := router.Path("/ProcedureRequest/_history").Subrouter()
+	procedurerequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["ProcedureRequestTypeHistory"], negroni.HandlerFunc(procedurerequestController.TypeHistoryHandler))...))
+	procedurerequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	procedurerequest := router.Path("/ProcedureRequest/{id}").Subrouter()
 	procedurerequest.Methods("GET").Handler(negroni.New(append(config["ProcedureRequestShow"], negroni.HandlerFunc(procedurerequestController.ShowHandler))...))
 	procedurerequest.Methods("PUT").Handler(negroni.New(append(config["ProcedureRequestUpdate"], negroni.HandlerFunc(procedurerequestController.UpdateHandler))...))
+	procedurerequest.Methods("PATCH").Handler(negroni.New(append(config["ProcedureRequestUpdate"], negroni.HandlerFunc(procedurerequestController.PatchHandler))...))
 	procedurerequest.Methods("DELETE").Handler(negroni.New(append(config["ProcedureRequestDelete"], negroni.HandlerFunc(procedurerequestController.DeleteHandler))...))
+	procedurerequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	procedurerequestInstanceHistory := router.Path("/ProcedureRequest/{id}/_history").Subrouter()
+	procedurerequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ProcedureRequestInstanceHistory"], negroni.HandlerFunc(procedurerequestController.InstanceHistoryHandler))...))
+	procedurerequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	procedurerequestVersion := router.Path("/ProcedureRequest/{id}/_history/{vid}").Subrouter()
+	procedurerequestVersion.Methods("GET").Handler(negroni.New(append(config["ProcedureRequestVRead"], negroni.HandlerFunc(procedurerequestController.VersionReadHandler))...))
+	procedurerequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	eligibilityresponseController := ResourceController{"EligibilityResponse"}
 	eligibilityresponseBase := router.Path("/EligibilityResponse").Subrouter()
 	eligibilityresponseBase.Methods("GET").Handler(negroni.New(append(config["EligibilityResponseIndex"], negroni.HandlerFunc(eligibilityresponseController.IndexHandler))...))
 	eligibilityresponseBase.Methods("POST").Handler(negroni.New(append(config["EligibilityResponseCreate"], negroni.HandlerFunc(eligibilityresponseController.CreateHandler))...))
+	eligibilityresponseBase.Methods("PUT").Handler(negroni.New(append(config["EligibilityResponseConditionalUpdate"], negroni.HandlerFunc(eligibilityresponseController.ConditionalUpdateHandler))...))
+	eligibilityresponseBase.Methods("DELETE").Handler(negroni.New(append(config["EligibilityResponseConditionalDelete"], negroni.HandlerFunc(eligibilityresponseController.ConditionalDeleteHandler))...))
+	eligibilityresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	eligibilityresponseTypeHistory := router.Path("/EligibilityResponse/_history").Subrouter()
+	eligibilityresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["EligibilityResponseTypeHistory"], negroni.HandlerFunc(eligibilityresponseController.TypeHistoryHandler))...))
+	eligibilityresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	eligibilityresponse := router.Path("/EligibilityResponse/{id}").Subrouter()
 	eligibilityresponse.Methods("GET").Handler(negroni.New(append(config["EligibilityResponseShow"], negroni.HandlerFunc(eligibilityresponseController.ShowHandler))...))
 	eligibilityresponse.Methods("PUT").Handler(negroni.New(append(config["EligibilityResponseUpdate"], negroni.HandlerFunc(eligibilityresponseController.UpdateHandler))...))
+	eligibilityresponse.Methods("PATCH").Handler(negroni.New(append(config["EligibilityResponseUpdate"], negroni.HandlerFunc(eligibilityresponseController.PatchHandler))...))
 	eligibilityresponse.Methods("DELETE").Handler(negroni.New(append(config["EligibilityResponseDelete"], negroni.HandlerFunc(eligibilityresponseController.DeleteHandler))...))
+	eligibilityresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	eligibilityresponseInstanceHistory := router.Path("/EligibilityResponse/{id}/_history").Subrouter()
+	eligibilityresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EligibilityResponseInstanceHistory"], negroni.HandlerFunc(eligibilityresponseController.InstanceHistoryHandler))...))
+	eligibilityresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	eligibilityresponseVersion := router.Path("/EligibilityResponse/{id}/_history/{vid}").Subrouter()
+	eligibilityresponseVersion.Methods("GET").Handler(negroni.New(append(config["EligibilityResponseVRead"], negroni.HandlerFunc(eligibilityresponseController.VersionReadHandler))...))
+	eligibilityresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	deviceuserequestController := ResourceController{"DeviceUseRequest"}
 	deviceuserequestBase := router.Path("/DeviceUseRequest").Subrouter()
 	deviceuserequestBase.Methods("GET").Handler(negroni.New(append(config["DeviceUseRequestIndex"], negroni.HandlerFunc(deviceuserequestController.IndexHandler))...))
 	deviceuserequestBase.Methods("POST").Handler(negroni.New(append(config["DeviceUseRequestCreate"], negroni.HandlerFunc(deviceuserequestController.CreateHandler))...))
+	deviceuserequestBase.Methods("PUT").Handler(negroni.New(append(config["DeviceUseRequestConditionalUpdate"], negroni.HandlerFunc(deviceuserequestController.ConditionalUpdateHandler))...))
+	deviceuserequestBase.Methods("DELETE").Handler(negroni.New(append(config["DeviceUseRequestConditionalDelete"], negroni.HandlerFunc(deviceuserequestController.ConditionalDeleteHandler))...))
+	deviceuserequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	deviceuserequestTypeHistory := router.Path("/DeviceUseRequest/_history").Subrouter()
+	deviceuserequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["DeviceUseRequestTypeHistory"], negroni.HandlerFunc(deviceuserequestController.TypeHistoryHandler))...))
+	deviceuserequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	deviceuserequest := router.Path("/DeviceUseRequest/{id}").Subrouter()
 	deviceuserequest.Methods("GET").Handler(negroni.New(append(config["DeviceUseRequestShow"], negroni.HandlerFunc(deviceuserequestController.ShowHandler))...))
 	deviceuserequest.Methods("PUT").Handler(negroni.New(append(config["DeviceUseRequestUpdate"], negroni.HandlerFunc(deviceuserequestController.UpdateHandler))...))
+	deviceuserequest.Methods("PATCH").Handler(negroni.New(append(config["DeviceUseRequestUpdate"], negroni.HandlerFunc(deviceuserequestController.PatchHandler))...))
 	deviceuserequest.Methods("DELETE").Handler(negroni.New(append(config["DeviceUseRequestDelete"], negroni.HandlerFunc(deviceuserequestController.DeleteHandler))...))
+	deviceuserequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	deviceuserequestInstanceHistory := router.Path("/DeviceUseRequest/{id}/_history").Subrouter()
+	deviceuserequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DeviceUseRequestInstanceHistory"], negroni.HandlerFunc(deviceuserequestController.InstanceHistoryHandler))...))
+	deviceuserequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	deviceuserequestVersion := router.Path("/DeviceUseRequest/{id}/_history/{vid}").Subrouter()
+	deviceuserequestVersion.Methods("GET").Handler(negroni.New(append(config["DeviceUseRequestVRead"], negroni.HandlerFunc(deviceuserequestController.VersionReadHandler))...))
+	deviceuserequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	devicemetricController := ResourceController{"DeviceMetric"}
 	devicemetricBase := router.Path("/DeviceMetric").Subrouter()
 	devicemetricBase.Methods("GET").Handler(negroni.New(append(config["DeviceMetricIndex"], negroni.HandlerFunc(devicemetricController.IndexHandler))...))
 	devicemetricBase.Methods("POST").Handler(negroni.New(append(config["DeviceMetricCreate"], negroni.HandlerFunc(devicemetricController.CreateHandler))...))
+	devicemetricBase.Methods("PUT").Handler(negroni.New(append(config["DeviceMetricConditionalUpdate"], negroni.HandlerFunc(devicemetricController.ConditionalUpdateHandler))...))
+	devicemetricBase.Methods("DELETE").Handler(negroni.New(append(config["DeviceMetricConditionalDelete"], negroni.HandlerFunc(devicemetricController.ConditionalDeleteHandler))...))
+	devicemetricBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	devicemetricTypeHistory := router.Path("/DeviceMetric/_history").Subrouter()
+	devicemetricTypeHistory.Methods("GET").Handler(negroni.New(append(config["DeviceMetricTypeHistory"], negroni.HandlerFunc(devicemetricController.TypeHistoryHandler))...))
+	devicemetricTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	devicemetric := router.Path("/DeviceMetric/{id}").Subrouter()
 	devicemetric.Methods("GET").Handler(negroni.New(append(config["DeviceMetricShow"], negroni.HandlerFunc(devicemetricController.ShowHandler))...))
 	devicemetric.Methods("PUT").Handler(negroni.New(append(config["DeviceMetricUpdate"], negroni.HandlerFunc(devicemetricController.UpdateHandler))...))
+	devicemetric.Methods("PATCH").Handler(negroni.New(append(config["DeviceMetricUpdate"], negroni.HandlerFunc(devicemetricController.PatchHandler))...))
 	devicemetric.Methods("DELETE").Handler(negroni.New(append(config["DeviceMetricDelete"], negroni.HandlerFunc(devicemetricController.DeleteHandler))...))
+	devicemetric.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	devicemetricInstanceHistory := router.Path("/DeviceMetric/{id}/_history").Subrouter()
+	devicemetricInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DeviceMetricInstanceHistory"], negroni.HandlerFunc(devicemetricController.InstanceHistoryHandler))...))
+	devicemetricInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	devicemetricVersion := router.Path("/DeviceMetric/{id}/_history/{vid}").Subrouter()
+	devicemetricVersion.Methods("GET").Handler(negroni.New(append(config["DeviceMetricVRead"], negroni.HandlerFunc(devicemetricController.VersionReadHandler))...))
+	devicemetricVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	flagController := ResourceController{"Flag"}
 	flagBase := router.Path("/Flag").Subrouter()
 	flagBase.Methods("GET").Handler(negroni.New(append(config["FlagIndex"], negroni.HandlerFunc(flagController.IndexHandler))...))
 	flagBase.Methods("POST").Handler(negroni.New(append(config["FlagCreate"], negroni.HandlerFunc(flagController.CreateHandler))...))
+	flagBase.Methods("PUT").Handler(negroni.New(append(config["FlagConditionalUpdate"], negroni.HandlerFunc(flagController.ConditionalUpdateHandler))...))
+	flagBase.Methods("DELETE").Handler(negroni.New(append(config["FlagConditionalDelete"], negroni.HandlerFunc(flagController.ConditionalDeleteHandler))...))
+	flagBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	flagTypeHistory := router.Path("/Flag/_history").Subrouter()
+	flagTypeHistory.Methods("GET").Handler(negroni.New(append(config["FlagTypeHistory"], negroni.HandlerFunc(flagController.TypeHistoryHandler))...))
+	flagTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	flag := router.Path("/Flag/{id}").Subrouter()
 	flag.Methods("GET").Handler(negroni.New(append(config["FlagShow"], negroni.HandlerFunc(flagController.ShowHandler))...))
 	flag.Methods("PUT").Handler(negroni.New(append(config["FlagUpdate"], negroni.HandlerFunc(flagController.UpdateHandler))...))
+	flag.Methods("PATCH").Handler(negroni.New(append(config["FlagUpdate"], negroni.HandlerFunc(flagController.PatchHandler))...))
 	flag.Methods("DELETE").Handler(negroni.New(append(config["FlagDelete"], negroni.HandlerFunc(flagController.DeleteHandler))...))
+	flag.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	flagInstanceHistory := router.Path("/Flag/{id}/_history").Subrouter()
+	flagInstanceHistory.Methods("GET").Handler(negroni.New(append(config["FlagInstanceHistory"], negroni.HandlerFunc(flagController.InstanceHistoryHandler))...))
+	flagInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	flagVersion := router.Path("/Flag/{id}/_history/{vid}").Subrouter()
+	flagVersion.Methods("GET").Handler(negroni.New(append(config["FlagVRead"], negroni.HandlerFunc(flagController.VersionReadHandler))...))
+	flagVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	relatedpersonController := ResourceController{"RelatedPerson"}
 	relatedpersonBase := router.Path("/RelatedPerson").Subrouter()
 	relatedpersonBase.Methods("GET").Handler(negroni.New(append(config["RelatedPersonIndex"], negroni.HandlerFunc(relatedpersonController.IndexHandler))...))
 	relatedpersonBase.Methods("POST").Handler(negroni.New(append(config["RelatedPersonCreate"], negroni.HandlerFunc(relatedpersonController.CreateHandler))...))
+	relatedpersonBase.Methods("PUT").Handler(negroni.New(append(config["RelatedPersonConditionalUpdate"], negroni.HandlerFunc(relatedpersonController.ConditionalUpdateHandler))...))
+	relatedpersonBase.Methods("DELETE").Handler(negroni.New(append(config["RelatedPersonConditionalDelete"], negroni.HandlerFunc(relatedpersonController.ConditionalDeleteHandler))...))
+	relatedpersonBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	relatedpersonTypeHistory := router.Path("/RelatedPerson/_history").Subrouter()
+	relatedpersonTypeHistory.Methods("GET").Handler(negroni.New(append(config["RelatedPersonTypeHistory"], negroni.HandlerFunc(relatedpersonController.TypeHistoryHandler))...))
+	relatedpersonTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	relatedperson := router.Path("/RelatedPerson/{id}").Subrouter()
 	relatedperson.Methods("GET").Handler(negroni.New(append(config["RelatedPersonShow"], negroni.HandlerFunc(relatedpersonController.ShowHandler))...))
 	relatedperson.Methods("PUT").Handler(negroni.New(append(config["RelatedPersonUpdate"], negroni.HandlerFunc(relatedpersonController.UpdateHandler))...))
+	relatedperson.Methods("PATCH").Handler(negroni.New(append(config["RelatedPersonUpdate"], negroni.HandlerFunc(relatedpersonController.PatchHandler))...))
 	relatedperson.Methods("DELETE").Handler(negroni.New(append(config["RelatedPersonDelete"], negroni.HandlerFunc(relatedpersonController.DeleteHandler))...))
+	relatedperson.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	relatedpersonInstanceHistory := router.Path("/RelatedPerson/{id}/_history").Subrouter()
+	relatedpersonInstanceHistory.Methods("GET").Handler(negroni.New(append(config["RelatedPersonInstanceHistory"], negroni.HandlerFunc(relatedpersonController.InstanceHistoryHandler))...))
+	relatedpersonInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	relatedpersonVersion := router.Path("/RelatedPerson/{id}/_history/{vid}").Subrouter()
+	relatedpersonVersion.Methods("GET").Handler(negroni.New(append(config["RelatedPersonVRead"], negroni.HandlerFunc(relatedpersonController.VersionReadHandler))...))
+	relatedpersonVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	supplyrequestController := ResourceController{"SupplyRequest"}
 	supplyrequestBase := router.Path("/SupplyRequest").Subrouter()
 	supplyrequestBase.Methods("GET").Handler(negroni.New(append(config["SupplyRequestIndex"], negroni.HandlerFunc(supplyrequestController.IndexHandler))...))
 	supplyrequestBase.Methods("POST").Handler(negroni.New(append(config["SupplyRequestCreate"], negroni.HandlerFunc(supplyrequestController.CreateHandler))...))
+	supplyrequestBase.Methods("PUT").Handler(negroni.New(append(config["SupplyRequestConditionalUpdate"], negroni.HandlerFunc(supplyrequestController.ConditionalUpdateHandler))...))
+	supplyrequestBase.Methods("DELETE").Handler(negroni.New(append(config["SupplyRequestConditionalDelete"], negroni.HandlerFunc(supplyrequestController.ConditionalDeleteHandler))...))
+	supplyrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	supplyrequestTypeHistory := router.Path("/SupplyRequest/_history").Subrouter()
+	supplyrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["SupplyRequestTypeHistory"], negroni.HandlerFunc(supplyrequestController.TypeHistoryHandler))...))
+	supplyrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	supplyrequest := router.Path("/SupplyRequest/{id}").Subrouter()
 	supplyrequest.Methods("GET").Handler(negroni.New(append(config["SupplyRequestShow"], negroni.HandlerFunc(supplyrequestController.ShowHandler))...))
 	supplyrequest.Methods("PUT").Handler(negroni.New(append(config["SupplyRequestUpdate"], negroni.HandlerFunc(supplyrequestController.UpdateHandler))...))
+	supplyrequest.Methods("PATCH").Handler(negroni.New(append(config["SupplyRequestUpdate"], negroni.HandlerFunc(supplyrequestController.PatchHandler))...))
 	supplyrequest.Methods("DELETE").Handler(negroni.New(append(config["SupplyRequestDelete"], negroni.HandlerFunc(supplyrequestController.DeleteHandler))...))
+	supplyrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	supplyrequestInstanceHistory := router.Path("/SupplyRequest/{id}/_history").Subrouter()
+	supplyrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SupplyRequestInstanceHistory"], negroni.HandlerFunc(supplyrequestController.InstanceHistoryHandler))...))
+	supplyrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	supplyrequestVersion := router.Path("/SupplyRequest/{id}/_history/{vid}").Subrouter()
+	supplyrequestVersion.Methods("GET").Handler(negroni.New(append(config["SupplyRequestVRead"], negroni.HandlerFunc(supplyrequestController.VersionReadHandler))...))
+	supplyrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	practitionerController := ResourceController{"Practitioner"}
 	practitionerBase := router.Path("/Practitioner").Subrouter()
 	practitionerBase.Methods("GET").Handler(negroni.New(append(config["PractitionerIndex"], negroni.HandlerFunc(practitionerController.IndexHandler))...))
 	practitionerBase.Methods("POST").Handler(negroni.New(append(config["PractitionerCreate"], negroni.HandlerFunc(practitionerController.CreateHandler))...))
+	practitionerBase.Methods("PUT").Handler(negroni.New(append(config["PractitionerConditionalUpdate"], negroni.HandlerFunc(practitionerController.ConditionalUpdateHandler))...))
+	practitionerBase.Methods("DELETE").Handler(negroni.New(append(config["PractitionerConditionalDelete"], negroni.HandlerFunc(practitionerController.ConditionalDeleteHandler))...))
+	practitionerBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	practitionerTypeHistory := router.Path("/Practitioner/_history").Subrouter()
+	practitionerTypeHistory.Methods("GET").Handler(negroni.New(append(config["PractitionerTypeHistory"], negroni.HandlerFunc(practitionerController.TypeHistoryHandler))...))
+	practitionerTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	practitioner := router.Path("/Practitioner/{id}").Subrouter()
 	practitioner.Methods("GET").Handler(negroni.New(append(config["PractitionerShow"], negroni.HandlerFunc(practitionerController.ShowHandler))...))
 	practitioner.Methods("PUT").Handler(negroni.New(append(config["PractitionerUpdate"], negroni.HandlerFunc(practitionerController.UpdateHandler))...))
+	practitioner.Methods("PATCH").Handler(negroni.New(append(config["PractitionerUpdate"], negroni.HandlerFunc(practitionerController.PatchHandler))...))
 	practitioner.Methods("DELETE").Handler(negroni.New(append(config["PractitionerDelete"], negroni.HandlerFunc(practitionerController.DeleteHandler))...))
+	practitioner.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	practitionerInstanceHistory := router.Path("/Practitioner/{id}/_history").Subrouter()
+	practitionerInstanceHistory.Methods("GET").Handler(negroni.New(append(config["PractitionerInstanceHistory"], negroni.HandlerFunc(practitionerController.InstanceHistoryHandler))...))
+	practitionerInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	practitionerVersion := router.Path("/Practitioner/{id}/_history/{vid}").Subrouter()
+	practitionerVersion.Methods("GET").Handler(negroni.New(append(config["PractitionerVRead"], negroni.HandlerFunc(practitionerController.VersionReadHandler))...))
+	practitionerVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	appointmentresponseController := ResourceController{"AppointmentResponse"}
 	appointmentresponseBase := router.Path("/AppointmentResponse").Subrouter()
 	appointmentresponseBase.Methods("GET").Handler(negroni.New(append(config["AppointmentResponseIndex"], negroni.HandlerFunc(appointmentresponseController.IndexHandler))...))
 	appointmentresponseBase.Methods("POST").Handler(negroni.New(append(config["AppointmentResponseCreate"], negroni.HandlerFunc(appointmentresponseController.CreateHandler))...))
+	appointmentresponseBase.Methods("PUT").Handler(negroni.New(append(config["AppointmentResponseConditionalUpdate"], negroni.HandlerFunc(appointmentresponseController.ConditionalUpdateHandler))...))
+	appointmentresponseBase.Methods("DELETE").Handler(negroni.New(append(config["AppointmentResponseConditionalDelete"], negroni.HandlerFunc(appointmentresponseController.ConditionalDeleteHandler))...))
+	appointmentresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	appointmentresponseTypeHistory := router.Path("/AppointmentResponse/_history").Subrouter()
+	appointmentresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["AppointmentResponseTypeHistory"], negroni.HandlerFunc(appointmentresponseController.TypeHistoryHandler))...))
+	appointmentresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	appointmentresponse := router.Path("/AppointmentResponse/{id}").Subrouter()
 	appointmentresponse.Methods("GET").Handler(negroni.New(append(config["AppointmentResponseShow"], negroni.HandlerFunc(appointmentresponseController.ShowHandler))...))
 	appointmentresponse.Methods("PUT").Handler(negroni.New(append(config["AppointmentResponseUpdate"], negroni.HandlerFunc(appointmentresponseController.UpdateHandler))...))
+	appointmentresponse.Methods("PATCH").Handler(negroni.New(append(config["AppointmentResponseUpdate"], negroni.HandlerFunc(appointmentresponseController.PatchHandler))...))
 	appointmentresponse.Methods("DELETE").Handler(negroni.New(append(config["AppointmentResponseDelete"], negroni.HandlerFunc(appointmentresponseController.DeleteHandler))...))
+	appointmentresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	appointmentresponseInstanceHistory := router.Path("/AppointmentResponse/{id}/_history").Subrouter()
+	appointmentresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["AppointmentResponseInstanceHistory"], negroni.HandlerFunc(appointmentresponseController.InstanceHistoryHandler))...))
+	appointmentresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	appointmentresponseVersion := router.Path("/AppointmentResponse/{id}/_history/{vid}").Subrouter()
+	appointmentresponseVersion.Methods("GET").Handler(negroni.New(append(config["AppointmentResponseVRead"], negroni.HandlerFunc(appointmentresponseController.VersionReadHandler))...))
+	appointmentresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	observationController := ResourceController{"Observation"}
 	observationBase := router.Path("/Observation").Subrouter()
 	observationBase.Methods("GET").Handler(negroni.New(append(config["ObservationIndex"], negroni.HandlerFunc(observationController.IndexHandler))...))
 	observationBase.Methods("POST").Handler(negroni.New(append(config["ObservationCreate"], negroni.HandlerFunc(observationController.CreateHandler))...))
+	observationBase.Methods("PUT").Handler(negroni.New(append(config["ObservationConditionalUpdate"], negroni.HandlerFunc(observationController.ConditionalUpdateHandler))...))
+	observationBase.Methods("DELETE").Handler(negroni.New(append(config["ObservationConditionalDelete"], negroni.HandlerFunc(observationController.ConditionalDeleteHandler))...))
+	observationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	observationTypeHistory := router.Path("/Observation/_history").Subrouter()
+	observationTypeHistory.Methods("GET").Handler(negroni.New(append(config["ObservationTypeHistory"], negroni.HandlerFunc(observationController.TypeHistoryHandler))...))
+	observationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	observation := router.Path("/Observation/{id}").Subrouter()
 	observation.Methods("GET").Handler(negroni.New(append(config["ObservationShow"], negroni.HandlerFunc(observationController.ShowHandler))...))
 	observation.Methods("PUT").Handler(negroni.New(append(config["ObservationUpdate"], negroni.HandlerFunc(observationController.UpdateHandler))...))
+	observation.Methods("PATCH").Handler(negroni.New(append(config["ObservationUpdate"], negroni.HandlerFunc(observationController.PatchHandler))...))
 	observation.Methods("DELETE").Handler(negroni.New(append(config["ObservationDelete"], negroni.HandlerFunc(observationController.DeleteHandler))...))
+	observation.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	observationInstanceHistory := router.Path("/Observation/{id}/_history").Subrouter()
+	observationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ObservationInstanceHistory"], negroni.HandlerFunc(observationController.InstanceHistoryHandler))...))
+	observationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	observationVersion := router.Path("/Observation/{id}/_history/{vid}").Subrouter()
+	observationVersion.Methods("GET").Handler(negroni.New(append(config["ObservationVRead"], negroni.HandlerFunc(observationController.VersionReadHandler))...))
+	observationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationadministrationController := ResourceController{"MedicationAdministration"}
 	medicationadministrationBase := router.Path("/MedicationAdministration").Subrouter()
 	medicationadministrationBase.Methods("GET").Handler(negroni.New(append(config["MedicationAdministrationIndex"], negroni.HandlerFunc(medicationadministrationController.IndexHandler))...))
 	medicationadministrationBase.Methods("POST").Handler(negroni.New(append(config["MedicationAdministrationCreate"], negroni.HandlerFunc(medicationadministrationController.CreateHandler))...))
+	medicationadministrationBase.Methods("PUT").Handler(negroni.New(append(config["MedicationAdministrationConditionalUpdate"], negroni.HandlerFunc(medicationadministrationController.ConditionalUpdateHandler))...))
+	medicationadministrationBase.Methods("DELETE").Handler(negroni.New(append(config["MedicationAdministrationConditionalDelete"], negroni.HandlerFunc(medicationadministrationController.ConditionalDeleteHandler))...))
+	medicationadministrationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	medicationadministrationTypeHistory := router.Path("/MedicationAdministration/_history").Subrouter()
+	medicationadministrationTypeHistory.Methods("GET").Handler(negroni.New(append(config["MedicationAdministrationTypeHistory"], negroni.HandlerFunc(medicationadministrationController.TypeHistoryHandler))...))
+	medicationadministrationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationadministration := router.Path("/MedicationAdministration/{id}").Subrouter()
 	medicationadministration.Methods("GET").Handler(negroni.New(append(config["MedicationAdministrationShow"], negroni.HandlerFunc(medicationadministrationController.ShowHandler))...))
 	medicationadministration.Methods("PUT").Handler(negroni.New(append(config["MedicationAdministrationUpdate"], negroni.HandlerFunc(medicationadministrationController.UpdateHandler))...))
+	medicationadministration.Methods("PATCH").Handler(negroni.New(append(config["MedicationAdministrationUpdate"], negroni.HandlerFunc(medicationadministrationController.PatchHandler))...))
 	medicationadministration.Methods("DELETE").Handler(negroni.New(append(config["MedicationAdministrationDelete"], negroni.HandlerFunc(medicationadministrationController.DeleteHandler))...))
+	medicationadministration.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	medicationadministrationInstanceHistory := router.Path("/MedicationAdministration/{id}/_history").Subrouter()
+	medicationadministrationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MedicationAdministrationInstanceHistory"], negroni.HandlerFunc(medicationadministrationController.InstanceHistoryHandler))...))
+	medicationadministrationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	medicationadministrationVersion := router.Path("/MedicationAdministration/{id}/_history/{vid}").Subrouter()
+	medicationadministrationVersion.Methods("GET").Handler(negroni.New(append(config["MedicationAdministrationVRead"], negroni.HandlerFunc(medicationadministrationController.VersionReadHandler))...))
+	medicationadministrationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	slotController := ResourceController{"Slot"}
 	slotBase := router.Path("/Slot").Subrouter()
 	slotBase.Methods("GET").Handler(negroni.New(append(config["SlotIndex"], negroni.HandlerFunc(slotController.IndexHandler))...))
 	slotBase.Methods("POST").Handler(negroni.New(append(config["SlotCreate"], negroni.HandlerFunc(slotController.CreateHandler))...))
+	slotBase.Methods("PUT").Handler(negroni.New(append(config["SlotConditionalUpdate"], negroni.HandlerFunc(slotController.ConditionalUpdateHandler))...))
+	slotBase.Methods("DELETE").Handler(negroni.New(append(config["SlotConditionalDelete"], negroni.HandlerFunc(slotController.ConditionalDeleteHandler))...))
+	slotBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	slotTypeHistory := router.Path("/Slot/_history").Subrouter()
+	slotTypeHistory.Methods("GET").Handler(negroni.New(append(config["SlotTypeHistory"], negroni.HandlerFunc(slotController.TypeHistoryHandler))...))
+	slotTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	slot := router.Path("/Slot/{id}").Subrouter()
 	slot.Methods("GET").Handler(negroni.New(append(config["SlotShow"], negroni.HandlerFunc(slotController.ShowHandler))...))
 	slot.Methods("PUT").Handler(negroni.New(append(config["SlotUpdate"], negroni.HandlerFunc(slotController.UpdateHandler))...))
+	slot.Methods("PATCH").Handler(negroni.New(append(config["SlotUpdate"], negroni.HandlerFunc(slotController.PatchHandler))...))
 	slot.Methods("DELETE").Handler(negroni.New(append(config["SlotDelete"], negroni.HandlerFunc(slotController.DeleteHandler))...))
+	slot.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	slotInstanceHistory := router.Path("/Slot/{id}/_history").Subrouter()
+	slotInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SlotInstanceHistory"], negroni.HandlerFunc(slotController.InstanceHistoryHandler))...))
+	slotInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	slotVersion := router.Path("/Slot/{id}/_history/{vid}").Subrouter()
+	slotVersion.Methods("GET").Handler(negroni.New(append(config["SlotVRead"], negroni.HandlerFunc(slotController.VersionReadHandler))...))
+	slotVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	enrollmentresponseController := ResourceController{"EnrollmentResponse"}
 	enrollmentresponseBase := router.Path("/EnrollmentResponse").Subrouter()
 	enrollmentresponseBase.Methods("GET").Handler(negroni.New(append(config["EnrollmentResponseIndex"], negroni.HandlerFunc(enrollmentresponseController.IndexHandler))...))
 	enrollmentresponseBase.Methods("POST").Handler(negroni.New(append(config["EnrollmentResponseCreate"], negroni.HandlerFunc(enrollmentresponseController.CreateHandler))...))
+	enrollmentresponseBase.Methods("PUT").Handler(negroni.New(append(config["EnrollmentResponseConditionalUpdate"], negroni.HandlerFunc(enrollmentresponseController.ConditionalUpdateHandler))...))
+	enrollmentresponseBase.Methods("DELETE").Handler(negroni.New(append(config["EnrollmentResponseConditionalDelete"], negroni.HandlerFunc(enrollmentresponseController.ConditionalDeleteHandler))...))
+	enrollmentresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	enrollmentresponseTypeHistory := router.Path("/EnrollmentResponse/_history").Subrouter()
+	enrollmentresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["EnrollmentResponseTypeHistory"], negroni.HandlerFunc(enrollmentresponseController.TypeHistoryHandler))...))
+	enrollmentresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	enrollmentresponse := router.Path("/EnrollmentResponse/{id}").Subrouter()
 	enrollmentresponse.Methods("GET").Handler(negroni.New(append(config["EnrollmentResponseShow"], negroni.HandlerFunc(enrollmentresponseController.ShowHandler))...))
 	enrollmentresponse.Methods("PUT").Handler(negroni.New(append(config["EnrollmentResponseUpdate"], negroni.HandlerFunc(enrollmentresponseController.UpdateHandler))...))
+	enrollmentresponse.Methods("PATCH").Handler(negroni.New(append(config["EnrollmentResponseUpdate"], negroni.HandlerFunc(enrollmentresponseController.PatchHandler))...))
 	enrollmentresponse.Methods("DELETE").Handler(negroni.New(append(config["EnrollmentResponseDelete"], negroni.HandlerFunc(enrollmentresponseController.DeleteHandler))...))
+	enrollmentresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	enrollmentresponseInstanceHistory := router.Path("/EnrollmentResponse/{id}/_history").Subrouter()
+	enrollmentresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EnrollmentResponseInstanceHistory"], negroni.HandlerFunc(enrollmentresponseController.InstanceHistoryHandler))...))
+	enrollmentresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	enrollmentresponseVersion := router.Path("/EnrollmentResponse/{id}/_history/{vid}").Subrouter()
+	enrollmentresponseVersion.Methods("GET").Handler(negroni.New(append(config["EnrollmentResponseVRead"], negroni.HandlerFunc(enrollmentresponseController.VersionReadHandler))...))
+	enrollmentresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	binaryController := ResourceController{"Binary"}
 	binaryBase := router.Path("/Binary").Subrouter()
 	binaryBase.Methods("GET").Handler(negroni.New(append(config["BinaryIndex"], negroni.HandlerFunc(binaryController.IndexHandler))...))
 	binaryBase.Methods("POST").Handler(negroni.New(append(config["BinaryCreate"], negroni.HandlerFunc(binaryController.CreateHandler))...))
+	binaryBase.Methods("PUT").Handler(negroni.New(append(config["BinaryConditionalUpdate"], negroni.HandlerFunc(binaryController.ConditionalUpdateHandler))...))
+	binaryBase.Methods("DELETE").Handler(negroni.New(append(config["BinaryConditionalDelete"], negroni.HandlerFunc(binaryController.ConditionalDeleteHandler))...))
+	binaryBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	binaryTypeHistory := router.Path("/Binary/_history").Subrouter()
+	binaryTypeHistory.Methods("GET").Handler(negroni.New(append(config["BinaryTypeHistory"], negroni.HandlerFunc(binaryController.TypeHistoryHandler))...))
+	binaryTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	binary := router.Path("/Binary/{id}").Subrouter()
 	binary.Methods("GET").Handler(negroni.New(append(config["BinaryShow"], negroni.HandlerFunc(binaryController.ShowHandler))...))
 	binary.Methods("PUT").Handler(negroni.New(append(config["BinaryUpdate"], negroni.HandlerFunc(binaryController.UpdateHandler))...))
+	binary.Methods("PATCH").Handler(negroni.New(append(config["BinaryUpdate"], negroni.HandlerFunc(binaryController.PatchHandler))...))
 	binary.Methods("DELETE").Handler(negroni.New(append(config["BinaryDelete"], negroni.HandlerFunc(binaryController.DeleteHandler))...))
+	binary.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	binaryInstanceHistory := router.Path("/Binary/{id}/_history").Subrouter()
+	binaryInstanceHistory.Methods("GET").Handler(negroni.New(append(config["BinaryInstanceHistory"], negroni.HandlerFunc(binaryController.InstanceHistoryHandler))...))
+	binaryInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	binaryVersion := router.Path("/Binary/{id}/_history/{vid}").Subrouter()
+	binaryVersion.Methods("GET").Handler(negroni.New(append(config["BinaryVRead"], negroni.HandlerFunc(binaryController.VersionReadHandler))...))
+	binaryVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationstatementController := ResourceController{"MedicationStatement"}
 	medicationstatementBase := router.Path("/MedicationStatement").Subrouter()
 	medicationstatementBase.Methods("GET").Handler(negroni.New(append(config["MedicationStatementIndex"], negroni.HandlerFunc(medicationstatementController.IndexHandler))...))
 	medicationstatementBase.Methods("POST").Handler(negroni.New(append(config["MedicationStatementCreate"], negroni.HandlerFunc(medicationstatementController.CreateHandler))...))
+	medicationstatementBase.Methods("PUT").Handler(negroni.New(append(config["MedicationStatementConditionalUpdate"], negroni.HandlerFunc(medicationstatementController.ConditionalUpdateHandler))...))
+	medicationstatementBase.Methods("DELETE").Handler(negroni.New(append(config["MedicationStatementConditionalDelete"], negroni.HandlerFunc(medicationstatementController.ConditionalDeleteHandler))...))
+	medicationstatementBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	medicationstatementTypeHistory := router.Path("/MedicationStatement/_history").Subrouter()
+	medicationstatementTypeHistory.Methods("GET").Handler(negroni.New(append(config["MedicationStatementTypeHistory"], negroni.HandlerFunc(medicationstatementController.TypeHistoryHandler))...))
+	medicationstatementTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationstatement := router.Path("/MedicationStatement/{id}").Subrouter()
 	medicationstatement.Methods("GET").Handler(negroni.New(append(config["MedicationStatementShow"], negroni.HandlerFunc(medicationstatementController.ShowHandler))...))
 	medicationstatement.Methods("PUT").Handler(negroni.New(append(config["MedicationStatementUpdate"], negroni.HandlerFunc(medicationstatementController.UpdateHandler))...))
+	medicationstatement.Methods("PATCH").Handler(negroni.New(append(config["MedicationStatementUpdate"], negroni.HandlerFunc(medicationstatementController.PatchHandler))...))
 	medicationstatement.Methods("DELETE").Handler(negroni.New(append(config["MedicationStatementDelete"], negroni.HandlerFunc(medicationstatementController.DeleteHandler))...))
+	medicationstatement.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	medicationstatementInstanceHistory := router.Path("/MedicationStatement/{id}/_history").Subrouter()
+	medicationstatementInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MedicationStatementInstanceHistory"], negroni.HandlerFunc(medicationstatementController.InstanceHistoryHandler))...))
+	medicationstatementInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	medicationstatementVersion := router.Path("/MedicationStatement/{id}/_history/{vid}").Subrouter()
+	medicationstatementVersion.Methods("GET").Handler(negroni.New(append(config["MedicationStatementVRead"], negroni.HandlerFunc(medicationstatementController.VersionReadHandler))...))
+	medicationstatementVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	personController := ResourceController{"Person"}
 	personBase := router.Path("/Person").Subrouter()
 	personBase.Methods("GET").Handler(negroni.New(append(config["PersonIndex"], negroni.HandlerFunc(personController.IndexHandler))...))
 	personBase.Methods("POST").Handler(negroni.New(append(config["PersonCreate"], negroni.HandlerFunc(personController.CreateHandler))...))
+	personBase.Methods("PUT").Handler(negroni.New(append(config["PersonConditionalUpdate"], negroni.HandlerFunc(personController.ConditionalUpdateHandler))...))
+	personBase.Methods("DELETE").Handler(negroni.New(append(config["PersonConditionalDelete"], negroni.HandlerFunc(personController.ConditionalDeleteHandler))...))
+	personBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	personTypeHistory := router.Path("/Person/_history").Subrouter()
+	personTypeHistory.Methods("GET").Handler(negroni.New(append(config["PersonTypeHistory"], negroni.HandlerFunc(personController.TypeHistoryHandler))...))
+	personTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	person := router.Path("/Person/{id}").Subrouter()
 	person.Methods("GET").Handler(negroni.New(append(config["PersonShow"], negroni.HandlerFunc(personController.ShowHandler))...))
 	person.Methods("PUT").Handler(negroni.New(append(config["PersonUpdate"], negroni.HandlerFunc(personController.UpdateHandler))...))
+	person.Methods("PATCH").Handler(negroni.New(append(config["PersonUpdate"], negroni.HandlerFunc(personController.PatchHandler))...))
 	person.Methods("DELETE").Handler(negroni.New(append(config["PersonDelete"], negroni.HandlerFunc(personController.DeleteHandler))...))
+	person.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	personInstanceHistory := router.Path("/Person/{id}/_history").Subrouter()
+	personInstanceHistory.Methods("GET").Handler(negroni.New(append(config["PersonInstanceHistory"], negroni.HandlerFunc(personController.InstanceHistoryHandler))...))
+	personInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	personVersion := router.Path("/Person/{id}/_history/{vid}").Subrouter()
+	personVersion.Methods("GET").Handler(negroni.New(append(config["PersonVRead"], negroni.HandlerFunc(personController.VersionReadHandler))...))
+	personVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	contractController := ResourceController{"Contract"}
 	contractBase := router.Path("/Contract").Subrouter()
 	contractBase.Methods("GET").Handler(negroni.New(append(config["ContractIndex"], negroni.HandlerFunc(contractController.IndexHandler))...))
 	contractBase.Methods("POST").Handler(negroni.New(append(config["ContractCreate"], negroni.HandlerFunc(contractController.CreateHandler))...))
+	contractBase.Methods("PUT").Handler(negroni.New(append(config["ContractConditionalUpdate"], negroni.HandlerFunc(contractController.ConditionalUpdateHandler))...))
+	contractBase.Methods("DELETE").Handler(negroni.New(append(config["ContractConditionalDelete"], negroni.HandlerFunc(contractController.ConditionalDeleteHandler))...))
+	contractBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	contractTypeHistory := router.Path("/Contract/_history").Subrouter()
+	contractTypeHistory.Methods("GET").Handler(negroni.New(append(config["ContractTypeHistory"], negroni.HandlerFunc(contractController.TypeHistoryHandler))...))
+	contractTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	contract := router.Path("/Contract/{id}").Subrouter()
 	contract.Methods("GET").Handler(negroni.New(append(config["ContractShow"], negroni.HandlerFunc(contractController.ShowHandler))...))
 	contract.Methods("PUT").Handler(negroni.New(append(config["ContractUpdate"], negroni.HandlerFunc(contractController.UpdateHandler))...))
+	contract.Methods("PATCH").Handler(negroni.New(append(config["ContractUpdate"], negroni.HandlerFunc(contractController.PatchHandler))...))
 	contract.Methods("DELETE").Handler(negroni.New(append(config["ContractDelete"], negroni.HandlerFunc(contractController.DeleteHandler))...))
+	contract.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	contractInstanceHistory := router.Path("/Contract/{id}/_history").Subrouter()
+	contractInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ContractInstanceHistory"], negroni.HandlerFunc(contractController.InstanceHistoryHandler))...))
+	contractInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	contractVersion := router.Path("/Contract/{id}/_history/{vid}").Subrouter()
+	contractVersion.Methods("GET").Handler(negroni.New(append(config["ContractVRead"], negroni.HandlerFunc(contractController.VersionReadHandler))...))
+	contractVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	communicationrequestController := ResourceController{"CommunicationRequest"}
 	communicationrequestBase := router.Path("/CommunicationRequest").Subrouter()
 	communicationrequestBase.Methods("GET").Handler(negroni.New(append(config["CommunicationRequestIndex"], negroni.HandlerFunc(communicationrequestController.IndexHandler))...))
 	communicationrequestBase.Methods("POST").Handler(negroni.New(append(config["CommunicationRequestCreate"], negroni.HandlerFunc(communicationrequestController.CreateHandler))...))
+	communicationrequestBase.Methods("PUT").Handler(negroni.New(append(config["CommunicationRequestConditionalUpdate"], negroni.HandlerFunc(communicationrequestController.ConditionalUpdateHandler))...))
+	communicationrequestBase.Methods("DELETE").Handler(negroni.New(append(config["CommunicationRequestConditionalDelete"], negroni.HandlerFunc(communicationrequestController.ConditionalDeleteHandler))...))
+	communicationrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	communicationrequestTypeHistory := router.Path("/CommunicationRequest/_history").Subrouter()
+	communicationrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["CommunicationRequestTypeHistory"], negroni.HandlerFunc(communicationrequestController.TypeHistoryHandler))...))
+	communicationrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	communicationrequest := router.Path("/CommunicationRequest/{id}").Subrouter()
 	communicationrequest.Methods("GET").Handler(negroni.New(append(config["CommunicationRequestShow"], negroni.HandlerFunc(communicationrequestController.ShowHandler))...))
 	communicationrequest.Methods("PUT").Handler(negroni.New(append(config["CommunicationRequestUpdate"], negroni.HandlerFunc(communicationrequestController.UpdateHandler))...))
+	communicationrequest.Methods("PATCH").Handler(negroni.New(append(config["CommunicationRequestUpdate"], negroni.HandlerFunc(communicationrequestController.PatchHandler))...))
 	communicationrequest.Methods("DELETE").Handler(negroni.New(append(config["CommunicationRequestDelete"], negroni.HandlerFunc(communicationrequestController.DeleteHandler))...))
+	communicationrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	communicationrequestInstanceHistory := router.Path("/CommunicationRequest/{id}/_history").Subrouter()
+	communicationrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["CommunicationRequestInstanceHistory"], negroni.HandlerFunc(communicationrequestController.InstanceHistoryHandler))...))
+	communicationrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	communicationrequestVersion := router.Path("/CommunicationRequest/{id}/_history/{vid}").Subrouter()
+	communicationrequestVersion.Methods("GET").Handler(negroni.New(append(config["CommunicationRequestVRead"], negroni.HandlerFunc(communicationrequestController.VersionReadHandler))...))
+	communicationrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	riskassessmentController := ResourceController{"RiskAssessment"}
 	riskassessmentBase := router.Path("/RiskAssessment").Subrouter()
 	riskassessmentBase.Methods("GET").Handler(negroni.New(append(config["RiskAssessmentIndex"], negroni.HandlerFunc(riskassessmentController.IndexHandler))...))
 	riskassessmentBase.Methods("POST").Handler(negroni.New(append(config["RiskAssessmentCreate"], negroni.HandlerFunc(riskassessmentController.CreateHandler))...))
+	riskassessmentBase.Methods("PUT").Handler(negroni.New(append(config["RiskAssessmentConditionalUpdate"], negroni.HandlerFunc(riskassessmentController.ConditionalUpdateHandler))...))
+	riskassessmentBase.Methods("DELETE").Handler(negroni.New(append(config["RiskAssessmentConditionalDelete"], negroni.HandlerFunc(riskassessmentController.ConditionalDeleteHandler))...))
+	riskassessmentBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	riskassessmentTypeHistory := router.Path("/RiskAssessment/_history").Subrouter()
+	riskassessmentTypeHistory.Methods("GET").Handler(negroni.New(append(config["RiskAssessmentTypeHistory"], negroni.HandlerFunc(riskassessmentController.TypeHistoryHandler))...))
+	riskassessmentTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	riskassessment := router.Path("/RiskAssessment/{id}").Subrouter()
 	riskassessment.Methods("GET").Handler(negroni.New(append(config["RiskAssessmentShow"], negroni.HandlerFunc(riskassessmentController.ShowHandler))...))
 	riskassessment.Methods("PUT").Handler(negroni.New(append(config["RiskAssessmentUpdate"], negroni.HandlerFunc(riskassessmentController.UpdateHandler))...))
+	riskassessment.Methods("PATCH").Handler(negroni.New(append(config["RiskAssessmentUpdate"], negroni.HandlerFunc(riskassessmentController.PatchHandler))...))
 	riskassessment.Methods("DELETE").Handler(negroni.New(append(config["RiskAssessmentDelete"], negroni.HandlerFunc(riskassessmentController.DeleteHandler))...))
+	riskassessment.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	riskassessmentInstanceHistory := router.Path("/RiskAssessment/{id}/_history").Subrouter()
+	riskassessmentInstanceHistory.Methods("GET").Handler(negroni.New(append(config["RiskAssessmentInstanceHistory"], negroni.HandlerFunc(riskassessmentController.InstanceHistoryHandler))...))
+	riskassessmentInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	riskassessmentVersion := router.Path("/RiskAssessment/{id}/_history/{vid}").Subrouter()
+	riskassessmentVersion.Methods("GET").Handler(negroni.New(append(config["RiskAssessmentVRead"], negroni.HandlerFunc(riskassessmentController.VersionReadHandler))...))
+	riskassessmentVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	testscriptController := ResourceController{"TestScript"}
 	testscriptBase := router.Path("/TestScript").Subrouter()
 	testscriptBase.Methods("GET").Handler(negroni.New(append(config["TestScriptIndex"], negroni.HandlerFunc(testscriptController.IndexHandler))...))
 	testscriptBase.Methods("POST").Handler(negroni.New(append(config["TestScriptCreate"], negroni.HandlerFunc(testscriptController.CreateHandler))...))
+	testscriptBase.Methods("PUT").Handler(negroni.New(append(config["TestScriptConditionalUpdate"], negroni.HandlerFunc(testscriptController.ConditionalUpdateHandler))...))
+	testscriptBase.Methods("DELETE").Handler(negroni.New(append(config["TestScriptConditionalDelete"], negroni.HandlerFunc(testscriptController.ConditionalDeleteHandler))...))
+	testscriptBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	testscriptTypeHistory := router.Path("/TestScript/_history").Subrouter()
+	testscriptTypeHistory.Methods("GET").Handler(negroni.New(append(config["TestScriptTypeHistory"], negroni.HandlerFunc(testscriptController.TypeHistoryHandler))...))
+	testscriptTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	testscript := router.Path("/TestScript/{id}").Subrouter()
 	testscript.Methods("GET").Handler(negroni.New(append(config["TestScriptShow"], negroni.HandlerFunc(testscriptController.ShowHandler))...))
 	testscript.Methods("PUT").Handler(negroni.New(append(config["TestScriptUpdate"], negroni.HandlerFunc(testscriptController.UpdateHandler))...))
+	testscript.Methods("PATCH").Handler(negroni.New(append(config["TestScriptUpdate"], negroni.HandlerFunc(testscriptController.PatchHandler))...))
 	testscript.Methods("DELETE").Handler(negroni.New(append(config["TestScriptDelete"], negroni.HandlerFunc(testscriptController.DeleteHandler))...))
+	testscript.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	testscriptInstanceHistory := router.Path("/TestScript/{id}/_history").Subrouter()
+	testscriptInstanceHistory.Methods("GET").Handler(negroni.New(append(config["TestScriptInstanceHistory"], negroni.HandlerFunc(testscriptController.InstanceHistoryHandler))...))
+	testscriptInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	testscriptVersion := router.Path("/TestScript/{id}/_history/{vid}").Subrouter()
+	testscriptVersion.Methods("GET").Handler(negroni.New(append(config["TestScriptVRead"], negroni.HandlerFunc(testscriptController.VersionReadHandler))...))
+	testscriptVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	basicController := ResourceController{"Basic"}
 	basicBase := router.Path("/Basic").Subrouter()
 	basicBase.Methods("GET").Handler(negroni.New(append(config["BasicIndex"], negroni.HandlerFunc(basicController.IndexHandler))...))
 	basicBase.Methods("POST").Handler(negroni.New(append(config["BasicCreate"], negroni.HandlerFunc(basicController.CreateHandler))...))
+	basicBase.Methods("PUT").Handler(negroni.New(append(config["BasicConditionalUpdate"], negroni.HandlerFunc(basicController.ConditionalUpdateHandler))...))
+	basicBase.Methods("DELETE").Handler(negroni.New(append(config["BasicConditionalDelete"], negroni.HandlerFunc(basicController.ConditionalDeleteHandler))...))
+	basicBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	basicTypeHistory := router.Path("/Basic/_history").Subrouter()
+	basicTypeHistory.Methods("GET").Handler(negroni.New(append(config["BasicTypeHistory"], negroni.HandlerFunc(basicController.TypeHistoryHandler))...))
+	basicTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	basic := router.Path("/Basic/{id}").Subrouter()
 	basic.Methods("GET").Handler(negroni.New(append(config["BasicShow"], negroni.HandlerFunc(basicController.ShowHandler))...))
 	basic.Methods("PUT").Handler(negroni.New(append(config["BasicUpdate"], negroni.HandlerFunc(basicController.UpdateHandler))...))
+	basic.Methods("PATCH").Handler(negroni.New(append(config["BasicUpdate"], negroni.HandlerFunc(basicController.PatchHandler))...))
 	basic.Methods("DELETE").Handler(negroni.New(append(config["BasicDelete"], negroni.HandlerFunc(basicController.DeleteHandler))...))
+	basic.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	basicInstanceHistory := router.Path("/Basic/{id}/_history").Subrouter()
+	basicInstanceHistory.Methods("GET").Handler(negroni.New(append(config["BasicInstanceHistory"], negroni.HandlerFunc(basicController.InstanceHistoryHandler))...))
+	basicInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	basicVersion := router.Path("/Basic/{id}/_history/{vid}").Subrouter()
+	basicVersion.Methods("GET").Handler(negroni.New(append(config["BasicVRead"], negroni.HandlerFunc(basicController.VersionReadHandler))...))
+	basicVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	groupController := ResourceController{"Group"}
 	groupBase := router.Path("/Group").Subrouter()
 	groupBase.Methods("GET").Handler(negroni.New(append(config["GroupIndex"], negroni.HandlerFunc(groupController.IndexHandler))...))
 	groupBase.Methods("POST").Handler(negroni.New(append(config["GroupCreate"], negroni.HandlerFunc(groupController.CreateHandler))...))
+	groupBase.Methods("PUT").Handler(negroni.New(append(config["GroupConditionalUpdate"], negroni.HandlerFunc(groupController.ConditionalUpdateHandler))...))
+	groupBase.Methods("DELETE").Handler(negroni.New(append(config["GroupConditionalDelete"], negroni.HandlerFunc(groupController.ConditionalDeleteHandler))...))
+	groupBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	groupTypeHistory := router.Path("/Group/_history").Subrouter()
+	groupTypeHistory.Methods("GET").Handler(negroni.New(append(config["GroupTypeHistory"], negroni.HandlerFunc(groupController.TypeHistoryHandler))...))
+	groupTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	group := router.Path("/Group/{id}").Subrouter()
 	group.Methods("GET").Handler(negroni.New(append(config["GroupShow"], negroni.HandlerFunc(groupController.ShowHandler))...))
 	group.Methods("PUT").Handler(negroni.New(append(config["GroupUpdate"], negroni.HandlerFunc(groupController.UpdateHandler))...))
+	group.Methods("PATCH").Handler(negroni.New(append(config["GroupUpdate"], negroni.HandlerFunc(groupController.PatchHandler))...))
 	group.Methods("DELETE").Handler(negroni.New(append(config["GroupDelete"], negroni.HandlerFunc(groupController.DeleteHandler))...))
+	group.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	groupInstanceHistory := router.Path("/Group/{id}/_history").Subrouter()
+	groupInstanceHistory.Methods("GET").Handler(negroni.New(append(config["GroupInstanceHistory"], negroni.HandlerFunc(groupController.InstanceHistoryHandler))...))
+	groupInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	groupVersion := router.Path("/Group/{id}/_history/{vid}").Subrouter()
+	groupVersion.Methods("GET").Handler(negroni.New(append(config["GroupVRead"], negroni.HandlerFunc(groupController.VersionReadHandler))...))
+	groupVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	paymentnoticeController := ResourceController{"PaymentNotice"}
 	paymentnoticeBase := router.Path("/PaymentNotice").Subrouter()
 	paymentnoticeBase.Methods("GET").Handler(negroni.New(append(config["PaymentNoticeIndex"], negroni.HandlerFunc(paymentnoticeController.IndexHandler))...))
 	paymentnoticeBase.Methods("POST").Handler(negroni.New(append(config["PaymentNoticeCreate"], negroni.HandlerFunc(paymentnoticeController.CreateHandler))...))
+	paymentnoticeBase.Methods("PUT").Handler(negroni.New(append(config["PaymentNoticeConditionalUpdate"], negroni.HandlerFunc(paymentnoticeController.ConditionalUpdateHandler))...))
+	paymentnoticeBase.Methods("DELETE").Handler(negroni.New(append(config["PaymentNoticeConditionalDelete"], negroni.HandlerFunc(paymentnoticeController.ConditionalDeleteHandler))...))
+	paymentnoticeBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	paymentnoticeTypeHistory := router.Path("/PaymentNotice/_history").Subrouter()
+	paymentnoticeTypeHistory.Methods("GET").Handler(negroni.New(append(config["PaymentNoticeTypeHistory"], negroni.HandlerFunc(paymentnoticeController.TypeHistoryHandler))...))
+	paymentnoticeTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	paymentnotice := router.Path("/PaymentNotice/{id}").Subrouter()
 	paymentnotice.Methods("GET").Handler(negroni.New(append(config["PaymentNoticeShow"], negroni.HandlerFunc(paymentnoticeController.ShowHandler))...))
 	paymentnotice.Methods("PUT").Handler(negroni.New(append(config["PaymentNoticeUpdate"], negroni.HandlerFunc(paymentnoticeController.UpdateHandler))...))
+	paymentnotice.Methods("PATCH").Handler(negroni.New(append(config["PaymentNoticeUpdate"], negroni.HandlerFunc(paymentnoticeController.PatchHandler))...))
 	paymentnotice.Methods("DELETE").Handler(negroni.New(append(config["PaymentNoticeDelete"], negroni.HandlerFunc(paymentnoticeController.DeleteHandler))...))
+	paymentnotice.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	paymentnoticeInstanceHistory := router.Path("/PaymentNotice/{id}/_history").Subrouter()
+	paymentnoticeInstanceHistory.Methods("GET").Handler(negroni.New(append(config["PaymentNoticeInstanceHistory"], negroni.HandlerFunc(paymentnoticeController.InstanceHistoryHandler))...))
+	paymentnoticeInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	paymentnoticeVersion := router.Path("/PaymentNotice/{id}/_history/{vid}").Subrouter()
+	paymentnoticeVersion.Methods("GET").Handler(negroni.New(append(config["PaymentNoticeVRead"], negroni.HandlerFunc(paymentnoticeController.VersionReadHandler))...))
+	paymentnoticeVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	organizationController := ResourceController{"Organization"}
 	organizationBase := router.Path("/Organization").Subrouter()
 	organizationBase.Methods("GET").Handler(negroni.New(append(config["OrganizationIndex"], negroni.HandlerFunc(organizationController.IndexHandler))...))
 	organizationBase.Methods("POST").Handler(negroni.New(append(config["OrganizationCreate"], negroni.HandlerFunc(organizationController.CreateHandler))...))
+	organizationBase.Methods("PUT").Handler(negroni.New(append(config["OrganizationConditionalUpdate"], negroni.HandlerFunc(organizationController.ConditionalUpdateHandler))...))
+	organizationBase.Methods("DELETE").Handler(negroni.New(append(config["OrganizationConditionalDelete"], negroni.HandlerFunc(organizationController.ConditionalDeleteHandler))...))
+	organizationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	organizationTypeHistory := router.Path("/Organization/_history").Subrouter()
+	organizationTypeHistory.Methods("GET").Handler(negroni.New(append(config["OrganizationTypeHistory"], negroni.HandlerFunc(organizationController.TypeHistoryHandler))...))
+	organizationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	organization := router.Path("/Organization/{id}").Subrouter()
 	organization.Methods("GET").Handler(negroni.New(append(config["OrganizationShow"], negroni.HandlerFunc(organizationController.ShowHandler))...))
 	organization.Methods("PUT").Handler(negroni.New(append(config["OrganizationUpdate"], negroni.HandlerFunc(organizationController.UpdateHandler))...))
+	organization.Methods("PATCH").Handler(negroni.New(append(config["OrganizationUpdate"], negroni.HandlerFunc(organizationController.PatchHandler))...))
 	organization.Methods("DELETE").Handler(negroni.New(append(config["OrganizationDelete"], negroni.HandlerFunc(organizationController.DeleteHandler))...))
+	organization.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	organizationInstanceHistory := router.Path("/Organization/{id}/_history").Subrouter()
+	organizationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["OrganizationInstanceHistory"], negroni.HandlerFunc(organizationController.InstanceHistoryHandler))...))
+	organizationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	organizationVersion := router.Path("/Organization/{id}/_history/{vid}").Subrouter()
+	organizationVersion.Methods("GET").Handler(negroni.New(append(config["OrganizationVRead"], negroni.HandlerFunc(organizationController.VersionReadHandler))...))
+	organizationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	implementationguideController := ResourceController{"ImplementationGuide"}
 	implementationguideBase := router.Path("/ImplementationGuide").Subrouter()
 	implementationguideBase.Methods("GET").Handler(negroni.New(append(config["ImplementationGuideIndex"], negroni.HandlerFunc(implementationguideController.IndexHandler))...))
 	implementationguideBase.Methods("POST").Handler(negroni.New(append(config["ImplementationGuideCreate"], negroni.HandlerFunc(implementationguideController.CreateHandler))...))
+	implementationguideBase.Methods("PUT").Handler(negroni.New(append(config["ImplementationGuideConditionalUpdate"], negroni.HandlerFunc(implementationguideController.ConditionalUpdateHandler))...))
+	implementationguideBase.Methods("DELETE").Handler(negroni.New(append(config["ImplementationGuideConditionalDelete"], negroni.HandlerFunc(implementationguideController.ConditionalDeleteHandler))...))
+	implementationguideBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	implementationguideTypeHistory := router.Path("/ImplementationGuide/_history").Subrouter()
+	implementationguideTypeHistory.Methods("GET").Handler(negroni.New(append(config["ImplementationGuideTypeHistory"], negroni.HandlerFunc(implementationguideController.TypeHistoryHandler))...))
+	implementationguideTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	implementationguide := router.Path("/ImplementationGuide/{id}").Subrouter()
 	implementationguide.Methods("GET").Handler(negroni.New(append(config["ImplementationGuideShow"], negroni.HandlerFunc(implementationguideController.ShowHandler))...))
 	implementationguide.Methods("PUT").Handler(negroni.New(append(config["ImplementationGuideUpdate"], negroni.HandlerFunc(implementationguideController.UpdateHandler))...))
+	implementationguide.Methods("PATCH").Handler(negroni.New(append(config["ImplementationGuideUpdate"], negroni.HandlerFunc(implementationguideController.PatchHandler))...))
 	implementationguide.Methods("DELETE").Handler(negroni.New(append(config["ImplementationGuideDelete"], negroni.HandlerFunc(implementationguideController.DeleteHandler))...))
+	implementationguide.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	implementationguideInstanceHistory := router.Path("/ImplementationGuide/{id}/_history").Subrouter()
+	implementationguideInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ImplementationGuideInstanceHistory"], negroni.HandlerFunc(implementationguideController.InstanceHistoryHandler))...))
+	implementationguideInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	implementationguideVersion := router.Path("/ImplementationGuide/{id}/_history/{vid}").Subrouter()
+	implementationguideVersion.Methods("GET").Handler(negroni.New(append(config["ImplementationGuideVRead"], negroni.HandlerFunc(implementationguideController.VersionReadHandler))...))
+	implementationguideVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	claimresponseController := ResourceController{"ClaimResponse"}
 	claimresponseBase := router.Path("/ClaimResponse").Subrouter()
 	claimresponseBase.Methods("GET").Handler(negroni.New(append(config["ClaimResponseIndex"], negroni.HandlerFunc(claimresponseController.IndexHandler))...))
 	claimresponseBase.Methods("POST").Handler(negroni.New(append(config["ClaimResponseCreate"], negroni.HandlerFunc(claimresponseController.CreateHandler))...))
+	claimresponseBase.Methods("PUT").Handler(negroni.New(append(config["ClaimResponseConditionalUpdate"], negroni.HandlerFunc(claimresponseController.ConditionalUpdateHandler))...))
+	claimresponseBase.Methods("DELETE").Handler(negroni.New(append(config["ClaimResponseConditionalDelete"], negroni.HandlerFunc(claimresponseController.ConditionalDeleteHandler))...))
+	claimresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	claimresponseTypeHistory := router.Path("/ClaimResponse/_history").Subrouter()
+	claimresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["ClaimResponseTypeHistory"], negroni.HandlerFunc(claimresponseController.TypeHistoryHandler))...))
+	claimresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	claimresponse := router.Path("/ClaimResponse/{id}").Subrouter()
 	claimresponse.Methods("GET").Handler(negroni.New(append(config["ClaimResponseShow"], negroni.HandlerFunc(claimresponseController.ShowHandler))...))
 	claimresponse.Methods("PUT").Handler(negroni.New(append(config["ClaimResponseUpdate"], negroni.HandlerFunc(claimresponseController.UpdateHandler))...))
+	claimresponse.Methods("PATCH").Handler(negroni.New(append(config["ClaimResponseUpdate"], negroni.HandlerFunc(claimresponseController.PatchHandler))...))
 	claimresponse.Methods("DELETE").Handler(negroni.New(append(config["ClaimResponseDelete"], negroni.HandlerFunc(claimresponseController.DeleteHandler))...))
+	claimresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	claimresponseInstanceHistory := router.Path("/ClaimResponse/{id}/_history").Subrouter()
+	claimresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ClaimResponseInstanceHistory"], negroni.HandlerFunc(claimresponseController.InstanceHistoryHandler))...))
+	claimresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	claimresponseVersion := router.Path("/ClaimResponse/{id}/_history/{vid}").Subrouter()
+	claimresponseVersion.Methods("GET").Handler(negroni.New(append(config["ClaimResponseVRead"], negroni.HandlerFunc(claimresponseController.VersionReadHandler))...))
+	claimresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	eligibilityrequestController := ResourceController{"EligibilityRequest"}
 	eligibilityrequestBase := router.Path("/EligibilityRequest").Subrouter()
 	eligibilityrequestBase.Methods("GET").Handler(negroni.New(append(config["EligibilityRequestIndex"], negroni.HandlerFunc(eligibilityrequestController.IndexHandler))...))
 	eligibilityrequestBase.Methods("POST").Handler(negroni.New(append(config["EligibilityRequestCreate"], negroni.HandlerFunc(eligibilityrequestController.CreateHandler))...))
+	eligibilityrequestBase.Methods("PUT").Handler(negroni.New(append(config["EligibilityRequestConditionalUpdate"], negroni.HandlerFunc(eligibilityrequestController.ConditionalUpdateHandler))...))
+	eligibilityrequestBase.Methods("DELETE").Handler(negroni.New(append(config["EligibilityRequestConditionalDelete"], negroni.HandlerFunc(eligibilityrequestController.ConditionalDeleteHandler))...))
+	eligibilityrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	eligibilityrequestTypeHistory := router.Path("/EligibilityRequest/_history").Subrouter()
+	eligibilityrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["EligibilityRequestTypeHistory"], negroni.HandlerFunc(eligibilityrequestController.TypeHistoryHandler))...))
+	eligibilityrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	eligibilityrequest := router.Path("/EligibilityRequest/{id}").Subrouter()
 	eligibilityrequest.Methods("GET").Handler(negroni.New(append(config["EligibilityRequestShow"], negroni.HandlerFunc(eligibilityrequestController.ShowHandler))...))
 	eligibilityrequest.Methods("PUT").Handler(negroni.New(append(config["EligibilityRequestUpdate"], negroni.HandlerFunc(eligibilityrequestController.UpdateHandler))...))
+	eligibilityrequest.Methods("PATCH").Handler(negroni.New(append(config["EligibilityRequestUpdate"], negroni.HandlerFunc(eligibilityrequestController.PatchHandler))...))
 	eligibilityrequest.Methods("DELETE").Handler(negroni.New(append(config["EligibilityRequestDelete"], negroni.HandlerFunc(eligibilityrequestController.DeleteHandler))...))
+	eligibilityrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	eligibilityrequestInstanceHistory := router.Path("/EligibilityRequest/{id}/_history").Subrouter()
+	eligibilityrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EligibilityRequestInstanceHistory"], negroni.HandlerFunc(eligibilityrequestController.InstanceHistoryHandler))...))
+	eligibilityrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	eligibilityrequestVersion := router.Path("/EligibilityRequest/{id}/_history/{vid}").Subrouter()
+	eligibilityrequestVersion.Methods("GET").Handler(negroni.New(append(config["EligibilityRequestVRead"], negroni.HandlerFunc(eligibilityrequestController.VersionReadHandler))...))
+	eligibilityrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	processrequestController := ResourceController{"ProcessRequest"}
 	processrequestBase := router.Path("/ProcessRequest").Subrouter()
 	processrequestBase.Methods("GET").Handler(negroni.New(append(config["ProcessRequestIndex"], negroni.HandlerFunc(processrequestController.IndexHandler))...))
 	processrequestBase.Methods("POST").Handler(negroni.New(append(config["ProcessRequestCreate"], negroni.HandlerFunc(processrequestController.CreateHandler))...))
+	processrequestBase.Methods("PUT").Handler(negroni.New(append(config["ProcessRequestConditionalUpdate"], negroni.HandlerFunc(processrequestController.ConditionalUpdateHandler))...))
+	processrequestBase.Methods("DELETE").Handler(negroni.New(append(config["ProcessRequestConditionalDelete"], negroni.HandlerFunc(processrequestController.ConditionalDeleteHandler))...))
+	processrequestBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	processrequestTypeHistory := router.Path("/ProcessRequest/_history").Subrouter()
+	processrequestTypeHistory.Methods("GET").Handler(negroni.New(append(config["ProcessRequestTypeHistory"], negroni.HandlerFunc(processrequestController.TypeHistoryHandler))...))
+	processrequestTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	processrequest := router.Path("/ProcessRequest/{id}").Subrouter()
 	processrequest.Methods("GET").Handler(negroni.New(append(config["ProcessRequestShow"], negroni.HandlerFunc(processrequestController.ShowHandler))...))
 	processrequest.Methods("PUT").Handler(negroni.New(append(config["ProcessRequestUpdate"], negroni.HandlerFunc(processrequestController.UpdateHandler))...))
+	processrequest.Methods("PATCH").Handler(negroni.New(append(config["ProcessRequestUpdate"], negroni.HandlerFunc(processrequestController.PatchHandler))...))
 	processrequest.Methods("DELETE").Handler(negroni.New(append(config["ProcessRequestDelete"], negroni.HandlerFunc(processrequestController.DeleteHandler))...))
+	processrequest.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	processrequestInstanceHistory := router.Path("/ProcessRequest/{id}/_history").Subrouter()
+	processrequestInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ProcessRequestInstanceHistory"], negroni.HandlerFunc(processrequestController.InstanceHistoryHandler))...))
+	processrequestInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	processrequestVersion := router.Path("/ProcessRequest/{id}/_history/{vid}").Subrouter()
+	processrequestVersion.Methods("GET").Handler(negroni.New(append(config["ProcessRequestVRead"], negroni.HandlerFunc(processrequestController.VersionReadHandler))...))
+	processrequestVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationdispenseController := ResourceController{"MedicationDispense"}
 	medicationdispenseBase := router.Path("/MedicationDispense").Subrouter()
 	medicationdispenseBase.Methods("GET").Handler(negroni.New(append(config["MedicationDispenseIndex"], negroni.HandlerFunc(medicationdispenseController.IndexHandler))...))
 	medicationdispenseBase.Methods("POST").Handler(negroni.New(append(config["MedicationDispenseCreate"], negroni.HandlerFunc(medicationdispenseController.CreateHandler))...))
+	medicationdispenseBase.Methods("PUT").Handler(negroni.New(append(config["MedicationDispenseConditionalUpdate"], negroni.HandlerFunc(medicationdispenseController.ConditionalUpdateHandler))...))
+	medicationdispenseBase.Methods("DELETE").Handler(negroni.New(append(config["MedicationDispenseConditionalDelete"], negroni.HandlerFunc(medicationdispenseController.ConditionalDeleteHandler))...))
+	medicationdispenseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	medicationdispenseTypeHistory := router.Path("/MedicationDispense/_history").Subrouter()
+	medicationdispenseTypeHistory.Methods("GET").Handler(negroni.New(append(config["MedicationDispenseTypeHistory"], negroni.HandlerFunc(medicationdispenseController.TypeHistoryHandler))...))
+	medicationdispenseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationdispense := router.Path("/MedicationDispense/{id}").Subrouter()
 	medicationdispense.Methods("GET").Handler(negroni.New(append(config["MedicationDispenseShow"], negroni.HandlerFunc(medicationdispenseController.ShowHandler))...))
 	medicationdispense.Methods("PUT").Handler(negroni.New(append(config["MedicationDispenseUpdate"], negroni.HandlerFunc(medicationdispenseController.UpdateHandler))...))
+	medicationdispense.Methods("PATCH").Handler(negroni.New(append(config["MedicationDispenseUpdate"], negroni.HandlerFunc(medicationdispenseController.PatchHandler))...))
 	medicationdispense.Methods("DELETE").Handler(negroni.New(append(config["MedicationDispenseDelete"], negroni.HandlerFunc(medicationdispenseController.DeleteHandler))...))
+	medicationdispense.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	medicationdispenseInstanceHistory := router.Path("/MedicationDispense/{id}/_history").Subrouter()
+	medicationdispenseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MedicationDispenseInstanceHistory"], negroni.HandlerFunc(medicationdispenseController.InstanceHistoryHandler))...))
+	medicationdispenseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	medicationdispenseVersion := router.Path("/MedicationDispense/{id}/_history/{vid}").Subrouter()
+	medicationdispenseVersion.Methods("GET").Handler(negroni.New(append(config["MedicationDispenseVRead"], negroni.HandlerFunc(medicationdispenseController.VersionReadHandler))...))
+	medicationdispenseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	diagnosticreportController := ResourceController{"DiagnosticReport"}
 	diagnosticreportBase := router.Path("/DiagnosticReport").Subrouter()
 	diagnosticreportBase.Methods("GET").Handler(negroni.New(append(config["DiagnosticReportIndex"], negroni.HandlerFunc(diagnosticreportController.IndexHandler))...))
 	diagnosticreportBase.Methods("POST").Handler(negroni.New(append(config["DiagnosticReportCreate"], negroni.HandlerFunc(diagnosticreportController.CreateHandler))...))
+	diagnosticreportBase.Methods("PUT").Handler(negroni.New(append(config["DiagnosticReportConditionalUpdate"], negroni.HandlerFunc(diagnosticreportController.ConditionalUpdateHandler))...))
+	diagnosticreportBase.Methods("DELETE").Handler(negroni.New(append(config["DiagnosticReportConditionalDelete"], negroni.HandlerFunc(diagnosticreportController.ConditionalDeleteHandler))...))
+	diagnosticreportBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	diagnosticreportTypeHistory := router.Path("/DiagnosticReport/_history").Subrouter()
+	diagnosticreportTypeHistory.Methods("GET").Handler(negroni.New(append(config["DiagnosticReportTypeHistory"], negroni.HandlerFunc(diagnosticreportController.TypeHistoryHandler))...))
+	diagnosticreportTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	diagnosticreport := router.Path("/DiagnosticReport/{id}").Subrouter()
 	diagnosticreport.Methods("GET").Handler(negroni.New(append(config["DiagnosticReportShow"], negroni.HandlerFunc(diagnosticreportController.ShowHandler))...))
 	diagnosticreport.Methods("PUT").Handler(negroni.New(append(config["DiagnosticReportUpdate"], negroni.HandlerFunc(diagnosticreportController.UpdateHandler))...))
+	diagnosticreport.Methods("PATCH").Handler(negroni.New(append(config["DiagnosticReportUpdate"], negroni.HandlerFunc(diagnosticreportController.PatchHandler))...))
 	diagnosticreport.Methods("DELETE").Handler(negroni.New(append(config["DiagnosticReportDelete"], negroni.HandlerFunc(diagnosticreportController.DeleteHandler))...))
+	diagnosticreport.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	diagnosticreportInstanceHistory := router.Path("/DiagnosticReport/{id}/_history").Subrouter()
+	diagnosticreportInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DiagnosticReportInstanceHistory"], negroni.HandlerFunc(diagnosticreportController.InstanceHistoryHandler))...))
+	diagnosticreportInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	diagnosticreportVersion := router.Path("/DiagnosticReport/{id}/_history/{vid}").Subrouter()
+	diagnosticreportVersion.Methods("GET").Handler(negroni.New(append(config["DiagnosticReportVRead"], negroni.HandlerFunc(diagnosticreportController.VersionReadHandler))...))
+	diagnosticreportVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	imagingstudyController := ResourceController{"ImagingStudy"}
 	imagingstudyBase := router.Path("/ImagingStudy").Subrouter()
 	imagingstudyBase.Methods("GET").Handler(negroni.New(append(config["ImagingStudyIndex"], negroni.HandlerFunc(imagingstudyController.IndexHandler))...))
 	imagingstudyBase.Methods("POST").Handler(negroni.New(append(config["ImagingStudyCreate"], negroni.HandlerFunc(imagingstudyController.CreateHandler))...))
+	imagingstudyBase.Methods("PUT").Handler(negroni.New(append(config["ImagingStudyConditionalUpdate"], negroni.HandlerFunc(imagingstudyController.ConditionalUpdateHandler))...))
+	imagingstudyBase.Methods("DELETE").Handler(negroni.New(append(config["ImagingStudyConditionalDelete"], negroni.HandlerFunc(imagingstudyController.ConditionalDeleteHandler))...))
+	imagingstudyBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	imagingstudyTypeHistory := router.Path("/ImagingStudy/_history").Subrouter()
+	imagingstudyTypeHistory.Methods("GET").Handler(negroni.New(append(config["ImagingStudyTypeHistory"], negroni.HandlerFunc(imagingstudyController.TypeHistoryHandler))...))
+	imagingstudyTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	imagingstudy := router.Path("/ImagingStudy/{id}").Subrouter()
 	imagingstudy.Methods("GET").Handler(negroni.New(append(config["ImagingStudyShow"], negroni.HandlerFunc(imagingstudyController.ShowHandler))...))
 	imagingstudy.Methods("PUT").Handler(negroni.New(append(config["ImagingStudyUpdate"], negroni.HandlerFunc(imagingstudyController.UpdateHandler))...))
+	imagingstudy.Methods("PATCH").Handler(negroni.New(append(config["ImagingStudyUpdate"], negroni.HandlerFunc(imagingstudyController.PatchHandler))...))
 	imagingstudy.Methods("DELETE").Handler(negroni.New(append(config["ImagingStudyDelete"], negroni.HandlerFunc(imagingstudyController.DeleteHandler))...))
+	imagingstudy.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	imagingstudyInstanceHistory := router.Path("/ImagingStudy/{id}/_history").Subrouter()
+	imagingstudyInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ImagingStudyInstanceHistory"], negroni.HandlerFunc(imagingstudyController.InstanceHistoryHandler))...))
+	imagingstudyInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	imagingstudyVersion := router.Path("/ImagingStudy/{id}/_history/{vid}").Subrouter()
+	imagingstudyVersion.Methods("GET").Handler(negroni.New(append(config["ImagingStudyVRead"], negroni.HandlerFunc(imagingstudyController.VersionReadHandler))...))
+	imagingstudyVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	imagingobjectselectionController := ResourceController{"ImagingObjectSelection"}
 	imagingobjectselectionBase := router.Path("/ImagingObjectSelection").Subrouter()
 	imagingobjectselectionBase.Methods("GET").Handler(negroni.New(append(config["ImagingObjectSelectionIndex"], negroni.HandlerFunc(imagingobjectselectionController.IndexHandler))...))
 	imagingobjectselectionBase.Methods("POST").Handler(negroni.New(append(config["ImagingObjectSelectionCreate"], negroni.HandlerFunc(imagingobjectselectionController.CreateHandler))...))
+	imagingobjectselectionBase.Methods("PUT").Handler(negroni.New(append(config["ImagingObjectSelectionConditionalUpdate"], negroni.HandlerFunc(imagingobjectselectionController.ConditionalUpdateHandler))...))
+	imagingobjectselectionBase.Methods("DELETE").Handler(negroni.New(append(config["ImagingObjectSelectionConditionalDelete"], negroni.HandlerFunc(imagingobjectselectionController.ConditionalDeleteHandler))...))
+	imagingobjectselectionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	imagingobjectselectionTypeHistory := router.Path("/ImagingObjectSelection/_history").Subrouter()
+	imagingobjectselectionTypeHistory.Methods("GET").Handler(negroni.New(append(config["ImagingObjectSelectionTypeHistory"], negroni.HandlerFunc(imagingobjectselectionController.TypeHistoryHandler))...))
+	imagingobjectselectionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	imagingobjectselection := router.Path("/ImagingObjectSelection/{id}").Subrouter()
 	imagingobjectselection.Methods("GET").Handler(negroni.New(append(config["ImagingObjectSelectionShow"], negroni.HandlerFunc(imagingobjectselectionController.ShowHandler))...))
 	imagingobjectselection.Methods("PUT").Handler(negroni.New(append(config["ImagingObjectSelectionUpdate"], negroni.HandlerFunc(imagingobjectselectionController.UpdateHandler))...))
+	imagingobjectselection.Methods("PATCH").Handler(negroni.New(append(config["ImagingObjectSelectionUpdate"], negroni.HandlerFunc(imagingobjectselectionController.PatchHandler))...))
 	imagingobjectselection.Methods("DELETE").Handler(negroni.New(append(config["ImagingObjectSelectionDelete"], negroni.HandlerFunc(imagingobjectselectionController.DeleteHandler))...))
+	imagingobjectselection.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	imagingobjectselectionInstanceHistory := router.Path("/ImagingObjectSelection/{id}/_history").Subrouter()
+	imagingobjectselectionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ImagingObjectSelectionInstanceHistory"], negroni.HandlerFunc(imagingobjectselectionController.InstanceHistoryHandler))...))
+	imagingobjectselectionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	imagingobjectselectionVersion := router.Path("/ImagingObjectSelection/{id}/_history/{vid}").Subrouter()
+	imagingobjectselectionVersion.Methods("GET").Handler(negroni.New(append(config["ImagingObjectSelectionVRead"], negroni.HandlerFunc(imagingobjectselectionController.VersionReadHandler))...))
+	imagingobjectselectionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	healthcareserviceController := ResourceController{"HealthcareService"}
 	healthcareserviceBase := router.Path("/HealthcareService").Subrouter()
 	healthcareserviceBase.Methods("GET").Handler(negroni.New(append(config["HealthcareServiceIndex"], negroni.HandlerFunc(healthcareserviceController.IndexHandler))...))
 	healthcareserviceBase.Methods("POST").Handler(negroni.New(append(config["HealthcareServiceCreate"], negroni.HandlerFunc(healthcareserviceController.CreateHandler))...))
+	healthcareserviceBase.Methods("PUT").Handler(negroni.New(append(config["HealthcareServiceConditionalUpdate"], negroni.HandlerFunc(healthcareserviceController.ConditionalUpdateHandler))...))
+	healthcareserviceBase.Methods("DELETE").Handler(negroni.New(append(config["HealthcareServiceConditionalDelete"], negroni.HandlerFunc(healthcareserviceController.ConditionalDeleteHandler))...))
+	healthcareserviceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	healthcareserviceTypeHistory := router.Path("/HealthcareService/_history").Subrouter()
+	healthcareserviceTypeHistory.Methods("GET").Handler(negroni.New(append(config["HealthcareServiceTypeHistory"], negroni.HandlerFunc(healthcareserviceController.TypeHistoryHandler))...))
+	healthcareserviceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	healthcareservice := router.Path("/HealthcareService/{id}").Subrouter()
 	healthcareservice.Methods("GET").Handler(negroni.New(append(config["HealthcareServiceShow"], negroni.HandlerFunc(healthcareserviceController.ShowHandler))...))
 	healthcareservice.Methods("PUT").Handler(negroni.New(append(config["HealthcareServiceUpdate"], negroni.HandlerFunc(healthcareserviceController.UpdateHandler))...))
+	healthcareservice.Methods("PATCH").Handler(negroni.New(append(config["HealthcareServiceUpdate"], negroni.HandlerFunc(healthcareserviceController.PatchHandler))...))
 	healthcareservice.Methods("DELETE").Handler(negroni.New(append(config["HealthcareServiceDelete"], negroni.HandlerFunc(healthcareserviceController.DeleteHandler))...))
+	healthcareservice.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	healthcareserviceInstanceHistory := router.Path("/HealthcareService/{id}/_history").Subrouter()
+	healthcareserviceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["HealthcareServiceInstanceHistory"], negroni.HandlerFunc(healthcareserviceController.InstanceHistoryHandler))...))
+	healthcareserviceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	healthcareserviceVersion := router.Path("/HealthcareService/{id}/_history/{vid}").Subrouter()
+	healthcareserviceVersion.Methods("GET").Handler(negroni.New(append(config["HealthcareServiceVRead"], negroni.HandlerFunc(healthcareserviceController.VersionReadHandler))...))
+	healthcareserviceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	dataelementController := ResourceController{"DataElement"}
 	dataelementBase := router.Path("/DataElement").Subrouter()
 	dataelementBase.Methods("GET").Handler(negroni.New(append(config["DataElementIndex"], negroni.HandlerFunc(dataelementController.IndexHandler))...))
 	dataelementBase.Methods("POST").Handler(negroni.New(append(config["DataElementCreate"], negroni.HandlerFunc(dataelementController.CreateHandler))...))
+	dataelementBase.Methods("PUT").Handler(negroni.New(append(config["DataElementConditionalUpdate"], negroni.HandlerFunc(dataelementController.ConditionalUpdateHandler))...))
+	dataelementBase.Methods("DELETE").Handler(negroni.New(append(config["DataElementConditionalDelete"], negroni.HandlerFunc(dataelementController.ConditionalDeleteHandler))...))
+	dataelementBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	dataelementTypeHistory := router.Path("/DataElement/_history").Subrouter()
+	dataelementTypeHistory.Methods("GET").Handler(negroni.New(append(config["DataElementTypeHistory"], negroni.HandlerFunc(dataelementController.TypeHistoryHandler))...))
+	dataelementTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	dataelement := router.Path("/DataElement/{id}").Subrouter()
 	dataelement.Methods("GET").Handler(negroni.New(append(config["DataElementShow"], negroni.HandlerFunc(dataelementController.ShowHandler))...))
 	dataelement.Methods("PUT").Handler(negroni.New(append(config["DataElementUpdate"], negroni.HandlerFunc(dataelementController.UpdateHandler))...))
+	dataelement.Methods("PATCH").Handler(negroni.New(append(config["DataElementUpdate"], negroni.HandlerFunc(dataelementController.PatchHandler))...))
 	dataelement.Methods("DELETE").Handler(negroni.New(append(config["DataElementDelete"], negroni.HandlerFunc(dataelementController.DeleteHandler))...))
+	dataelement.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	dataelementInstanceHistory := router.Path("/DataElement/{id}/_history").Subrouter()
+	dataelementInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DataElementInstanceHistory"], negroni.HandlerFunc(dataelementController.InstanceHistoryHandler))...))
+	dataelementInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	dataelementVersion := router.Path("/DataElement/{id}/_history/{vid}").Subrouter()
+	dataelementVersion.Methods("GET").Handler(negroni.New(append(config["DataElementVRead"], negroni.HandlerFunc(dataelementController.VersionReadHandler))...))
+	dataelementVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	devicecomponentController := ResourceController{"DeviceComponent"}
 	devicecomponentBase := router.Path("/DeviceComponent").Subrouter()
 	devicecomponentBase.Methods("GET").Handler(negroni.New(append(config["DeviceComponentIndex"], negroni.HandlerFunc(devicecomponentController.IndexHandler))...))
 	devicecomponentBase.Methods("POST").Handler(negroni.New(append(config["DeviceComponentCreate"], negroni.HandlerFunc(devicecomponentController.CreateHandler))...))
+	devicecomponentBase.Methods("PUT").Handler(negroni.New(append(config["DeviceComponentConditionalUpdate"], negroni.HandlerFunc(devicecomponentController.ConditionalUpdateHandler))...))
+	devicecomponentBase.Methods("DELETE").Handler(negroni.New(append(config["DeviceComponentConditionalDelete"], negroni.HandlerFunc(devicecomponentController.ConditionalDeleteHandler))...))
+	devicecomponentBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	devicecomponentTypeHistory := router.Path("/DeviceComponent/_history").Subrouter()
+	devicecomponentTypeHistory.Methods("GET").Handler(negroni.New(append(config["DeviceComponentTypeHistory"], negroni.HandlerFunc(devicecomponentController.TypeHistoryHandler))...))
+	devicecomponentTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	devicecomponent := router.Path("/DeviceComponent/{id}").Subrouter()
 	devicecomponent.Methods("GET").Handler(negroni.New(append(config["DeviceComponentShow"], negroni.HandlerFunc(devicecomponentController.ShowHandler))...))
 	devicecomponent.Methods("PUT").Handler(negroni.New(append(config["DeviceComponentUpdate"], negroni.HandlerFunc(devicecomponentController.UpdateHandler))...))
+	devicecomponent.Methods("PATCH").Handler(negroni.New(append(config["DeviceComponentUpdate"], negroni.HandlerFunc(devicecomponentController.PatchHandler))...))
 	devicecomponent.Methods("DELETE").Handler(negroni.New(append(config["DeviceComponentDelete"], negroni.HandlerFunc(devicecomponentController.DeleteHandler))...))
+	devicecomponent.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	devicecomponentInstanceHistory := router.Path("/DeviceComponent/{id}/_history").Subrouter()
+	devicecomponentInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DeviceComponentInstanceHistory"], negroni.HandlerFunc(devicecomponentController.InstanceHistoryHandler))...))
+	devicecomponentInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	devicecomponentVersion := router.Path("/DeviceComponent/{id}/_history/{vid}").Subrouter()
+	devicecomponentVersion.Methods("GET").Handler(negroni.New(append(config["DeviceComponentVRead"], negroni.HandlerFunc(devicecomponentController.VersionReadHandler))...))
+	devicecomponentVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	familymemberhistoryController := ResourceController{"FamilyMemberHistory"}
 	familymemberhistoryBase := router.Path("/FamilyMemberHistory").Subrouter()
 	familymemberhistoryBase.Methods("GET").Handler(negroni.New(append(config["FamilyMemberHistoryIndex"], negroni.HandlerFunc(familymemberhistoryController.IndexHandler))...))
 	familymemberhistoryBase.Methods("POST").Handler(negroni.New(append(config["FamilyMemberHistoryCreate"], negroni.HandlerFunc(familymemberhistoryController.CreateHandler))...))
+	familymemberhistoryBase.Methods("PUT").Handler(negroni.New(append(config["FamilyMemberHistoryConditionalUpdate"], negroni.HandlerFunc(familymemberhistoryController.ConditionalUpdateHandler))...))
+	familymemberhistoryBase.Methods("DELETE").Handler(negroni.New(append(config["FamilyMemberHistoryConditionalDelete"], negroni.HandlerFunc(familymemberhistoryController.ConditionalDeleteHandler))...))
+	familymemberhistoryBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	familymemberhistoryTypeHistory := router.Path("/FamilyMemberHistory/_history").Subrouter()
+	familymemberhistoryTypeHistory.Methods("GET").Handler(negroni.New(append(config["FamilyMemberHistoryTypeHistory"], negroni.HandlerFunc(familymemberhistoryController.TypeHistoryHandler))...))
+	familymemberhistoryTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	familymemberhistory := router.Path("/FamilyMemberHistory/{id}").Subrouter()
 	familymemberhistory.Methods("GET").Handler(negroni.New(append(config["FamilyMemberHistoryShow"], negroni.HandlerFunc(familymemberhistoryController.ShowHandler))...))
 	familymemberhistory.Methods("PUT").Handler(negroni.New(append(config["FamilyMemberHistoryUpdate"], negroni.HandlerFunc(familymemberhistoryController.UpdateHandler))...))
+	familymemberhistory.Methods("PATCH").Handler(negroni.New(append(config["FamilyMemberHistoryUpdate"], negroni.HandlerFunc(familymemberhistoryController.PatchHandler))...))
 	familymemberhistory.Methods("DELETE").Handler(negroni.New(append(config["FamilyMemberHistoryDelete"], negroni.HandlerFunc(familymemberhistoryController.DeleteHandler))...))
+	familymemberhistory.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	familymemberhistoryInstanceHistory := router.Path("/FamilyMemberHistory/{id}/_history").Subrouter()
+	familymemberhistoryInstanceHistory.Methods("GET").Handler(negroni.New(append(config["FamilyMemberHistoryInstanceHistory"], negroni.HandlerFunc(familymemberhistoryController.InstanceHistoryHandler))...))
+	familymemberhistoryInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	familymemberhistoryVersion := router.Path("/FamilyMemberHistory/{id}/_history/{vid}").Subrouter()
+	familymemberhistoryVersion.Methods("GET").Handler(negroni.New(append(config["FamilyMemberHistoryVRead"], negroni.HandlerFunc(familymemberhistoryController.VersionReadHandler))...))
+	familymemberhistoryVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	nutritionorderController := ResourceController{"NutritionOrder"}
 	nutritionorderBase := router.Path("/NutritionOrder").Subrouter()
 	nutritionorderBase.Methods("GET").Handler(negroni.New(append(config["NutritionOrderIndex"], negroni.HandlerFunc(nutritionorderController.IndexHandler))...))
 	nutritionorderBase.Methods("POST").Handler(negroni.New(append(config["NutritionOrderCreate"], negroni.HandlerFunc(nutritionorderController.CreateHandler))...))
+	nutritionorderBase.Methods("PUT").Handler(negroni.New(append(config["NutritionOrderConditionalUpdate"], negroni.HandlerFunc(nutritionorderController.ConditionalUpdateHandler))...))
+	nutritionorderBase.Methods("DELETE").Handler(negroni.New(append(config["NutritionOrderConditionalDelete"], negroni.HandlerFunc(nutritionorderController.ConditionalDeleteHandler))...))
+	nutritionorderBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	nutritionorderTypeHistory := router.Path("/NutritionOrder/_history").Subrouter()
+	nutritionorderTypeHistory.Methods("GET").Handler(negroni.New(append(config["NutritionOrderTypeHistory"], negroni.HandlerFunc(nutritionorderController.TypeHistoryHandler))...))
+	nutritionorderTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	nutritionorder := router.Path("/NutritionOrder/{id}").Subrouter()
 	nutritionorder.Methods("GET").Handler(negroni.New(append(config["NutritionOrderShow"], negroni.HandlerFunc(nutritionorderController.ShowHandler))...))
 	nutritionorder.Methods("PUT").Handler(negroni.New(append(config["NutritionOrderUpdate"], negroni.HandlerFunc(nutritionorderController.UpdateHandler))...))
+	nutritionorder.Methods("PATCH").Handler(negroni.New(append(config["NutritionOrderUpdate"], negroni.HandlerFunc(nutritionorderController.PatchHandler))...))
 	nutritionorder.Methods("DELETE").Handler(negroni.New(append(config["NutritionOrderDelete"], negroni.HandlerFunc(nutritionorderController.DeleteHandler))...))
+	nutritionorder.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	nutritionorderInstanceHistory := router.Path("/NutritionOrder/{id}/_history").Subrouter()
+	nutritionorderInstanceHistory.Methods("GET").Handler(negroni.New(append(config["NutritionOrderInstanceHistory"], negroni.HandlerFunc(nutritionorderController.InstanceHistoryHandler))...))
+	nutritionorderInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	nutritionorderVersion := router.Path("/NutritionOrder/{id}/_history/{vid}").Subrouter()
+	nutritionorderVersion.Methods("GET").Handler(negroni.New(append(config["NutritionOrderVRead"], negroni.HandlerFunc(nutritionorderController.VersionReadHandler))...))
+	nutritionorderVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	encounterController := ResourceController{"Encounter"}
 	encounterBase := router.Path("/Encounter").Subrouter()
 	encounterBase.Methods("GET").Handler(negroni.New(append(config["EncounterIndex"], negroni.HandlerFunc(encounterController.IndexHandler))...))
 	encounterBase.Methods("POST").Handler(negroni.New(append(config["EncounterCreate"], negroni.HandlerFunc(encounterController.CreateHandler))...))
+	encounterBase.Methods("PUT").Handler(negroni.New(append(config["EncounterConditionalUpdate"], negroni.HandlerFunc(encounterController.ConditionalUpdateHandler))...))
+	encounterBase.Methods("DELETE").Handler(negroni.New(append(config["EncounterConditionalDelete"], negroni.HandlerFunc(encounterController.ConditionalDeleteHandler))...))
+	encounterBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	encounterTypeHistory := router.Path("/Encounter/_history").Subrouter()
+	encounterTypeHistory.Methods("GET").Handler(negroni.New(append(config["EncounterTypeHistory"], negroni.HandlerFunc(encounterController.TypeHistoryHandler))...))
+	encounterTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	encounter := router.Path("/Encounter/{id}").Subrouter()
 	encounter.Methods("GET").Handler(negroni.New(append(config["EncounterShow"], negroni.HandlerFunc(encounterController.ShowHandler))...))
 	encounter.Methods("PUT").Handler(negroni.New(append(config["EncounterUpdate"], negroni.HandlerFunc(encounterController.UpdateHandler))...))
+	encounter.Methods("PATCH").Handler(negroni.New(append(config["EncounterUpdate"], negroni.HandlerFunc(encounterController.PatchHandler))...))
 	encounter.Methods("DELETE").Handler(negroni.New(append(config["EncounterDelete"], negroni.HandlerFunc(encounterController.DeleteHandler))...))
+	encounter.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	encounterInstanceHistory := router.Path("/Encounter/{id}/_history").Subrouter()
+	encounterInstanceHistory.Methods("GET").Handler(negroni.New(append(config["EncounterInstanceHistory"], negroni.HandlerFunc(encounterController.InstanceHistoryHandler))...))
+	encounterInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	encounterVersion := router.Path("/Encounter/{id}/_history/{vid}").Subrouter()
+	encounterVersion.Methods("GET").Handler(negroni.New(append(config["EncounterVRead"], negroni.HandlerFunc(encounterController.VersionReadHandler))...))
+	encounterVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	substanceController := ResourceController{"Substance"}
 	substanceBase := router.Path("/Substance").Subrouter()
 	substanceBase.Methods("GET").Handler(negroni.New(append(config["SubstanceIndex"], negroni.HandlerFunc(substanceController.IndexHandler))...))
 	substanceBase.Methods("POST").Handler(negroni.New(append(config["SubstanceCreate"], negroni.HandlerFunc(substanceController.CreateHandler))...))
+	substanceBase.Methods("PUT").Handler(negroni.New(append(config["SubstanceConditionalUpdate"], negroni.HandlerFunc(substanceController.ConditionalUpdateHandler))...))
+	substanceBase.Methods("DELETE").Handler(negroni.New(append(config["SubstanceConditionalDelete"], negroni.HandlerFunc(substanceController.ConditionalDeleteHandler))...))
+	substanceBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	substanceTypeHistory := router.Path("/Substance/_history").Subrouter()
+	substanceTypeHistory.Methods("GET").Handler(negroni.New(append(config["SubstanceTypeHistory"], negroni.HandlerFunc(substanceController.TypeHistoryHandler))...))
+	substanceTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	substance := router.Path("/Substance/{id}").Subrouter()
 	substance.Methods("GET").Handler(negroni.New(append(config["SubstanceShow"], negroni.HandlerFunc(substanceController.ShowHandler))...))
 	substance.Methods("PUT").Handler(negroni.New(append(config["SubstanceUpdate"], negroni.HandlerFunc(substanceController.UpdateHandler))...))
+	substance.Methods("PATCH").Handler(negroni.New(append(config["SubstanceUpdate"], negroni.HandlerFunc(substanceController.PatchHandler))...))
 	substance.Methods("DELETE").Handler(negroni.New(append(config["SubstanceDelete"], negroni.HandlerFunc(substanceController.DeleteHandler))...))
+	substance.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	substanceInstanceHistory := router.Path("/Substance/{id}/_history").Subrouter()
+	substanceInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SubstanceInstanceHistory"], negroni.HandlerFunc(substanceController.InstanceHistoryHandler))...))
+	substanceInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	substanceVersion := router.Path("/Substance/{id}/_history/{vid}").Subrouter()
+	substanceVersion.Methods("GET").Handler(negroni.New(append(config["SubstanceVRead"], negroni.HandlerFunc(substanceController.VersionReadHandler))...))
+	substanceVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	auditeventController := ResourceController{"AuditEvent"}
 	auditeventBase := router.Path("/AuditEvent").Subrouter()
 	auditeventBase.Methods("GET").Handler(negroni.New(append(config["AuditEventIndex"], negroni.HandlerFunc(auditeventController.IndexHandler))...))
 	auditeventBase.Methods("POST").Handler(negroni.New(append(config["AuditEventCreate"], negroni.HandlerFunc(auditeventController.CreateHandler))...))
+	auditeventBase.Methods("PUT").Handler(negroni.New(append(config["AuditEventConditionalUpdate"], negroni.HandlerFunc(auditeventController.ConditionalUpdateHandler))...))
+	auditeventBase.Methods("DELETE").Handler(negroni.New(append(config["AuditEventConditionalDelete"], negroni.HandlerFunc(auditeventController.ConditionalDeleteHandler))...))
+	auditeventBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	auditeventTypeHistory := router.Path("/AuditEvent/_history").Subrouter()
+	auditeventTypeHistory.Methods("GET").Handler(negroni.New(append(config["AuditEventTypeHistory"], negroni.HandlerFunc(auditeventController.TypeHistoryHandler))...))
+	auditeventTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	auditevent := router.Path("/AuditEvent/{id}").Subrouter()
 	auditevent.Methods("GET").Handler(negroni.New(append(config["AuditEventShow"], negroni.HandlerFunc(auditeventController.ShowHandler))...))
 	auditevent.Methods("PUT").Handler(negroni.New(append(config["AuditEventUpdate"], negroni.HandlerFunc(auditeventController.UpdateHandler))...))
+	auditevent.Methods("PATCH").Handler(negroni.New(append(config["AuditEventUpdate"], negroni.HandlerFunc(auditeventController.PatchHandler))...))
 	auditevent.Methods("DELETE").Handler(negroni.New(append(config["AuditEventDelete"], negroni.HandlerFunc(auditeventController.DeleteHandler))...))
+	auditevent.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	auditeventInstanceHistory := router.Path("/AuditEvent/{id}/_history").Subrouter()
+	auditeventInstanceHistory.Methods("GET").Handler(negroni.New(append(config["AuditEventInstanceHistory"], negroni.HandlerFunc(auditeventController.InstanceHistoryHandler))...))
+	auditeventInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	auditeventVersion := router.Path("/AuditEvent/{id}/_history/{vid}").Subrouter()
+	auditeventVersion.Methods("GET").Handler(negroni.New(append(config["AuditEventVRead"], negroni.HandlerFunc(auditeventController.VersionReadHandler))...))
+	auditeventVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationorderController := ResourceController{"MedicationOrder"}
 	medicationorderBase := router.Path("/MedicationOrder").Subrouter()
 	medicationorderBase.Methods("GET").Handler(negroni.New(append(config["MedicationOrderIndex"], negroni.HandlerFunc(medicationorderController.IndexHandler))...))
 	medicationorderBase.Methods("POST").Handler(negroni.New(append(config["MedicationOrderCreate"], negroni.HandlerFunc(medicationorderController.CreateHandler))...))
+	medicationorderBase.Methods("PUT").Handler(negroni.New(append(config["MedicationOrderConditionalUpdate"], negroni.HandlerFunc(medicationorderController.ConditionalUpdateHandler))...))
+	medicationorderBase.Methods("DELETE").Handler(negroni.New(append(config["MedicationOrderConditionalDelete"], negroni.HandlerFunc(medicationorderController.ConditionalDeleteHandler))...))
+	medicationorderBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	medicationorderTypeHistory := router.Path("/MedicationOrder/_history").Subrouter()
+	medicationorderTypeHistory.Methods("GET").Handler(negroni.New(append(config["MedicationOrderTypeHistory"], negroni.HandlerFunc(medicationorderController.TypeHistoryHandler))...))
+	medicationorderTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	medicationorder := router.Path("/MedicationOrder/{id}").Subrouter()
 	medicationorder.Methods("GET").Handler(negroni.New(append(config["MedicationOrderShow"], negroni.HandlerFunc(medicationorderController.ShowHandler))...))
 	medicationorder.Methods("PUT").Handler(negroni.New(append(config["MedicationOrderUpdate"], negroni.HandlerFunc(medicationorderController.UpdateHandler))...))
+	medicationorder.Methods("PATCH").Handler(negroni.New(append(config["MedicationOrderUpdate"], negroni.HandlerFunc(medicationorderController.PatchHandler))...))
 	medicationorder.Methods("DELETE").Handler(negroni.New(append(config["MedicationOrderDelete"], negroni.HandlerFunc(medicationorderController.DeleteHandler))...))
+	medicationorder.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	medicationorderInstanceHistory := router.Path("/MedicationOrder/{id}/_history").Subrouter()
+	medicationorderInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MedicationOrderInstanceHistory"], negroni.HandlerFunc(medicationorderController.InstanceHistoryHandler))...))
+	medicationorderInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	medicationorderVersion := router.Path("/MedicationOrder/{id}/_history/{vid}").Subrouter()
+	medicationorderVersion.Methods("GET").Handler(negroni.New(append(config["MedicationOrderVRead"], negroni.HandlerFunc(medicationorderController.VersionReadHandler))...))
+	medicationorderVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	searchparameterController := ResourceController{"SearchParameter"}
 	searchparameterBase := router.Path("/SearchParameter").Subrouter()
 	searchparameterBase.Methods("GET").Handler(negroni.New(append(config["SearchParameterIndex"], negroni.HandlerFunc(searchparameterController.IndexHandler))...))
 	searchparameterBase.Methods("POST").Handler(negroni.New(append(config["SearchParameterCreate"], negroni.HandlerFunc(searchparameterController.CreateHandler))...))
+	searchparameterBase.Methods("PUT").Handler(negroni.New(append(config["SearchParameterConditionalUpdate"], negroni.HandlerFunc(searchparameterController.ConditionalUpdateHandler))...))
+	searchparameterBase.Methods("DELETE").Handler(negroni.New(append(config["SearchParameterConditionalDelete"], negroni.HandlerFunc(searchparameterController.ConditionalDeleteHandler))...))
+	searchparameterBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	searchparameterTypeHistory := router.Path("/SearchParameter/_history").Subrouter()
+	searchparameterTypeHistory.Methods("GET").Handler(negroni.New(append(config["SearchParameterTypeHistory"], negroni.HandlerFunc(searchparameterController.TypeHistoryHandler))...))
+	searchparameterTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	searchparameter := router.Path("/SearchParameter/{id}").Subrouter()
 	searchparameter.Methods("GET").Handler(negroni.New(append(config["SearchParameterShow"], negroni.HandlerFunc(searchparameterController.ShowHandler))...))
 	searchparameter.Methods("PUT").Handler(negroni.New(append(config["SearchParameterUpdate"], negroni.HandlerFunc(searchparameterController.UpdateHandler))...))
+	searchparameter.Methods("PATCH").Handler(negroni.New(append(config["SearchParameterUpdate"], negroni.HandlerFunc(searchparameterController.PatchHandler))...))
 	searchparameter.Methods("DELETE").Handler(negroni.New(append(config["SearchParameterDelete"], negroni.HandlerFunc(searchparameterController.DeleteHandler))...))
+	searchparameter.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	searchparameterInstanceHistory := router.Path("/SearchParameter/{id}/_history").Subrouter()
+	searchparameterInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SearchParameterInstanceHistory"], negroni.HandlerFunc(searchparameterController.InstanceHistoryHandler))...))
+	searchparameterInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	searchparameterVersion := router.Path("/SearchParameter/{id}/_history/{vid}").Subrouter()
+	searchparameterVersion.Methods("GET").Handler(negroni.New(append(config["SearchParameterVRead"], negroni.HandlerFunc(searchparameterController.VersionReadHandler))...))
+	searchparameterVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	paymentreconciliationController := ResourceController{"PaymentReconciliation"}
 	paymentreconciliationBase := router.Path("/PaymentReconciliation").Subrouter()
 	paymentreconciliationBase.Methods("GET").Handler(negroni.New(append(config["PaymentReconciliationIndex"], negroni.HandlerFunc(paymentreconciliationController.IndexHandler))...))
 	paymentreconciliationBase.Methods("POST").Handler(negroni.New(append(config["PaymentReconciliationCreate"], negroni.HandlerFunc(paymentreconciliationController.CreateHandler))...))
+	paymentreconciliationBase.Methods("PUT").Handler(negroni.New(append(config["PaymentReconciliationConditionalUpdate"], negroni.HandlerFunc(paymentreconciliationController.ConditionalUpdateHandler))...))
+	paymentreconciliationBase.Methods("DELETE").Handler(negroni.New(append(config["PaymentReconciliationConditionalDelete"], negroni.HandlerFunc(paymentreconciliationController.ConditionalDeleteHandler))...))
+	paymentreconciliationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	paymentreconciliationTypeHistory := router.Path("/PaymentReconciliation/_history").Subrouter()
+	paymentreconciliationTypeHistory.Methods("GET").Handler(negroni.New(append(config["PaymentReconciliationTypeHistory"], negroni.HandlerFunc(paymentreconciliationController.TypeHistoryHandler))...))
+	paymentreconciliationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	paymentreconciliation := router.Path("/PaymentReconciliation/{id}").Subrouter()
 	paymentreconciliation.Methods("GET").Handler(negroni.New(append(config["PaymentReconciliationShow"], negroni.HandlerFunc(paymentreconciliationController.ShowHandler))...))
 	paymentreconciliation.Methods("PUT").Handler(negroni.New(append(config["PaymentReconciliationUpdate"], negroni.HandlerFunc(paymentreconciliationController.UpdateHandler))...))
+	paymentreconciliation.Methods("PATCH").Handler(negroni.New(append(config["PaymentReconciliationUpdate"], negroni.HandlerFunc(paymentreconciliationController.PatchHandler))...))
 	paymentreconciliation.Methods("DELETE").Handler(negroni.New(append(config["PaymentReconciliationDelete"], negroni.HandlerFunc(paymentreconciliationController.DeleteHandler))...))
+	paymentreconciliation.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	paymentreconciliationInstanceHistory := router.Path("/PaymentReconciliation/{id}/_history").Subrouter()
+	paymentreconciliationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["PaymentReconciliationInstanceHistory"], negroni.HandlerFunc(paymentreconciliationController.InstanceHistoryHandler))...))
+	paymentreconciliationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	paymentreconciliationVersion := router.Path("/PaymentReconciliation/{id}/_history/{vid}").Subrouter()
+	paymentreconciliationVersion.Methods("GET").Handler(negroni.New(append(config["PaymentReconciliationVRead"], negroni.HandlerFunc(paymentreconciliationController.VersionReadHandler))...))
+	paymentreconciliationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	communicationController := ResourceController{"Communication"}
 	communicationBase := router.Path("/Communication").Subrouter()
 	communicationBase.Methods("GET").Handler(negroni.New(append(config["CommunicationIndex"], negroni.HandlerFunc(communicationController.IndexHandler))...))
 	communicationBase.Methods("POST").Handler(negroni.New(append(config["CommunicationCreate"], negroni.HandlerFunc(communicationController.CreateHandler))...))
+	communicationBase.Methods("PUT").Handler(negroni.New(append(config["CommunicationConditionalUpdate"], negroni.HandlerFunc(communicationController.ConditionalUpdateHandler))...))
+	communicationBase.Methods("DELETE").Handler(negroni.New(append(config["CommunicationConditionalDelete"], negroni.HandlerFunc(communicationController.ConditionalDeleteHandler))...))
+	communicationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	communicationTypeHistory := router.Path("/Communication/_history").Subrouter()
+	communicationTypeHistory.Methods("GET").Handler(negroni.New(append(config["CommunicationTypeHistory"], negroni.HandlerFunc(communicationController.TypeHistoryHandler))...))
+	communicationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	communication := router.Path("/Communication/{id}").Subrouter()
 	communication.Methods("GET").Handler(negroni.New(append(config["CommunicationShow"], negroni.HandlerFunc(communicationController.ShowHandler))...))
 	communication.Methods("PUT").Handler(negroni.New(append(config["CommunicationUpdate"], negroni.HandlerFunc(communicationController.UpdateHandler))...))
+	communication.Methods("PATCH").Handler(negroni.New(append(config["CommunicationUpdate"], negroni.HandlerFunc(communicationController.PatchHandler))...))
 	communication.Methods("DELETE").Handler(negroni.New(append(config["CommunicationDelete"], negroni.HandlerFunc(communicationController.DeleteHandler))...))
+	communication.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	communicationInstanceHistory := router.Path("/Communication/{id}/_history").Subrouter()
+	communicationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["CommunicationInstanceHistory"], negroni.HandlerFunc(communicationController.InstanceHistoryHandler))...))
+	communicationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	communicationVersion := router.Path("/Communication/{id}/_history/{vid}").Subrouter()
+	communicationVersion.Methods("GET").Handler(negroni.New(append(config["CommunicationVRead"], negroni.HandlerFunc(communicationController.VersionReadHandler))...))
+	communicationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	conditionController := ResourceController{"Condition"}
 	conditionBase := router.Path("/Condition").Subrouter()
 	conditionBase.Methods("GET").Handler(negroni.New(append(config["ConditionIndex"], negroni.HandlerFunc(conditionController.IndexHandler))...))
 	conditionBase.Methods("POST").Handler(negroni.New(append(config["ConditionCreate"], negroni.HandlerFunc(conditionController.CreateHandler))...))
+	conditionBase.Methods("PUT").Handler(negroni.New(append(config["ConditionConditionalUpdate"], negroni.HandlerFunc(conditionController.ConditionalUpdateHandler))...))
+	conditionBase.Methods("DELETE").Handler(negroni.New(append(config["ConditionConditionalDelete"], negroni.HandlerFunc(conditionController.ConditionalDeleteHandler))...))
+	conditionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	conditionTypeHistory := router.Path("/Condition/_history").Subrouter()
+	conditionTypeHistory.Methods("GET").Handler(negroni.New(append(config["ConditionTypeHistory"], negroni.HandlerFunc(conditionController.TypeHistoryHandler))...))
+	conditionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	condition := router.Path("/Condition/{id}").Subrouter()
 	condition.Methods("GET").Handler(negroni.New(append(config["ConditionShow"], negroni.HandlerFunc(conditionController.ShowHandler))...))
 	condition.Methods("PUT").Handler(negroni.New(append(config["ConditionUpdate"], negroni.HandlerFunc(conditionController.UpdateHandler))...))
+	condition.Methods("PATCH").Handler(negroni.New(append(config["ConditionUpdate"], negroni.HandlerFunc(conditionController.PatchHandler))...))
 	condition.Methods("DELETE").Handler(negroni.New(append(config["ConditionDelete"], negroni.HandlerFunc(conditionController.DeleteHandler))...))
+	condition.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	conditionInstanceHistory := router.Path("/Condition/{id}/_history").Subrouter()
+	conditionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ConditionInstanceHistory"], negroni.HandlerFunc(conditionController.InstanceHistoryHandler))...))
+	conditionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	conditionVersion := router.Path("/Condition/{id}/_history/{vid}").Subrouter()
+	conditionVersion.Methods("GET").Handler(negroni.New(append(config["ConditionVRead"], negroni.HandlerFunc(conditionController.VersionReadHandler))...))
+	conditionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	compositionController := ResourceController{"Composition"}
 	compositionBase := router.Path("/Composition").Subrouter()
 	compositionBase.Methods("GET").Handler(negroni.New(append(config["CompositionIndex"], negroni.HandlerFunc(compositionController.IndexHandler))...))
 	compositionBase.Methods("POST").Handler(negroni.New(append(config["CompositionCreate"], negroni.HandlerFunc(compositionController.CreateHandler))...))
+	compositionBase.Methods("PUT").Handler(negroni.New(append(config["CompositionConditionalUpdate"], negroni.HandlerFunc(compositionController.ConditionalUpdateHandler))...))
+	compositionBase.Methods("DELETE").Handler(negroni.New(append(config["CompositionConditionalDelete"], negroni.HandlerFunc(compositionController.ConditionalDeleteHandler))...))
+	compositionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	compositionTypeHistory := router.Path("/Composition/_history").Subrouter()
+	compositionTypeHistory.Methods("GET").Handler(negroni.New(append(config["CompositionTypeHistory"], negroni.HandlerFunc(compositionController.TypeHistoryHandler))...))
+	compositionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	composition := router.Path("/Composition/{id}").Subrouter()
 	composition.Methods("GET").Handler(negroni.New(append(config["CompositionShow"], negroni.HandlerFunc(compositionController.ShowHandler))...))
 	composition.Methods("PUT").Handler(negroni.New(append(config["CompositionUpdate"], negroni.HandlerFunc(compositionController.UpdateHandler))...))
+	composition.Methods("PATCH").Handler(negroni.New(append(config["CompositionUpdate"], negroni.HandlerFunc(compositionController.PatchHandler))...))
 	composition.Methods("DELETE").Handler(negroni.New(append(config["CompositionDelete"], negroni.HandlerFunc(compositionController.DeleteHandler))...))
+	composition.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	compositionInstanceHistory := router.Path("/Composition/{id}/_history").Subrouter()
+	compositionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["CompositionInstanceHistory"], negroni.HandlerFunc(compositionController.InstanceHistoryHandler))...))
+	compositionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	compositionVersion := router.Path("/Composition/{id}/_history/{vid}").Subrouter()
+	compositionVersion.Methods("GET").Handler(negroni.New(append(config["CompositionVRead"], negroni.HandlerFunc(compositionController.VersionReadHandler))...))
+	compositionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	detectedissueController := ResourceController{"DetectedIssue"}
 	detectedissueBase := router.Path("/DetectedIssue").Subrouter()
 	detectedissueBase.Methods("GET").Handler(negroni.New(append(config["DetectedIssueIndex"], negroni.HandlerFunc(detectedissueController.IndexHandler))...))
 	detectedissueBase.Methods("POST").Handler(negroni.New(append(config["DetectedIssueCreate"], negroni.HandlerFunc(detectedissueController.CreateHandler))...))
+	detectedissueBase.Methods("PUT").Handler(negroni.New(append(config["DetectedIssueConditionalUpdate"], negroni.HandlerFunc(detectedissueController.ConditionalUpdateHandler))...))
+	detectedissueBase.Methods("DELETE").Handler(negroni.New(append(config["DetectedIssueConditionalDelete"], negroni.HandlerFunc(detectedissueController.ConditionalDeleteHandler))...))
+	detectedissueBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	detectedissueTypeHistory := router.Path("/DetectedIssue/_history").Subrouter()
+	detectedissueTypeHistory.Methods("GET").Handler(negroni.New(append(config["DetectedIssueTypeHistory"], negroni.HandlerFunc(detectedissueController.TypeHistoryHandler))...))
+	detectedissueTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	detectedissue := router.Path("/DetectedIssue/{id}").Subrouter()
 	detectedissue.Methods("GET").Handler(negroni.New(append(config["DetectedIssueShow"], negroni.HandlerFunc(detectedissueController.ShowHandler))...))
 	detectedissue.Methods("PUT").Handler(negroni.New(append(config["DetectedIssueUpdate"], negroni.HandlerFunc(detectedissueController.UpdateHandler))...))
+	detectedissue.Methods("PATCH").Handler(negroni.New(append(config["DetectedIssueUpdate"], negroni.HandlerFunc(detectedissueController.PatchHandler))...))
 	detectedissue.Methods("DELETE").Handler(negroni.New(append(config["DetectedIssueDelete"], negroni.HandlerFunc(detectedissueController.DeleteHandler))...))
+	detectedissue.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	detectedissueInstanceHistory := router.Path("/DetectedIssue/{id}/_history").Subrouter()
+	detectedissueInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DetectedIssueInstanceHistory"], negroni.HandlerFunc(detectedissueController.InstanceHistoryHandler))...))
+	detectedissueInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	detectedissueVersion := router.Path("/DetectedIssue/{id}/_history/{vid}").Subrouter()
+	detectedissueVersion.Methods("GET").Handler(negroni.New(append(config["DetectedIssueVRead"], negroni.HandlerFunc(detectedissueController.VersionReadHandler))...))
+	detectedissueVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	bundleController := ResourceController{"Bundle"}
 	bundleBase := router.Path("/Bundle").Subrouter()
 	bundleBase.Methods("GET").Handler(negroni.New(append(config["BundleIndex"], negroni.HandlerFunc(bundleController.IndexHandler))...))
 	bundleBase.Methods("POST").Handler(negroni.New(append(config["BundleCreate"], negroni.HandlerFunc(bundleController.CreateHandler))...))
+	bundleBase.Methods("PUT").Handler(negroni.New(append(config["BundleConditionalUpdate"], negroni.HandlerFunc(bundleController.ConditionalUpdateHandler))...))
+	bundleBase.Methods("DELETE").Handler(negroni.New(append(config["BundleConditionalDelete"], negroni.HandlerFunc(bundleController.ConditionalDeleteHandler))...))
+	bundleBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	bundleTypeHistory := router.Path("/Bundle/_history").Subrouter()
+	bundleTypeHistory.Methods("GET").Handler(negroni.New(append(config["BundleTypeHistory"], negroni.HandlerFunc(bundleController.TypeHistoryHandler))...))
+	bundleTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	bundle := router.Path("/Bundle/{id}").Subrouter()
 	bundle.Methods("GET").Handler(negroni.New(append(config["BundleShow"], negroni.HandlerFunc(bundleController.ShowHandler))...))
 	bundle.Methods("PUT").Handler(negroni.New(append(config["BundleUpdate"], negroni.HandlerFunc(bundleController.UpdateHandler))...))
+	bundle.Methods("PATCH").Handler(negroni.New(append(config["BundleUpdate"], negroni.HandlerFunc(bundleController.PatchHandler))...))
 	bundle.Methods("DELETE").Handler(negroni.New(append(config["BundleDelete"], negroni.HandlerFunc(bundleController.DeleteHandler))...))
+	bundle.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	bundleInstanceHistory := router.Path("/Bundle/{id}/_history").Subrouter()
+	bundleInstanceHistory.Methods("GET").Handler(negroni.New(append(config["BundleInstanceHistory"], negroni.HandlerFunc(bundleController.InstanceHistoryHandler))...))
+	bundleInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	bundleVersion := router.Path("/Bundle/{id}/_history/{vid}").Subrouter()
+	bundleVersion.Methods("GET").Handler(negroni.New(append(config["BundleVRead"], negroni.HandlerFunc(bundleController.VersionReadHandler))...))
+	bundleVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	diagnosticorderController := ResourceController{"DiagnosticOrder"}
 	diagnosticorderBase := router.Path("/DiagnosticOrder").Subrouter()
 	diagnosticorderBase.Methods("GET").Handler(negroni.New(append(config["DiagnosticOrderIndex"], negroni.HandlerFunc(diagnosticorderController.IndexHandler))...))
 	diagnosticorderBase.Methods("POST").Handler(negroni.New(append(config["DiagnosticOrderCreate"], negroni.HandlerFunc(diagnosticorderController.CreateHandler))...))
+	diagnosticorderBase.Methods("PUT").Handler(negroni.New(append(config["DiagnosticOrderConditionalUpdate"], negroni.HandlerFunc(diagnosticorderController.ConditionalUpdateHandler))...))
+	diagnosticorderBase.Methods("DELETE").Handler(negroni.New(append(config["DiagnosticOrderConditionalDelete"], negroni.HandlerFunc(diagnosticorderController.ConditionalDeleteHandler))...))
+	diagnosticorderBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	diagnosticorderTypeHistory := router.Path("/DiagnosticOrder/_history").Subrouter()
+	diagnosticorderTypeHistory.Methods("GET").Handler(negroni.New(append(config["DiagnosticOrderTypeHistory"], negroni.HandlerFunc(diagnosticorderController.TypeHistoryHandler))...))
+	diagnosticorderTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	diagnosticorder := router.Path("/DiagnosticOrder/{id}").Subrouter()
 	diagnosticorder.Methods("GET").Handler(negroni.New(append(config["DiagnosticOrderShow"], negroni.HandlerFunc(diagnosticorderController.ShowHandler))...))
 	diagnosticorder.Methods("PUT").Handler(negroni.New(append(config["DiagnosticOrderUpdate"], negroni.HandlerFunc(diagnosticorderController.UpdateHandler))...))
+	diagnosticorder.Methods("PATCH").Handler(negroni.New(append(config["DiagnosticOrderUpdate"], negroni.HandlerFunc(diagnosticorderController.PatchHandler))...))
 	diagnosticorder.Methods("DELETE").Handler(negroni.New(append(config["DiagnosticOrderDelete"], negroni.HandlerFunc(diagnosticorderController.DeleteHandler))...))
+	diagnosticorder.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	diagnosticorderInstanceHistory := router.Path("/DiagnosticOrder/{id}/_history").Subrouter()
+	diagnosticorderInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DiagnosticOrderInstanceHistory"], negroni.HandlerFunc(diagnosticorderController.InstanceHistoryHandler))...))
+	diagnosticorderInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	diagnosticorderVersion := router.Path("/DiagnosticOrder/{id}/_history/{vid}").Subrouter()
+	diagnosticorderVersion.Methods("GET").Handler(negroni.New(append(config["DiagnosticOrderVRead"], negroni.HandlerFunc(diagnosticorderController.VersionReadHandler))...))
+	diagnosticorderVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	patientController := ResourceController{"Patient"}
 	patientBase := router.Path("/Patient").Subrouter()
 	patientBase.Methods("GET").Handler(negroni.New(append(config["PatientIndex"], negroni.HandlerFunc(patientController.IndexHandler))...))
 	patientBase.Methods("POST").Handler(negroni.New(append(config["PatientCreate"], negroni.HandlerFunc(patientController.CreateHandler))...))
+	patientBase.Methods("PUT").Handler(negroni.New(append(config["PatientConditionalUpdate"], negroni.HandlerFunc(patientController.ConditionalUpdateHandler))...))
+	patientBase.Methods("DELETE").Handler(negroni.New(append(config["PatientConditionalDelete"], negroni.HandlerFunc(patientController.ConditionalDeleteHandler))...))
+	patientBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	patientTypeHistory := router.Path("/Patient/_history").Subrouter()
+	patientTypeHistory.Methods("GET").Handler(negroni.New(append(config["PatientTypeHistory"], negroni.HandlerFunc(patientController.TypeHistoryHandler))...))
+	patientTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	patient := router.Path("/Patient/{id}").Subrouter()
 	patient.Methods("GET").Handler(negroni.New(append(config["PatientShow"], negroni.HandlerFunc(patientController.ShowHandler))...))
 	patient.Methods("PUT").Handler(negroni.New(append(config["PatientUpdate"], negroni.HandlerFunc(patientController.UpdateHandler))...))
+	patient.Methods("PATCH").Handler(negroni.New(append(config["PatientUpdate"], negroni.HandlerFunc(patientController.PatchHandler))...))
 	patient.Methods("DELETE").Handler(negroni.New(append(config["PatientDelete"], negroni.HandlerFunc(patientController.DeleteHandler))...))
+	patient.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	patientInstanceHistory := router.Path("/Patient/{id}/_history").Subrouter()
+	patientInstanceHistory.Methods("GET").Handler(negroni.New(append(config["PatientInstanceHistory"], negroni.HandlerFunc(patientController.InstanceHistoryHandler))...))
+	patientInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	patientVersion := router.Path("/Patient/{id}/_history/{vid}").Subrouter()
+	patientVersion.Methods("GET").Handler(negroni.New(append(config["PatientVRead"], negroni.HandlerFunc(patientController.VersionReadHandler))...))
+	patientVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	orderresponseController := ResourceController{"OrderResponse"}
 	orderresponseBase := router.Path("/OrderResponse").Subrouter()
 	orderresponseBase.Methods("GET").Handler(negroni.New(append(config["OrderResponseIndex"], negroni.HandlerFunc(orderresponseController.IndexHandler))...))
 	orderresponseBase.Methods("POST").Handler(negroni.New(append(config["OrderResponseCreate"], negroni.HandlerFunc(orderresponseController.CreateHandler))...))
+	orderresponseBase.Methods("PUT").Handler(negroni.New(append(config["OrderResponseConditionalUpdate"], negroni.HandlerFunc(orderresponseController.ConditionalUpdateHandler))...))
+	orderresponseBase.Methods("DELETE").Handler(negroni.New(append(config["OrderResponseConditionalDelete"], negroni.HandlerFunc(orderresponseController.ConditionalDeleteHandler))...))
+	orderresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	orderresponseTypeHistory := router.Path("/OrderResponse/_history").Subrouter()
+	orderresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["OrderResponseTypeHistory"], negroni.HandlerFunc(orderresponseController.TypeHistoryHandler))...))
+	orderresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	orderresponse := router.Path("/OrderResponse/{id}").Subrouter()
 	orderresponse.Methods("GET").Handler(negroni.New(append(config["OrderResponseShow"], negroni.HandlerFunc(orderresponseController.ShowHandler))...))
 	orderresponse.Methods("PUT").Handler(negroni.New(append(config["OrderResponseUpdate"], negroni.HandlerFunc(orderresponseController.UpdateHandler))...))
+	orderresponse.Methods("PATCH").Handler(negroni.New(append(config["OrderResponseUpdate"], negroni.HandlerFunc(orderresponseController.PatchHandler))...))
 	orderresponse.Methods("DELETE").Handler(negroni.New(append(config["OrderResponseDelete"], negroni.HandlerFunc(orderresponseController.DeleteHandler))...))
+	orderresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	orderresponseInstanceHistory := router.Path("/OrderResponse/{id}/_history").Subrouter()
+	orderresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["OrderResponseInstanceHistory"], negroni.HandlerFunc(orderresponseController.InstanceHistoryHandler))...))
+	orderresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	orderresponseVersion := router.Path("/OrderResponse/{id}/_history/{vid}").Subrouter()
+	orderresponseVersion.Methods("GET").Handler(negroni.New(append(config["OrderResponseVRead"], negroni.HandlerFunc(orderresponseController.VersionReadHandler))...))
+	orderresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	coverageController := ResourceController{"Coverage"}
 	coverageBase := router.Path("/Coverage").Subrouter()
 	coverageBase.Methods("GET").Handler(negroni.New(append(config["CoverageIndex"], negroni.HandlerFunc(coverageController.IndexHandler))...))
 	coverageBase.Methods("POST").Handler(negroni.New(append(config["CoverageCreate"], negroni.HandlerFunc(coverageController.CreateHandler))...))
+	coverageBase.Methods("PUT").Handler(negroni.New(append(config["CoverageConditionalUpdate"], negroni.HandlerFunc(coverageController.ConditionalUpdateHandler))...))
+	coverageBase.Methods("DELETE").Handler(negroni.New(append(config["CoverageConditionalDelete"], negroni.HandlerFunc(coverageController.ConditionalDeleteHandler))...))
+	coverageBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	coverageTypeHistory := router.Path("/Coverage/_history").Subrouter()
+	coverageTypeHistory.Methods("GET").Handler(negroni.New(append(config["CoverageTypeHistory"], negroni.HandlerFunc(coverageController.TypeHistoryHandler))...))
+	coverageTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	coverage := router.Path("/Coverage/{id}").Subrouter()
 	coverage.Methods("GET").Handler(negroni.New(append(config["CoverageShow"], negroni.HandlerFunc(coverageController.ShowHandler))...))
 	coverage.Methods("PUT").Handler(negroni.New(append(config["CoverageUpdate"], negroni.HandlerFunc(coverageController.UpdateHandler))...))
+	coverage.Methods("PATCH").Handler(negroni.New(append(config["CoverageUpdate"], negroni.HandlerFunc(coverageController.PatchHandler))...))
 	coverage.Methods("DELETE").Handler(negroni.New(append(config["CoverageDelete"], negroni.HandlerFunc(coverageController.DeleteHandler))...))
+	coverage.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	coverageInstanceHistory := router.Path("/Coverage/{id}/_history").Subrouter()
+	coverageInstanceHistory.Methods("GET").Handler(negroni.New(append(config["CoverageInstanceHistory"], negroni.HandlerFunc(coverageController.InstanceHistoryHandler))...))
+	coverageInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	coverageVersion := router.Path("/Coverage/{id}/_history/{vid}").Subrouter()
+	coverageVersion.Methods("GET").Handler(negroni.New(append(config["CoverageVRead"], negroni.HandlerFunc(coverageController.VersionReadHandler))...))
+	coverageVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	questionnaireresponseController := ResourceController{"QuestionnaireResponse"}
 	questionnaireresponseBase := router.Path("/QuestionnaireResponse").Subrouter()
 	questionnaireresponseBase.Methods("GET").Handler(negroni.New(append(config["QuestionnaireResponseIndex"], negroni.HandlerFunc(questionnaireresponseController.IndexHandler))...))
 	questionnaireresponseBase.Methods("POST").Handler(negroni.New(append(config["QuestionnaireResponseCreate"], negroni.HandlerFunc(questionnaireresponseController.CreateHandler))...))
+	questionnaireresponseBase.Methods("PUT").Handler(negroni.New(append(config["QuestionnaireResponseConditionalUpdate"], negroni.HandlerFunc(questionnaireresponseController.ConditionalUpdateHandler))...))
+	questionnaireresponseBase.Methods("DELETE").Handler(negroni.New(append(config["QuestionnaireResponseConditionalDelete"], negroni.HandlerFunc(questionnaireresponseController.ConditionalDeleteHandler))...))
+	questionnaireresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	questionnaireresponseTypeHistory := router.Path("/QuestionnaireResponse/_history").Subrouter()
+	questionnaireresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["QuestionnaireResponseTypeHistory"], negroni.HandlerFunc(questionnaireresponseController.TypeHistoryHandler))...))
+	questionnaireresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	questionnaireresponse := router.Path("/QuestionnaireResponse/{id}").Subrouter()
 	questionnaireresponse.Methods("GET").Handler(negroni.New(append(config["QuestionnaireResponseShow"], negroni.HandlerFunc(questionnaireresponseController.ShowHandler))...))
 	questionnaireresponse.Methods("PUT").Handler(negroni.New(append(config["QuestionnaireResponseUpdate"], negroni.HandlerFunc(questionnaireresponseController.UpdateHandler))...))
+	questionnaireresponse.Methods("PATCH").Handler(negroni.New(append(config["QuestionnaireResponseUpdate"], negroni.HandlerFunc(questionnaireresponseController.PatchHandler))...))
 	questionnaireresponse.Methods("DELETE").Handler(negroni.New(append(config["QuestionnaireResponseDelete"], negroni.HandlerFunc(questionnaireresponseController.DeleteHandler))...))
+	questionnaireresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	questionnaireresponseInstanceHistory := router.Path("/QuestionnaireResponse/{id}/_history").Subrouter()
+	questionnaireresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["QuestionnaireResponseInstanceHistory"], negroni.HandlerFunc(questionnaireresponseController.InstanceHistoryHandler))...))
+	questionnaireresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	questionnaireresponseVersion := router.Path("/QuestionnaireResponse/{id}/_history/{vid}").Subrouter()
+	questionnaireresponseVersion.Methods("GET").Handler(negroni.New(append(config["QuestionnaireResponseVRead"], negroni.HandlerFunc(questionnaireresponseController.VersionReadHandler))...))
+	questionnaireresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	deviceusestatementController := ResourceController{"DeviceUseStatement"}
 	deviceusestatementBase := router.Path("/DeviceUseStatement").Subrouter()
 	deviceusestatementBase.Methods("GET").Handler(negroni.New(append(config["DeviceUseStatementIndex"], negroni.HandlerFunc(deviceusestatementController.IndexHandler))...))
 	deviceusestatementBase.Methods("POST").Handler(negroni.New(append(config["DeviceUseStatementCreate"], negroni.HandlerFunc(deviceusestatementController.CreateHandler))...))
+	deviceusestatementBase.Methods("PUT").Handler(negroni.New(append(config["DeviceUseStatementConditionalUpdate"], negroni.HandlerFunc(deviceusestatementController.ConditionalUpdateHandler))...))
+	deviceusestatementBase.Methods("DELETE").Handler(negroni.New(append(config["DeviceUseStatementConditionalDelete"], negroni.HandlerFunc(deviceusestatementController.ConditionalDeleteHandler))...))
+	deviceusestatementBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	deviceusestatementTypeHistory := router.Path("/DeviceUseStatement/_history").Subrouter()
+	deviceusestatementTypeHistory.Methods("GET").Handler(negroni.New(append(config["DeviceUseStatementTypeHistory"], negroni.HandlerFunc(deviceusestatementController.TypeHistoryHandler))...))
+	deviceusestatementTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	deviceusestatement := router.Path("/DeviceUseStatement/{id}").Subrouter()
 	deviceusestatement.Methods("GET").Handler(negroni.New(append(config["DeviceUseStatementShow"], negroni.HandlerFunc(deviceusestatementController.ShowHandler))...))
 	deviceusestatement.Methods("PUT").Handler(negroni.New(append(config["DeviceUseStatementUpdate"], negroni.HandlerFunc(deviceusestatementController.UpdateHandler))...))
+	deviceusestatement.Methods("PATCH").Handler(negroni.New(append(config["DeviceUseStatementUpdate"], negroni.HandlerFunc(deviceusestatementController.PatchHandler))...))
 	deviceusestatement.Methods("DELETE").Handler(negroni.New(append(config["DeviceUseStatementDelete"], negroni.HandlerFunc(deviceusestatementController.DeleteHandler))...))
+	deviceusestatement.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	deviceusestatementInstanceHistory := router.Path("/DeviceUseStatement/{id}/_history").Subrouter()
+	deviceusestatementInstanceHistory.Methods("GET").Handler(negroni.New(append(config["DeviceUseStatementInstanceHistory"], negroni.HandlerFunc(deviceusestatementController.InstanceHistoryHandler))...))
+	deviceusestatementInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	deviceusestatementVersion := router.Path("/DeviceUseStatement/{id}/_history/{vid}").Subrouter()
+	deviceusestatementVersion.Methods("GET").Handler(negroni.New(append(config["DeviceUseStatementVRead"], negroni.HandlerFunc(deviceusestatementController.VersionReadHandler))...))
+	deviceusestatementVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	processresponseController := ResourceController{"ProcessResponse"}
 	processresponseBase := router.Path("/ProcessResponse").Subrouter()
 	processresponseBase.Methods("GET").Handler(negroni.New(append(config["ProcessResponseIndex"], negroni.HandlerFunc(processresponseController.IndexHandler))...))
 	processresponseBase.Methods("POST").Handler(negroni.New(append(config["ProcessResponseCreate"], negroni.HandlerFunc(processresponseController.CreateHandler))...))
+	processresponseBase.Methods("PUT").Handler(negroni.New(append(config["ProcessResponseConditionalUpdate"], negroni.HandlerFunc(processresponseController.ConditionalUpdateHandler))...))
+	processresponseBase.Methods("DELETE").Handler(negroni.New(append(config["ProcessResponseConditionalDelete"], negroni.HandlerFunc(processresponseController.ConditionalDeleteHandler))...))
+	processresponseBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	processresponseTypeHistory := router.Path("/ProcessResponse/_history").Subrouter()
+	processresponseTypeHistory.Methods("GET").Handler(negroni.New(append(config["ProcessResponseTypeHistory"], negroni.HandlerFunc(processresponseController.TypeHistoryHandler))...))
+	processresponseTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	processresponse := router.Path("/ProcessResponse/{id}").Subrouter()
 	processresponse.Methods("GET").Handler(negroni.New(append(config["ProcessResponseShow"], negroni.HandlerFunc(processresponseController.ShowHandler))...))
 	processresponse.Methods("PUT").Handler(negroni.New(append(config["ProcessResponseUpdate"], negroni.HandlerFunc(processresponseController.UpdateHandler))...))
+	processresponse.Methods("PATCH").Handler(negroni.New(append(config["ProcessResponseUpdate"], negroni.HandlerFunc(processresponseController.PatchHandler))...))
 	processresponse.Methods("DELETE").Handler(negroni.New(append(config["ProcessResponseDelete"], negroni.HandlerFunc(processresponseController.DeleteHandler))...))
+	processresponse.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	processresponseInstanceHistory := router.Path("/ProcessResponse/{id}/_history").Subrouter()
+	processresponseInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ProcessResponseInstanceHistory"], negroni.HandlerFunc(processresponseController.InstanceHistoryHandler))...))
+	processresponseInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	processresponseVersion := router.Path("/ProcessResponse/{id}/_history/{vid}").Subrouter()
+	processresponseVersion.Methods("GET").Handler(negroni.New(append(config["ProcessResponseVRead"], negroni.HandlerFunc(processresponseController.VersionReadHandler))...))
+	processresponseVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	namingsystemController := ResourceController{"NamingSystem"}
 	namingsystemBase := router.Path("/NamingSystem").Subrouter()
 	namingsystemBase.Methods("GET").Handler(negroni.New(append(config["NamingSystemIndex"], negroni.HandlerFunc(namingsystemController.IndexHandler))...))
 	namingsystemBase.Methods("POST").Handler(negroni.New(append(config["NamingSystemCreate"], negroni.HandlerFunc(namingsystemController.CreateHandler))...))
+	namingsystemBase.Methods("PUT").Handler(negroni.New(append(config["NamingSystemConditionalUpdate"], negroni.HandlerFunc(namingsystemController.ConditionalUpdateHandler))...))
+	namingsystemBase.Methods("DELETE").Handler(negroni.New(append(config["NamingSystemConditionalDelete"], negroni.HandlerFunc(namingsystemController.ConditionalDeleteHandler))...))
+	namingsystemBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	namingsystemTypeHistory := router.Path("/NamingSystem/_history").Subrouter()
+	namingsystemTypeHistory.Methods("GET").Handler(negroni.New(append(config["NamingSystemTypeHistory"], negroni.HandlerFunc(namingsystemController.TypeHistoryHandler))...))
+	namingsystemTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	namingsystem := router.Path("/NamingSystem/{id}").Subrouter()
 	namingsystem.Methods("GET").Handler(negroni.New(append(config["NamingSystemShow"], negroni.HandlerFunc(namingsystemController.ShowHandler))...))
 	namingsystem.Methods("PUT").Handler(negroni.New(append(config["NamingSystemUpdate"], negroni.HandlerFunc(namingsystemController.UpdateHandler))...))
+	namingsystem.Methods("PATCH").Handler(negroni.New(append(config["NamingSystemUpdate"], negroni.HandlerFunc(namingsystemController.PatchHandler))...))
 	namingsystem.Methods("DELETE").Handler(negroni.New(append(config["NamingSystemDelete"], negroni.HandlerFunc(namingsystemController.DeleteHandler))...))
+	namingsystem.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	namingsystemInstanceHistory := router.Path("/NamingSystem/{id}/_history").Subrouter()
+	namingsystemInstanceHistory.Methods("GET").Handler(negroni.New(append(config["NamingSystemInstanceHistory"], negroni.HandlerFunc(namingsystemController.InstanceHistoryHandler))...))
+	namingsystemInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	namingsystemVersion := router.Path("/NamingSystem/{id}/_history/{vid}").Subrouter()
+	namingsystemVersion.Methods("GET").Handler(negroni.New(append(config["NamingSystemVRead"], negroni.HandlerFunc(namingsystemController.VersionReadHandler))...))
+	namingsystemVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	scheduleController := ResourceController{"Schedule"}
 	scheduleBase := router.Path("/Schedule").Subrouter()
 	scheduleBase.Methods("GET").Handler(negroni.New(append(config["ScheduleIndex"], negroni.HandlerFunc(scheduleController.IndexHandler))...))
 	scheduleBase.Methods("POST").Handler(negroni.New(append(config["ScheduleCreate"], negroni.HandlerFunc(scheduleController.CreateHandler))...))
+	scheduleBase.Methods("PUT").Handler(negroni.New(append(config["ScheduleConditionalUpdate"], negroni.HandlerFunc(scheduleController.ConditionalUpdateHandler))...))
+	scheduleBase.Methods("DELETE").Handler(negroni.New(append(config["ScheduleConditionalDelete"], negroni.HandlerFunc(scheduleController.ConditionalDeleteHandler))...))
+	scheduleBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	scheduleTypeHistory := router.Path("/Schedule/_history").Subrouter()
+	scheduleTypeHistory.Methods("GET").Handler(negroni.New(append(config["ScheduleTypeHistory"], negroni.HandlerFunc(scheduleController.TypeHistoryHandler))...))
+	scheduleTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	schedule := router.Path("/Schedule/{id}").Subrouter()
 	schedule.Methods("GET").Handler(negroni.New(append(config["ScheduleShow"], negroni.HandlerFunc(scheduleController.ShowHandler))...))
 	schedule.Methods("PUT").Handler(negroni.New(append(config["ScheduleUpdate"], negroni.HandlerFunc(scheduleController.UpdateHandler))...))
+	schedule.Methods("PATCH").Handler(negroni.New(append(config["ScheduleUpdate"], negroni.HandlerFunc(scheduleController.PatchHandler))...))
 	schedule.Methods("DELETE").Handler(negroni.New(append(config["ScheduleDelete"], negroni.HandlerFunc(scheduleController.DeleteHandler))...))
+	schedule.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	scheduleInstanceHistory := router.Path("/Schedule/{id}/_history").Subrouter()
+	scheduleInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ScheduleInstanceHistory"], negroni.HandlerFunc(scheduleController.InstanceHistoryHandler))...))
+	scheduleInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	scheduleVersion := router.Path("/Schedule/{id}/_history/{vid}").Subrouter()
+	scheduleVersion.Methods("GET").Handler(negroni.New(append(config["ScheduleVRead"], negroni.HandlerFunc(scheduleController.VersionReadHandler))...))
+	scheduleVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	supplydeliveryController := ResourceController{"SupplyDelivery"}
 	supplydeliveryBase := router.Path("/SupplyDelivery").Subrouter()
 	supplydeliveryBase.Methods("GET").Handler(negroni.New(append(config["SupplyDeliveryIndex"], negroni.HandlerFunc(supplydeliveryController.IndexHandler))...))
 	supplydeliveryBase.Methods("POST").Handler(negroni.New(append(config["SupplyDeliveryCreate"], negroni.HandlerFunc(supplydeliveryController.CreateHandler))...))
+	supplydeliveryBase.Methods("PUT").Handler(negroni.New(append(config["SupplyDeliveryConditionalUpdate"], negroni.HandlerFunc(supplydeliveryController.ConditionalUpdateHandler))...))
+	supplydeliveryBase.Methods("DELETE").Handler(negroni.New(append(config["SupplyDeliveryConditionalDelete"], negroni.HandlerFunc(supplydeliveryController.ConditionalDeleteHandler))...))
+	supplydeliveryBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	supplydeliveryTypeHistory := router.Path("/SupplyDelivery/_history").Subrouter()
+	supplydeliveryTypeHistory.Methods("GET").Handler(negroni.New(append(config["SupplyDeliveryTypeHistory"], negroni.HandlerFunc(supplydeliveryController.TypeHistoryHandler))...))
+	supplydeliveryTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	supplydelivery := router.Path("/SupplyDelivery/{id}").Subrouter()
 	supplydelivery.Methods("GET").Handler(negroni.New(append(config["SupplyDeliveryShow"], negroni.HandlerFunc(supplydeliveryController.ShowHandler))...))
 	supplydelivery.Methods("PUT").Handler(negroni.New(append(config["SupplyDeliveryUpdate"], negroni.HandlerFunc(supplydeliveryController.UpdateHandler))...))
+	supplydelivery.Methods("PATCH").Handler(negroni.New(append(config["SupplyDeliveryUpdate"], negroni.HandlerFunc(supplydeliveryController.PatchHandler))...))
 	supplydelivery.Methods("DELETE").Handler(negroni.New(append(config["SupplyDeliveryDelete"], negroni.HandlerFunc(supplydeliveryController.DeleteHandler))...))
+	supplydelivery.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	supplydeliveryInstanceHistory := router.Path("/SupplyDelivery/{id}/_history").Subrouter()
+	supplydeliveryInstanceHistory.Methods("GET").Handler(negroni.New(append(config["SupplyDeliveryInstanceHistory"], negroni.HandlerFunc(supplydeliveryController.InstanceHistoryHandler))...))
+	supplydeliveryInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	supplydeliveryVersion := router.Path("/SupplyDelivery/{id}/_history/{vid}").Subrouter()
+	supplydeliveryVersion.Methods("GET").Handler(negroni.New(append(config["SupplyDeliveryVRead"], negroni.HandlerFunc(supplydeliveryController.VersionReadHandler))...))
+	supplydeliveryVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	clinicalimpressionController := ResourceController{"ClinicalImpression"}
 	clinicalimpressionBase := router.Path("/ClinicalImpression").Subrouter()
 	clinicalimpressionBase.Methods("GET").Handler(negroni.New(append(config["ClinicalImpressionIndex"], negroni.HandlerFunc(clinicalimpressionController.IndexHandler))...))
 	clinicalimpressionBase.Methods("POST").Handler(negroni.New(append(config["ClinicalImpressionCreate"], negroni.HandlerFunc(clinicalimpressionController.CreateHandler))...))
+	clinicalimpressionBase.Methods("PUT").Handler(negroni.New(append(config["ClinicalImpressionConditionalUpdate"], negroni.HandlerFunc(clinicalimpressionController.ConditionalUpdateHandler))...))
+	clinicalimpressionBase.Methods("DELETE").Handler(negroni.New(append(config["ClinicalImpressionConditionalDelete"], negroni.HandlerFunc(clinicalimpressionController.ConditionalDeleteHandler))...))
+	clinicalimpressionBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	clinicalimpressionTypeHistory := router.Path("/ClinicalImpression/_history").Subrouter()
+	clinicalimpressionTypeHistory.Methods("GET").Handler(negroni.New(append(config["ClinicalImpressionTypeHistory"], negroni.HandlerFunc(clinicalimpressionController.TypeHistoryHandler))...))
+	clinicalimpressionTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	clinicalimpression := router.Path("/ClinicalImpression/{id}").Subrouter()
 	clinicalimpression.Methods("GET").Handler(negroni.New(append(config["ClinicalImpressionShow"], negroni.HandlerFunc(clinicalimpressionController.ShowHandler))...))
 	clinicalimpression.Methods("PUT").Handler(negroni.New(append(config["ClinicalImpressionUpdate"], negroni.HandlerFunc(clinicalimpressionController.UpdateHandler))...))
+	clinicalimpression.Methods("PATCH").Handler(negroni.New(append(config["ClinicalImpressionUpdate"], negroni.HandlerFunc(clinicalimpressionController.PatchHandler))...))
 	clinicalimpression.Methods("DELETE").Handler(negroni.New(append(config["ClinicalImpressionDelete"], negroni.HandlerFunc(clinicalimpressionController.DeleteHandler))...))
+	clinicalimpression.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	clinicalimpressionInstanceHistory := router.Path("/ClinicalImpression/{id}/_history").Subrouter()
+	clinicalimpressionInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ClinicalImpressionInstanceHistory"], negroni.HandlerFunc(clinicalimpressionController.InstanceHistoryHandler))...))
+	clinicalimpressionInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	clinicalimpressionVersion := router.Path("/ClinicalImpression/{id}/_history/{vid}").Subrouter()
+	clinicalimpressionVersion.Methods("GET").Handler(negroni.New(append(config["ClinicalImpressionVRead"], negroni.HandlerFunc(clinicalimpressionController.VersionReadHandler))...))
+	clinicalimpressionVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	messageheaderController := ResourceController{"MessageHeader"}
 	messageheaderBase := router.Path("/MessageHeader").Subrouter()
 	messageheaderBase.Methods("GET").Handler(negroni.New(append(config["MessageHeaderIndex"], negroni.HandlerFunc(messageheaderController.IndexHandler))...))
 	messageheaderBase.Methods("POST").Handler(negroni.New(append(config["MessageHeaderCreate"], negroni.HandlerFunc(messageheaderController.CreateHandler))...))
+	messageheaderBase.Methods("PUT").Handler(negroni.New(append(config["MessageHeaderConditionalUpdate"], negroni.HandlerFunc(messageheaderController.ConditionalUpdateHandler))...))
+	messageheaderBase.Methods("DELETE").Handler(negroni.New(append(config["MessageHeaderConditionalDelete"], negroni.HandlerFunc(messageheaderController.ConditionalDeleteHandler))...))
+	messageheaderBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	messageheaderTypeHistory := router.Path("/MessageHeader/_history").Subrouter()
+	messageheaderTypeHistory.Methods("GET").Handler(negroni.New(append(config["MessageHeaderTypeHistory"], negroni.HandlerFunc(messageheaderController.TypeHistoryHandler))...))
+	messageheaderTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	messageheader := router.Path("/MessageHeader/{id}").Subrouter()
 	messageheader.Methods("GET").Handler(negroni.New(append(config["MessageHeaderShow"], negroni.HandlerFunc(messageheaderController.ShowHandler))...))
 	messageheader.Methods("PUT").Handler(negroni.New(append(config["MessageHeaderUpdate"], negroni.HandlerFunc(messageheaderController.UpdateHandler))...))
+	messageheader.Methods("PATCH").Handler(negroni.New(append(config["MessageHeaderUpdate"], negroni.HandlerFunc(messageheaderController.PatchHandler))...))
 	messageheader.Methods("DELETE").Handler(negroni.New(append(config["MessageHeaderDelete"], negroni.HandlerFunc(messageheaderController.DeleteHandler))...))
+	messageheader.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	messageheaderInstanceHistory := router.Path("/MessageHeader/{id}/_history").Subrouter()
+	messageheaderInstanceHistory.Methods("GET").Handler(negroni.New(append(config["MessageHeaderInstanceHistory"], negroni.HandlerFunc(messageheaderController.InstanceHistoryHandler))...))
+	messageheaderInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	messageheaderVersion := router.Path("/MessageHeader/{id}/_history/{vid}").Subrouter()
+	messageheaderVersion.Methods("GET").Handler(negroni.New(append(config["MessageHeaderVRead"], negroni.HandlerFunc(messageheaderController.VersionReadHandler))...))
+	messageheaderVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	claimController := ResourceController{"Claim"}
 	claimBase := router.Path("/Claim").Subrouter()
 	claimBase.Methods("GET").Handler(negroni.New(append(config["ClaimIndex"], negroni.HandlerFunc(claimController.IndexHandler))...))
 	claimBase.Methods("POST").Handler(negroni.New(append(config["ClaimCreate"], negroni.HandlerFunc(claimController.CreateHandler))...))
+	claimBase.Methods("PUT").Handler(negroni.New(append(config["ClaimConditionalUpdate"], negroni.HandlerFunc(claimController.ConditionalUpdateHandler))...))
+	claimBase.Methods("DELETE").Handler(negroni.New(append(config["ClaimConditionalDelete"], negroni.HandlerFunc(claimController.ConditionalDeleteHandler))...))
+	claimBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	claimTypeHistory := router.Path("/Claim/_history").Subrouter()
+	claimTypeHistory.Methods("GET").Handler(negroni.New(append(config["ClaimTypeHistory"], negroni.HandlerFunc(claimController.TypeHistoryHandler))...))
+	claimTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	claim := router.Path("/Claim/{id}").Subrouter()
 	claim.Methods("GET").Handler(negroni.New(append(config["ClaimShow"], negroni.HandlerFunc(claimController.ShowHandler))...))
 	claim.Methods("PUT").Handler(negroni.New(append(config["ClaimUpdate"], negroni.HandlerFunc(claimController.UpdateHandler))...))
+	claim.Methods("PATCH").Handler(negroni.New(append(config["ClaimUpdate"], negroni.HandlerFunc(claimController.PatchHandler))...))
 	claim.Methods("DELETE").Handler(negroni.New(append(config["ClaimDelete"], negroni.HandlerFunc(claimController.DeleteHandler))...))
+	claim.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	claimInstanceHistory := router.Path("/Claim/{id}/_history").Subrouter()
+	claimInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ClaimInstanceHistory"], negroni.HandlerFunc(claimController.InstanceHistoryHandler))...))
+	claimInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	claimVersion := router.Path("/Claim/{id}/_history/{vid}").Subrouter()
+	claimVersion.Methods("GET").Handler(negroni.New(append(config["ClaimVRead"], negroni.HandlerFunc(claimController.VersionReadHandler))...))
+	claimVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	immunizationrecommendationController := ResourceController{"ImmunizationRecommendation"}
 	immunizationrecommendationBase := router.Path("/ImmunizationRecommendation").Subrouter()
 	immunizationrecommendationBase.Methods("GET").Handler(negroni.New(append(config["ImmunizationRecommendationIndex"], negroni.HandlerFunc(immunizationrecommendationController.IndexHandler))...))
 	immunizationrecommendationBase.Methods("POST").Handler(negroni.New(append(config["ImmunizationRecommendationCreate"], negroni.HandlerFunc(immunizationrecommendationController.CreateHandler))...))
+	immunizationrecommendationBase.Methods("PUT").Handler(negroni.New(append(config["ImmunizationRecommendationConditionalUpdate"], negroni.HandlerFunc(immunizationrecommendationController.ConditionalUpdateHandler))...))
+	immunizationrecommendationBase.Methods("DELETE").Handler(negroni.New(append(config["ImmunizationRecommendationConditionalDelete"], negroni.HandlerFunc(immunizationrecommendationController.ConditionalDeleteHandler))...))
+	immunizationrecommendationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	immunizationrecommendationTypeHistory := router.Path("/ImmunizationRecommendation/_history").Subrouter()
+	immunizationrecommendationTypeHistory.Methods("GET").Handler(negroni.New(append(config["ImmunizationRecommendationTypeHistory"], negroni.HandlerFunc(immunizationrecommendationController.TypeHistoryHandler))...))
+	immunizationrecommendationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	immunizationrecommendation := router.Path("/ImmunizationRecommendation/{id}").Subrouter()
 	immunizationrecommendation.Methods("GET").Handler(negroni.New(append(config["ImmunizationRecommendationShow"], negroni.HandlerFunc(immunizationrecommendationController.ShowHandler))...))
 	immunizationrecommendation.Methods("PUT").Handler(negroni.New(append(config["ImmunizationRecommendationUpdate"], negroni.HandlerFunc(immunizationrecommendationController.UpdateHandler))...))
+	immunizationrecommendation.Methods("PATCH").Handler(negroni.New(append(config["ImmunizationRecommendationUpdate"], negroni.HandlerFunc(immunizationrecommendationController.PatchHandler))...))
 	immunizationrecommendation.Methods("DELETE").Handler(negroni.New(append(config["ImmunizationRecommendationDelete"], negroni.HandlerFunc(immunizationrecommendationController.DeleteHandler))...))
+	immunizationrecommendation.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	immunizationrecommendationInstanceHistory := router.Path("/ImmunizationRecommendation/{id}/_history").Subrouter()
+	immunizationrecommendationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["ImmunizationRecommendationInstanceHistory"], negroni.HandlerFunc(immunizationrecommendationController.InstanceHistoryHandler))...))
+	immunizationrecommendationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	immunizationrecommendationVersion := router.Path("/ImmunizationRecommendation/{id}/_history/{vid}").Subrouter()
+	immunizationrecommendationVersion.Methods("GET").Handler(negroni.New(append(config["ImmunizationRecommendationVRead"], negroni.HandlerFunc(immunizationrecommendationController.VersionReadHandler))...))
+	immunizationrecommendationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	locationController := ResourceController{"Location"}
 	locationBase := router.Path("/Location").Subrouter()
 	locationBase.Methods("GET").Handler(negroni.New(append(config["LocationIndex"], negroni.HandlerFunc(locationController.IndexHandler))...))
 	locationBase.Methods("POST").Handler(negroni.New(append(config["LocationCreate"], negroni.HandlerFunc(locationController.CreateHandler))...))
+	locationBase.Methods("PUT").Handler(negroni.New(append(config["LocationConditionalUpdate"], negroni.HandlerFunc(locationController.ConditionalUpdateHandler))...))
+	locationBase.Methods("DELETE").Handler(negroni.New(append(config["LocationConditionalDelete"], negroni.HandlerFunc(locationController.ConditionalDeleteHandler))...))
+	locationBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	locationTypeHistory := router.Path("/Location/_history").Subrouter()
+	locationTypeHistory.Methods("GET").Handler(negroni.New(append(config["LocationTypeHistory"], negroni.HandlerFunc(locationController.TypeHistoryHandler))...))
+	locationTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	location := router.Path("/Location/{id}").Subrouter()
 	location.Methods("GET").Handler(negroni.New(append(config["LocationShow"], negroni.HandlerFunc(locationController.ShowHandler))...))
 	location.Methods("PUT").Handler(negroni.New(append(config["LocationUpdate"], negroni.HandlerFunc(locationController.UpdateHandler))...))
+	location.Methods("PATCH").Handler(negroni.New(append(config["LocationUpdate"], negroni.HandlerFunc(locationController.PatchHandler))...))
 	location.Methods("DELETE").Handler(negroni.New(append(config["LocationDelete"], negroni.HandlerFunc(locationController.DeleteHandler))...))
+	location.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	locationInstanceHistory := router.Path("/Location/{id}/_history").Subrouter()
+	locationInstanceHistory.Methods("GET").Handler(negroni.New(append(config["LocationInstanceHistory"], negroni.HandlerFunc(locationController.InstanceHistoryHandler))...))
+	locationInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	locationVersion := router.Path("/Location/{id}/_history/{vid}").Subrouter()
+	locationVersion.Methods("GET").Handler(negroni.New(append(config["LocationVRead"], negroni.HandlerFunc(locationController.VersionReadHandler))...))
+	locationVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	bodysiteController := ResourceController{"BodySite"}
 	bodysiteBase := router.Path("/BodySite").Subrouter()
 	bodysiteBase.Methods("GET").Handler(negroni.New(append(config["BodySiteIndex"], negroni.HandlerFunc(bodysiteController.IndexHandler))...))
 	bodysiteBase.Methods("POST").Handler(negroni.New(append(config["BodySiteCreate"], negroni.HandlerFunc(bodysiteController.CreateHandler))...))
+	bodysiteBase.Methods("PUT").Handler(negroni.New(append(config["BodySiteConditionalUpdate"], negroni.HandlerFunc(bodysiteController.ConditionalUpdateHandler))...))
+	bodysiteBase.Methods("DELETE").Handler(negroni.New(append(config["BodySiteConditionalDelete"], negroni.HandlerFunc(bodysiteController.ConditionalDeleteHandler))...))
+	bodysiteBase.NewRoute().Handler(MethodNotAllowedHandler("GET", "POST", "PUT", "DELETE"))
+
+	bodysiteTypeHistory := router.Path("/BodySite/_history").Subrouter()
+	bodysiteTypeHistory.Methods("GET").Handler(negroni.New(append(config["BodySiteTypeHistory"], negroni.HandlerFunc(bodysiteController.TypeHistoryHandler))...))
+	bodysiteTypeHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
 	bodysite := router.Path("/BodySite/{id}").Subrouter()
 	bodysite.Methods("GET").Handler(negroni.New(append(config["BodySiteShow"], negroni.HandlerFunc(bodysiteController.ShowHandler))...))
 	bodysite.Methods("PUT").Handler(negroni.New(append(config["BodySiteUpdate"], negroni.HandlerFunc(bodysiteController.UpdateHandler))...))
+	bodysite.Methods("PATCH").Handler(negroni.New(append(config["BodySiteUpdate"], negroni.HandlerFunc(bodysiteController.PatchHandler))...))
 	bodysite.Methods("DELETE").Handler(negroni.New(append(config["BodySiteDelete"], negroni.HandlerFunc(bodysiteController.DeleteHandler))...))
+	bodysite.NewRoute().Handler(MethodNotAllowedHandler("GET", "PUT", "PATCH", "DELETE"))
+
+	bodysiteInstanceHistory := router.Path("/BodySite/{id}/_history").Subrouter()
+	bodysiteInstanceHistory.Methods("GET").Handler(negroni.New(append(config["BodySiteInstanceHistory"], negroni.HandlerFunc(bodysiteController.InstanceHistoryHandler))...))
+	bodysiteInstanceHistory.NewRoute().Handler(MethodNotAllowedHandler("GET"))
+
+	bodysiteVersion := router.Path("/BodySite/{id}/_history/{vid}").Subrouter()
+	bodysiteVersion.Methods("GET").Handler(negroni.New(append(config["BodySiteVRead"], negroni.HandlerFunc(bodysiteController.VersionReadHandler))...))
+	bodysiteVersion.NewRoute().Handler(MethodNotAllowedHandler("GET"))
 
+	// The conformance statement describes all of the routes registered above
+	metadataController.Conformance = BuildConformance(router)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/codegangsta/negroni"
 	"github.com/gorilla/mux"
+	"github.com/intervention-engine/fhir/search"
 	"gopkg.in/mgo.v2"
 )
 
@@ -37,6 +38,18 @@ func (f *FHIRServer) Run() {
 	defer MongoSession.Close()
 
 	Database = MongoSession.DB("fhir")
+	if err = EnsureHistoryIndexes(Database); err != nil {
+		panic(err)
+	}
+	if err = EnsurePagingIndexes(Database); err != nil {
+		panic(err)
+	}
+	if err = search.EnsureDateIndexes(Database); err != nil {
+		panic(err)
+	}
+	if err = search.EnsureTextIndexes(Database); err != nil {
+		panic(err)
+	}
 
 	RegisterRoutes(f.Router, f.MiddlewareConfig)
 
@@ -47,3 +60,20 @@ func (f *FHIRServer) Run() {
 	n.UseHandler(f.Router)
 	n.Run(":3001")
 }
+
+// MigrateDateRanges rewrites the resources stored by earlier versions of the
+// server so that their dates can be searched.  It only needs to be run once,
+// before the server is started.
+func (f *FHIRServer) MigrateDateRanges() {
+	session, err := mgo.Dial(f.DatabaseHost)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+
+	migrated, err := search.MigrateDateRanges(session.DB("fhir"))
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("Migrated the dates of %d resources\n", migrated)
+}
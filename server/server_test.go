@@ -3,12 +3,15 @@ package server
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/codegangsta/negroni"
 	"github.com/gorilla/mux"
@@ -57,6 +60,9 @@ func (s *ServerSuite) SetUpTest(c *C) {
 
 func (s *ServerSuite) TearDownTest(c *C) {
 	Database.C("patients").DropCollection()
+	Database.C("history").DropCollection()
+	Database.C(snapshotCollectionName).DropCollection()
+	Database.C(snapshotChunkCollectionName).DropCollection()
 }
 
 func (s *ServerSuite) TearDownSuite(c *C) {
@@ -157,6 +163,21 @@ func (s *ServerSuite) TestGetPatientsPaging(c *C) {
 	assertPagingLink(c, bundle.Link[2], "next", 10, 10)
 	assertPagingLink(c, bundle.Link[3], "last", 10, 30)
 
+	// Search with a sort order, which must be kept by the paging links
+	bundle = performSearch(c, s.Server.URL+"/Patient?_count=10&_sort=family,-birthdate")
+	c.Assert(bundle.Link, HasLen, 4)
+	assertPagingLink(c, bundle.Link[2], "next", 10, 10)
+	for _, link := range bundle.Link {
+		linkURL, err := url.Parse(link.Url)
+		util.CheckErr(err)
+		page, err := decodePageToken(linkURL.Query().Get(search.PageParam))
+		util.CheckErr(err)
+		snapshot, err := loadSnapshot("Patient", page.Snapshot)
+		util.CheckErr(err)
+		query := search.Query{Resource: "Patient", Query: snapshot.Query}
+		c.Assert(query.Options().QueryValues().Get(search.SortParam), Equals, "family,-birthdate")
+	}
+
 	// Search with no results
 	bundle = performSearch(c, s.Server.URL+"/Patient?_count=10&gender=FOO")
 	c.Assert(bundle.Link, HasLen, 3)
@@ -187,6 +208,103 @@ func (s *ServerSuite) TestGetPatientsPaging(c *C) {
 	assertPagingLink(c, bundle.Link[2], "last", 100, 0)
 }
 
+func (s *ServerSuite) TestGetPatientsPagesAreStable(c *C) {
+	// Add 24 more patients
+	for i := 0; i < 24; i++ {
+		insertPatientFromFixture("../fixtures/patient-example-a.json")
+	}
+
+	first := performSearch(c, s.Server.URL+"/Patient?_count=10&_sort=_id")
+	c.Assert(first.Entry, HasLen, 10)
+	c.Assert(first.Link[2].Relation, Equals, "next")
+
+	// Adding and deleting patients doesn't change the next page
+	for i := 0; i < 5; i++ {
+		insertPatientFromFixture("../fixtures/patient-example-a.json")
+	}
+	util.CheckErr(Database.C("patients").RemoveId(first.Entry[0].Resource.(*models.Patient).Id))
+
+	second := performSearch(c, first.Link[2].Url)
+	c.Assert(*second.Total, Equals, uint32(25))
+	c.Assert(second.Entry, HasLen, 10)
+	assertPagingLink(c, second.Link[0], "self", 10, 10)
+	assertPagingLink(c, second.Link[2], "previous", 10, 0)
+	assertPagingLink(c, second.Link[3], "next", 10, 20)
+	lastID := first.Entry[9].Resource.(*models.Patient).Id
+	for _, entry := range second.Entry {
+		id := entry.Resource.(*models.Patient).Id
+		c.Assert(id > lastID, Equals, true)
+		lastID = id
+	}
+
+	// Expired and invalid tokens are errors
+	SearchSnapshotExpiry = -time.Minute
+	defer func() { SearchSnapshotExpiry = 30 * time.Minute }()
+	expired := performSearch(c, s.Server.URL+"/Patient?_count=10")
+	res, err := http.Get(expired.Link[2].Url)
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusGone, "expired")
+
+	res, err = http.Get(s.Server.URL + "/Patient?_page=foo")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+}
+
+func (s *ServerSuite) TestGetPatientsPagesDontSkipOrRepeat(c *C) {
+	for i := 0; i < 24; i++ {
+		insertPatientFromFixture("../fixtures/patient-example-a.json")
+	}
+	var expected []string
+	var patients []models.Patient
+	util.CheckErr(Database.C("patients").Find(nil).Sort("-_id").All(&patients))
+	for _, patient := range patients {
+		expected = append(expected, patient.Id)
+	}
+
+	// A patient added after the first page sorts before it, but doesn't move the
+	// patients on the first page onto the second page
+	first := performSearch(c, s.Server.URL+"/Patient?_count=10&_sort=-_id")
+	insertPatientFromFixture("../fixtures/patient-example-a.json")
+	second := performSearch(c, first.Link[2].Url)
+	third := performSearch(c, second.Link[3].Url)
+
+	var found []string
+	for _, bundle := range []*models.Bundle{first, second, third} {
+		c.Assert(*bundle.Total, Equals, uint32(25))
+		for _, entry := range bundle.Entry {
+			found = append(found, entry.Resource.(*models.Patient).Id)
+		}
+	}
+	c.Assert(found, DeepEquals, expected)
+}
+
+func (s *ServerSuite) TestGetPatientsPastSnapshotLimit(c *C) {
+	for i := 0; i < 4; i++ {
+		insertPatientFromFixture("../fixtures/patient-example-a.json")
+	}
+
+	// Searches with more results than can be stored in a snapshot are refused
+	SearchSnapshotLimit = 3
+	defer func() { SearchSnapshotLimit = 10000 }()
+	res, err := http.Get(s.Server.URL + "/Patient?_count=2")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusForbidden, "too-costly")
+
+	// But they can still be counted
+	bundle := performSearch(c, s.Server.URL+"/Patient?_count=0")
+	c.Assert(*bundle.Total, Equals, uint32(5))
+	c.Assert(bundle.Entry, HasLen, 0)
+	for _, link := range bundle.Link {
+		c.Assert(link.Relation, Not(Equals), "next")
+	}
+
+	res, err = http.Get(s.Server.URL + "/Patient?gender=male&_count=2")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusForbidden, "too-costly")
+	bundle = performSearch(c, s.Server.URL+"/Patient?_id="+s.FixtureId)
+	c.Assert(bundle.Entry, HasLen, 1)
+}
+
 func (s *ServerSuite) TestGetPatient(c *C) {
 	res, err := http.Get(s.Server.URL + "/Patient/" + s.FixtureId)
 	util.CheckErr(err)
@@ -198,6 +316,56 @@ func (s *ServerSuite) TestGetPatient(c *C) {
 	c.Assert(patient.Name[0].Family[0], Equals, "Donald")
 }
 
+func (s *ServerSuite) TestGetPatientWithSummaryAndElements(c *C) {
+	patient := s.getPatient(c, "/Patient/"+s.FixtureId+"?_elements=gender,active")
+	c.Assert(patient.Id, Equals, s.FixtureId)
+	c.Assert(patient.Gender, Equals, "male")
+	c.Assert(patient.Name, HasLen, 0)
+	c.Assert(patient.Photo, HasLen, 0)
+	assertSubsetted(c, patient.Meta)
+
+	// The summary includes only the summary elements
+	patient = s.getPatient(c, "/Patient/"+s.FixtureId+"?_summary=true")
+	c.Assert(patient.Name[0].Family[0], Equals, "Donald")
+	c.Assert(patient.Photo, HasLen, 0)
+	c.Assert(patient.Text, IsNil)
+	assertSubsetted(c, patient.Meta)
+
+	patient = s.getPatient(c, "/Patient/"+s.FixtureId+"?_summary=false")
+	c.Assert(patient.Photo, Not(HasLen), 0)
+
+	res, err := http.Get(s.Server.URL + "/Patient/" + s.FixtureId + "?_summary=count")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+
+	// Only elements of the resource can be selected
+	for _, path := range []string{"/Patient/" + s.FixtureId, "/Patient"} {
+		res, err = http.Get(s.Server.URL + path + "?_elements=%24where")
+		util.CheckErr(err)
+		assertOperationOutcome(c, res, http.StatusBadRequest, "processing")
+	}
+}
+
+func (s *ServerSuite) TestSearchPatientsWithSummary(c *C) {
+	insertPatientFromFixture("../fixtures/patient-example-a.json")
+
+	bundle := performSearch(c, s.Server.URL+"/Patient?_summary=count")
+	c.Assert(*bundle.Total, Equals, uint32(2))
+	c.Assert(bundle.Entry, HasLen, 0)
+
+	bundle = performSearch(c, s.Server.URL+"/Patient?_summary=text")
+	c.Assert(*bundle.Total, Equals, uint32(2))
+	c.Assert(bundle.Entry, HasLen, 2)
+	for _, entry := range bundle.Entry {
+		patient := entry.Resource.(*models.Patient)
+		c.Assert(patient.Text, NotNil)
+		c.Assert(patient.Name, HasLen, 0)
+		assertSubsetted(c, patient.Meta)
+	}
+	assertPagingLink(c, bundle.Link[0], "self", 100, 0)
+	c.Assert(bundle.Link[0].Url, Matches, ".*_summary=text.*")
+}
+
 func (s *ServerSuite) TestShowPatient(c *C) {
 	res, err := http.Get(s.Server.URL + "/Patient")
 	util.CheckErr(err)
@@ -272,6 +440,525 @@ func (s *ServerSuite) TestDeletePatient(c *C) {
 	c.Assert(count, Equals, 0)
 }
 
+func (s *ServerSuite) TestPatchPatientChangedSinceRead(c *C) {
+	stored := &models.Patient{}
+	err := Database.C("patients").FindId(s.FixtureId).One(stored)
+	util.CheckErr(err)
+
+	// Update the patient after it is read for patching but before it is saved
+	res := sendPatientFixture(c, "PUT", s.Server.URL+"/Patient/"+s.FixtureId, "../fixtures/patient-example-c.json")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+
+	ops, err := decodePatch([]byte(`[{"op": "replace", "path": "/gender", "value": "female"}]`))
+	util.CheckErr(err)
+	req, err := http.NewRequest("PATCH", s.Server.URL+"/Patient/"+s.FixtureId, nil)
+	util.CheckErr(err)
+	rw := httptest.NewRecorder()
+	rc := ResourceController{Name: "Patient"}
+	rc.patchResource(rw, req, s.FixtureId, stored, ops)
+	c.Assert(rw.Code, Equals, http.StatusConflict)
+
+	// The update isn't overwritten by the patch of the old version
+	patient := models.Patient{}
+	err = Database.C("patients").FindId(s.FixtureId).One(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Name[0].Family[0], Equals, "Darkwing")
+	c.Assert(patient.Gender, Not(Equals), "female")
+	c.Assert(patient.Meta.VersionId, Equals, "1")
+}
+
+func (s *ServerSuite) TestPatchPatient(c *C) {
+	patch := `[
+		{"op": "test", "path": "/gender", "value": "male"},
+		{"op": "replace", "path": "/gender", "value": "female"},
+		{"op": "add", "path": "/name/0/given/-", "value": "Fauntleroy"}
+	]`
+	res := sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, JSONPatchContentType, patch)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"1"`)
+
+	patient := models.Patient{}
+	err := Database.C("patients").FindId(s.FixtureId).One(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Gender, Equals, "female")
+	c.Assert(patient.Name[0].Given, DeepEquals, []string{"Duck", "Fauntleroy"})
+	c.Assert(patient.Identifier[0].Value, Equals, "654321")
+
+	// A failed test operation leaves the resource untouched
+	res = sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, JSONPatchContentType, `[
+		{"op": "test", "path": "/gender", "value": "male"},
+		{"op": "replace", "path": "/gender", "value": "other"}
+	]`)
+	c.Assert(res.StatusCode, Equals, http.StatusUnprocessableEntity)
+
+	// The patched document must still be a valid Patient
+	res = sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, JSONPatchContentType, `[{"op": "replace", "path": "/gender", "value": 1}]`)
+	c.Assert(res.StatusCode, Equals, http.StatusUnprocessableEntity)
+
+	res = sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, JSONPatchContentType, `[{"op": "jump", "path": "/gender"}]`)
+	c.Assert(res.StatusCode, Equals, http.StatusBadRequest)
+
+	res = sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, "application/json", patch)
+	c.Assert(res.StatusCode, Equals, http.StatusUnsupportedMediaType)
+
+	err = Database.C("patients").FindId(s.FixtureId).One(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Gender, Equals, "female")
+}
+
+func (s *ServerSuite) TestUpdateCreatesPatientWithLogicalId(c *C) {
+	res := sendPatientFixture(c, "PUT", s.Server.URL+"/Patient/patient-example-b", "../fixtures/patient-example-b.json")
+	c.Assert(res.StatusCode, Equals, http.StatusCreated)
+	c.Assert(res.Header.Get("Location"), Equals, s.Server.URL+"/Patient/patient-example-b")
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"1"`)
+
+	res, err := http.Get(s.Server.URL + "/Patient/patient-example-b")
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	patient := models.Patient{}
+	err = json.NewDecoder(res.Body).Decode(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Id, Equals, "patient-example-b")
+
+	res = sendPatientFixture(c, "PUT", s.Server.URL+"/Patient/patient-example-b", "../fixtures/patient-example-c.json")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"2"`)
+
+	res = sendPatientFixture(c, "DELETE", s.Server.URL+"/Patient/patient-example-b", "")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	count, err := Database.C("patients").FindId("patient-example-b").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 0)
+
+	res = sendPatientFixture(c, "PUT", s.Server.URL+"/Patient/not_a_valid_id", "../fixtures/patient-example-b.json")
+	c.Assert(res.StatusCode, Equals, http.StatusBadRequest)
+}
+
+func (s *ServerSuite) TestConditionalCreatePatient(c *C) {
+	// One match: return the existing patient without creating a new one
+	res := postPatientFixture(c, s.Server.URL, "../fixtures/patient-example-a.json", "identifier=urn:oid:0.1.2.3.4.5.6.7|654321")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	c.Assert(res.Header.Get("Location"), Equals, s.Server.URL+"/Patient/"+s.FixtureId)
+	count, err := Database.C("patients").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 1)
+
+	// No matches: create the patient
+	res = postPatientFixture(c, s.Server.URL, "../fixtures/patient-example-b.json", "identifier=urn:oid:0.1.2.3.4.5.6.7|no-such-id")
+	c.Assert(res.StatusCode, Equals, http.StatusCreated)
+	count, err = Database.C("patients").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 2)
+
+	// Multiple matches: fail the precondition
+	insertPatientFromFixture("../fixtures/patient-example-a.json")
+	res = postPatientFixture(c, s.Server.URL, "../fixtures/patient-example-a.json", "identifier=urn:oid:0.1.2.3.4.5.6.7|654321")
+	c.Assert(res.StatusCode, Equals, http.StatusPreconditionFailed)
+	outcome := &models.OperationOutcome{}
+	err = json.NewDecoder(res.Body).Decode(outcome)
+	util.CheckErr(err)
+	c.Assert(outcome.Issue, HasLen, 1)
+	c.Assert(outcome.Issue[0].Severity, Equals, "error")
+	count, err = Database.C("patients").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 3)
+}
+
+func (s *ServerSuite) TestConditionalUpdatePatient(c *C) {
+	// One match: update the matching patient
+	res := sendPatientFixture(c, "PUT", s.Server.URL+"/Patient?identifier=urn:oid:0.1.2.3.4.5.6.7|654321", "../fixtures/patient-example-c.json")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	patient := models.Patient{}
+	err := Database.C("patients").Find(bson.M{"_id": s.FixtureId}).One(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Name[0].Family[0], Equals, "Darkwing")
+
+	// One match with a different id: reject the update
+	req, err := http.NewRequest("PUT", s.Server.URL+"/Patient?identifier=urn:oid:0.1.2.3.4.5.6.7|654321", strings.NewReader(`{"resourceType": "Patient", "id": "someone-else"}`))
+	util.CheckErr(err)
+	res, err = http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	outcome := assertOperationOutcome(c, res, http.StatusBadRequest, "invalid")
+	c.Assert(outcome.Issue[0].Location, DeepEquals, []string{"Patient.id"})
+	count, err := Database.C("patients").FindId("someone-else").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 0)
+
+	// No matches: create the patient
+	res = sendPatientFixture(c, "PUT", s.Server.URL+"/Patient?identifier=urn:oid:0.1.2.3.4.5.6.7|no-such-id", "../fixtures/patient-example-b.json")
+	c.Assert(res.StatusCode, Equals, http.StatusCreated)
+	count, err = Database.C("patients").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 2)
+
+	// Multiple matches: fail the precondition
+	res = sendPatientFixture(c, "PUT", s.Server.URL+"/Patient?gender=male", "../fixtures/patient-example-b.json")
+	c.Assert(res.StatusCode, Equals, http.StatusPreconditionFailed)
+}
+
+func (s *ServerSuite) TestConditionalDeletePatient(c *C) {
+	insertPatientFromFixture("../fixtures/patient-example-b.json")
+
+	// Multiple matches: fail the precondition
+	res := sendPatientFixture(c, "DELETE", s.Server.URL+"/Patient?gender=male", "")
+	c.Assert(res.StatusCode, Equals, http.StatusPreconditionFailed)
+
+	// One match: delete the matching patient
+	res = sendPatientFixture(c, "DELETE", s.Server.URL+"/Patient?identifier=urn:oid:0.1.2.3.4.5.6.7|654321", "")
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	count, err := Database.C("patients").Find(bson.M{"_id": s.FixtureId}).Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 0)
+
+	// No matches: not found
+	res = sendPatientFixture(c, "DELETE", s.Server.URL+"/Patient?identifier=urn:oid:0.1.2.3.4.5.6.7|654321", "")
+	c.Assert(res.StatusCode, Equals, http.StatusNotFound)
+}
+
+func (s *ServerSuite) TestPatientHistoryAndVersionRead(c *C) {
+	data, err := os.Open("../fixtures/patient-example-b.json")
+	defer data.Close()
+	util.CheckErr(err)
+	res, err := http.Post(s.Server.URL+"/Patient", "application/json", data)
+	util.CheckErr(err)
+
+	splitLocation := strings.Split(res.Header["Location"][0], "/")
+	createdPatientId := splitLocation[len(splitLocation)-1]
+
+	update, err := os.Open("../fixtures/patient-example-c.json")
+	defer update.Close()
+	util.CheckErr(err)
+	req, err := http.NewRequest("PUT", s.Server.URL+"/Patient/"+createdPatientId, update)
+	util.CheckErr(err)
+	_, err = http.DefaultClient.Do(req)
+	util.CheckErr(err)
+
+	// The current version should carry the version meta
+	patient := models.Patient{}
+	err = Database.C("patients").Find(bson.M{"_id": createdPatientId}).One(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Meta, NotNil)
+	c.Assert(patient.Meta.VersionId, Equals, "2")
+	c.Assert(patient.Meta.LastUpdated, NotNil)
+
+	// Both versions should be in the history, newest first
+	bundle := performSearch(c, s.Server.URL+"/Patient/"+createdPatientId+"/_history")
+	c.Assert(bundle.Type, Equals, "history")
+	c.Assert(*bundle.Total, Equals, uint32(2))
+	c.Assert(bundle.Entry, HasLen, 2)
+	c.Assert(bundle.Entry[0].Request.Method, Equals, "PUT")
+	c.Assert(bundle.Entry[0].Resource.(*models.Patient).Name[0].Family[0], Equals, "Darkwing")
+	c.Assert(bundle.Entry[1].Request.Method, Equals, "POST")
+	c.Assert(bundle.Entry[1].Resource.(*models.Patient).Name[0].Family[0], Equals, "Daffy")
+
+	// The original version should still be readable
+	res, err = http.Get(s.Server.URL + "/Patient/" + createdPatientId + "/_history/1")
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	original := &models.Patient{}
+	err = json.NewDecoder(res.Body).Decode(original)
+	util.CheckErr(err)
+	c.Assert(original.Name[0].Family[0], Equals, "Daffy")
+	c.Assert(original.Meta.VersionId, Equals, "1")
+}
+
+func (s *ServerSuite) TestDeletedPatientHistory(c *C) {
+	data, err := os.Open("../fixtures/patient-example-d.json")
+	defer data.Close()
+	util.CheckErr(err)
+	res, err := http.Post(s.Server.URL+"/Patient", "application/json", data)
+	util.CheckErr(err)
+
+	splitLocation := strings.Split(res.Header["Location"][0], "/")
+	createdPatientId := splitLocation[len(splitLocation)-1]
+
+	req, err := http.NewRequest("DELETE", s.Server.URL+"/Patient/"+createdPatientId, nil)
+	util.CheckErr(err)
+	_, err = http.DefaultClient.Do(req)
+	util.CheckErr(err)
+
+	bundle := performSearch(c, s.Server.URL+"/Patient/"+createdPatientId+"/_history")
+	c.Assert(bundle.Entry, HasLen, 2)
+	c.Assert(bundle.Entry[0].Request.Method, Equals, "DELETE")
+	c.Assert(bundle.Entry[0].Resource, IsNil)
+
+	res, err = http.Get(s.Server.URL + "/Patient/" + createdPatientId + "/_history/2")
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusGone)
+
+	res, err = http.Get(s.Server.URL + "/Patient/" + createdPatientId)
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusGone, "deleted")
+
+	res, err = http.Get(s.Server.URL + "/Patient/" + createdPatientId + "/_history/3")
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusNotFound)
+}
+
+func (s *ServerSuite) TestConcurrentPatientUpdates(c *C) {
+	patientURL := s.Server.URL + "/Patient/" + s.FixtureId
+	statuses := make(chan int)
+	for i := 0; i < 5; i++ {
+		go func() {
+			res := sendPatientFixture(c, "PUT", patientURL, "../fixtures/patient-example-c.json")
+			res.Body.Close()
+			statuses <- res.StatusCode
+		}()
+	}
+
+	// Each update either succeeds with its own version or conflicts
+	updated := 0
+	for i := 0; i < 5; i++ {
+		status := <-statuses
+		if status == http.StatusOK {
+			updated++
+		} else {
+			c.Assert(status, Equals, http.StatusConflict)
+		}
+	}
+	c.Assert(updated > 0, Equals, true)
+
+	// Conflicting updates don't use up versions
+	bundle := performSearch(c, patientURL+"/_history")
+	c.Assert(*bundle.Total, Equals, uint32(updated))
+	versions := make(map[string]bool)
+	for _, entry := range bundle.Entry {
+		versions[entry.Resource.(*models.Patient).Meta.VersionId] = true
+	}
+	c.Assert(versions, HasLen, updated)
+	for version := 1; version <= updated; version++ {
+		c.Assert(versions[strconv.Itoa(version)], Equals, true)
+	}
+
+	patient := models.Patient{}
+	err := Database.C("patients").FindId(s.FixtureId).One(&patient)
+	util.CheckErr(err)
+	c.Assert(versions[patient.Meta.VersionId], Equals, true)
+}
+
+func (s *ServerSuite) TestTypeAndSystemHistoryPaging(c *C) {
+	for i := 0; i < 3; i++ {
+		data, err := os.Open("../fixtures/patient-example-b.json")
+		util.CheckErr(err)
+		_, err = http.Post(s.Server.URL+"/Patient", "application/json", data)
+		util.CheckErr(err)
+		data.Close()
+	}
+
+	bundle := performSearch(c, s.Server.URL+"/Patient/_history?_count=2")
+	c.Assert(*bundle.Total, Equals, uint32(3))
+	c.Assert(bundle.Entry, HasLen, 2)
+	c.Assert(bundle.Link, HasLen, 4)
+	assertPagingLink(c, bundle.Link[2], "next", 2, 2)
+
+	bundle = performSearch(c, s.Server.URL+"/_history")
+	c.Assert(*bundle.Total, Equals, uint32(3))
+
+	bundle = performSearch(c, s.Server.URL+"/_history?_since=2100-01-01")
+	c.Assert(*bundle.Total, Equals, uint32(0))
+}
+
+func (s *ServerSuite) TestHistoryErrors(c *C) {
+	res, err := http.Get(s.Server.URL + "/Patient/_history?_since=yesterday")
+	util.CheckErr(err)
+	outcome := assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+	c.Assert(outcome.Issue[0].Location, DeepEquals, []string{"http._since"})
+
+	res, err = http.Get(s.Server.URL + "/_history?_since=2100-01-01foo")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+
+	for _, path := range []string{"/Patient/not_a_valid_id/_history", "/Patient/not_a_valid_id/_history/1"} {
+		res, err = http.Get(s.Server.URL + path)
+		util.CheckErr(err)
+		assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+	}
+
+	res, err = http.Get(s.Server.URL + "/Patient/no-such-patient/_history")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusNotFound, "not-found")
+
+	// Resources stored before they were versioned have an empty history
+	bundle := performSearch(c, s.Server.URL+"/Patient/"+s.FixtureId+"/_history")
+	c.Assert(*bundle.Total, Equals, uint32(0))
+}
+
+func (s *ServerSuite) TestPatientETagAndIfMatch(c *C) {
+	res := postPatientFixture(c, s.Server.URL, "../fixtures/patient-example-b.json", "")
+	c.Assert(res.StatusCode, Equals, http.StatusCreated)
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"1"`)
+	splitLocation := strings.Split(res.Header.Get("Location"), "/")
+	patientURL := s.Server.URL + "/Patient/" + splitLocation[len(splitLocation)-1]
+
+	res, err := http.Get(patientURL)
+	util.CheckErr(err)
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"1"`)
+
+	// Matching version: update the patient
+	res = sendPatientFixtureIfMatch(c, "PUT", patientURL, "../fixtures/patient-example-c.json", `W/"1"`)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	c.Assert(res.Header.Get("ETag"), Equals, `W/"2"`)
+
+	// Stale version: reject the update and the delete
+	res = sendPatientFixtureIfMatch(c, "PUT", patientURL, "../fixtures/patient-example-b.json", `W/"1"`)
+	c.Assert(res.StatusCode, Equals, http.StatusConflict)
+	outcome := models.OperationOutcome{}
+	err = json.NewDecoder(res.Body).Decode(&outcome)
+	util.CheckErr(err)
+	c.Assert(outcome.Issue[0].Code, Equals, "conflict")
+	res = sendPatientFixtureIfMatch(c, "DELETE", patientURL, "", `W/"1"`)
+	c.Assert(res.StatusCode, Equals, http.StatusConflict)
+
+	res, err = http.Get(patientURL)
+	util.CheckErr(err)
+	patient := models.Patient{}
+	err = json.NewDecoder(res.Body).Decode(&patient)
+	util.CheckErr(err)
+	c.Assert(patient.Name[0].Family[0], Equals, "Darkwing")
+	c.Assert(patient.Meta.VersionId, Equals, "2")
+
+	// Current version: delete the patient
+	res = sendPatientFixtureIfMatch(c, "DELETE", patientURL, "", `W/"2"`)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+
+	// No current version: fail the precondition rather than create the patient
+	res = sendPatientFixtureIfMatch(c, "PUT", s.Server.URL+"/Patient/no-such-patient", "../fixtures/patient-example-c.json", `W/"1"`)
+	assertOperationOutcome(c, res, http.StatusPreconditionFailed, "not-found")
+	count, err := Database.C("patients").FindId("no-such-patient").Count()
+	util.CheckErr(err)
+	c.Assert(count, Equals, 0)
+}
+
+func (s *ServerSuite) TestErrorResponses(c *C) {
+	res, err := http.Get(s.Server.URL + "/Patient/no-such-patient")
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusNotFound, "not-found")
+
+	res, err = http.Get(s.Server.URL + "/Patient/not_a_valid_id")
+	util.CheckErr(err)
+	outcome := assertOperationOutcome(c, res, http.StatusBadRequest, "value")
+	c.Assert(outcome.Issue[0].Location, DeepEquals, []string{"Patient.id"})
+
+	res, err = http.Post(s.Server.URL+"/Patient", "application/json", strings.NewReader(`{"resourceType": "Patient",`))
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusBadRequest, "structure")
+
+	res, err = http.Post(s.Server.URL+"/Patient", "application/json", strings.NewReader(`{"resourceType": "Patient", "gender": 1}`))
+	util.CheckErr(err)
+	outcome = assertOperationOutcome(c, res, http.StatusUnprocessableEntity, "structure")
+	c.Assert(outcome.Issue[0].Location, DeepEquals, []string{"Patient.gender"})
+
+	for _, contained := range []string{`[1]`, `[{"id": "org1"}]`, `[{"resourceType": "Foo"}]`} {
+		res, err = http.Post(s.Server.URL+"/Patient", "application/json", strings.NewReader(`{"resourceType": "Patient", "contained": `+contained+`}`))
+		util.CheckErr(err)
+		assertOperationOutcome(c, res, http.StatusBadRequest, "structure")
+	}
+
+	res = sendPatch(c, s.Server.URL+"/Patient/"+s.FixtureId, "application/json", `{"resourceType": "Patient", "id": "someone-else"}`)
+	assertOperationOutcome(c, res, http.StatusUnsupportedMediaType, "not-supported")
+
+	req, err := http.NewRequest("PUT", s.Server.URL+"/Patient/"+s.FixtureId, strings.NewReader(`{"resourceType": "Patient", "id": "someone-else"}`))
+	util.CheckErr(err)
+	res, err = http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	assertOperationOutcome(c, res, http.StatusBadRequest, "invalid")
+
+	res = sendPatientFixture(c, "DELETE", s.Server.URL+"/Patient/no-such-patient", "")
+	assertOperationOutcome(c, res, http.StatusNotFound, "not-found")
+
+	res = sendPatientFixture(c, "POST", s.Server.URL+"/Patient/"+s.FixtureId, "../fixtures/patient-example-b.json")
+	assertOperationOutcome(c, res, http.StatusMethodNotAllowed, "not-supported")
+	c.Assert(res.Header.Get("Allow"), Equals, "GET, PUT, PATCH, DELETE")
+
+	res, err = http.Get(s.Server.URL + "/Patient?foo=bar")
+	util.CheckErr(err)
+	c.Assert(res.Header.Get("Content-Type"), Equals, "application/json; charset=utf-8")
+	c.Assert(res.StatusCode, Not(Equals), http.StatusOK)
+}
+
+func assertOperationOutcome(c *C, res *http.Response, status int, code string) *models.OperationOutcome {
+	c.Assert(res.StatusCode, Equals, status)
+	outcome := &models.OperationOutcome{}
+	err := json.NewDecoder(res.Body).Decode(outcome)
+	util.CheckErr(err)
+	c.Assert(outcome.Issue, HasLen, 1)
+	c.Assert(outcome.Issue[0].Severity, Equals, "error")
+	c.Assert(outcome.Issue[0].Code, Equals, code)
+	c.Assert(outcome.Issue[0].Diagnostics, Not(Equals), "")
+	return outcome
+}
+
+func postPatientFixture(c *C, serverURL string, filePath string, ifNoneExist string) *http.Response {
+	data, err := os.Open(filePath)
+	defer data.Close()
+	util.CheckErr(err)
+	req, err := http.NewRequest("POST", serverURL+"/Patient", data)
+	util.CheckErr(err)
+	req.Header.Set("Content-Type", "application/json")
+	if ifNoneExist != "" {
+		req.Header.Set("If-None-Exist", ifNoneExist)
+	}
+	res, err := http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	return res
+}
+
+func sendPatientFixture(c *C, method string, url string, filePath string) *http.Response {
+	var body io.Reader
+	if filePath != "" {
+		data, err := os.Open(filePath)
+		util.CheckErr(err)
+		defer data.Close()
+		body = data
+	}
+	req, err := http.NewRequest(method, url, body)
+	util.CheckErr(err)
+	res, err := http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	return res
+}
+
+func sendPatientFixtureIfMatch(c *C, method string, url string, filePath string, ifMatch string) *http.Response {
+	var body io.Reader
+	if filePath != "" {
+		data, err := os.Open(filePath)
+		util.CheckErr(err)
+		defer data.Close()
+		body = data
+	}
+	req, err := http.NewRequest(method, url, body)
+	util.CheckErr(err)
+	req.Header.Set("If-Match", ifMatch)
+	res, err := http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	return res
+}
+
+func sendPatch(c *C, url string, contentType string, patch string) *http.Response {
+	req, err := http.NewRequest("PATCH", url, strings.NewReader(patch))
+	util.CheckErr(err)
+	req.Header.Set("Content-Type", contentType)
+	res, err := http.DefaultClient.Do(req)
+	util.CheckErr(err)
+	return res
+}
+
+func (s *ServerSuite) getPatient(c *C, path string) *models.Patient {
+	res, err := http.Get(s.Server.URL + path)
+	util.CheckErr(err)
+	c.Assert(res.StatusCode, Equals, http.StatusOK)
+	patient := &models.Patient{}
+	err = json.NewDecoder(res.Body).Decode(patient)
+	util.CheckErr(err)
+	return patient
+}
+
+func assertSubsetted(c *C, meta *models.Meta) {
+	c.Assert(meta, NotNil)
+	c.Assert(meta.Security, HasLen, 1)
+	c.Assert(meta.Security[0].Code, Equals, "SUBSETTED")
+}
+
 func performSearch(c *C, url string) *models.Bundle {
 	res, err := http.Get(url)
 	util.CheckErr(err)
@@ -296,6 +983,14 @@ func assertPagingLink(c *C, link models.BundleLinkComponent, relation string, co
 	util.CheckErr(err)
 	v := urlUrl.Query()
 
+	// Pages of results spanning more than one page are identified by a token
+	if token := v.Get(search.PageParam); token != "" {
+		page, err := decodePageToken(token)
+		util.CheckErr(err)
+		c.Assert(page.Count, Equals, count)
+		c.Assert(page.Offset, Equals, offset)
+		return
+	}
 	c.Assert(v.Get(search.CountParam), Equals, fmt.Sprint(count))
 	c.Assert(v.Get(search.OffsetParam), Equals, fmt.Sprint(offset))
 }